@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+
+	adminmodulemodule "github.com/cosmos/admin-module/x/adminmodule"
+	adminmodulemodulekeeper "github.com/cosmos/admin-module/x/adminmodule/keeper"
+	adminmodulemoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	heroadminkeeper "github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+)
+
+// adminModule wraps the admin module so that its messages go through the msg server of the heroadmin
+// module, which checks submitted proposals against the proposal whitelist and holds admin module messages
+// until the admin threshold is reached when more than one admin must approve them.
+type adminModule struct {
+	adminmodulemodule.AppModule
+
+	keeper          adminmodulemodulekeeper.Keeper
+	heroadminKeeper heroadminkeeper.Keeper
+}
+
+func newAdminModule(
+	cdc codec.Codec,
+	keeper adminmodulemodulekeeper.Keeper,
+	heroadminKeeper heroadminkeeper.Keeper,
+) adminModule {
+	return adminModule{
+		AppModule:       adminmodulemodule.NewAppModule(cdc, keeper),
+		keeper:          keeper,
+		heroadminKeeper: heroadminKeeper,
+	}
+}
+
+func (am adminModule) msgServer() adminmodulemoduletypes.MsgServer {
+	return heroadminkeeper.NewAdminMsgServerImpl(adminmodulemodulekeeper.NewMsgServerImpl(am.keeper), am.heroadminKeeper)
+}
+
+// Route returns the admin module's message routing key with a handler going through the wrapped msg server.
+func (am adminModule) Route() sdk.Route {
+	msgServer := am.msgServer()
+	return sdk.NewRoute(adminmodulemoduletypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *adminmodulemoduletypes.MsgDeleteAdmin:
+			res, err := msgServer.DeleteAdmin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *adminmodulemoduletypes.MsgAddAdmin:
+			res, err := msgServer.AddAdmin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *adminmodulemoduletypes.MsgSubmitProposal:
+			res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", adminmodulemoduletypes.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	})
+}
+
+// RegisterServices registers the wrapped admin module msg server and its query server.
+func (am adminModule) RegisterServices(cfg module.Configurator) {
+	adminmodulemoduletypes.RegisterMsgServer(cfg.MsgServer(), am.msgServer())
+	adminmodulemoduletypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
@@ -0,0 +1,211 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	heroadmintypes "github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+func TestAdminThreshold(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.HeroadminKeeper
+
+	deliver := func(msg sdk.Msg) (*sdk.Result, error) {
+		return heroApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+	}
+	approve := func(from string, id uint64) (bool, error) {
+		res, err := deliver(heroadmintypes.NewMsgApproveAdminProposal(from, id))
+		if err != nil {
+			return false, err
+		}
+		var resp heroadmintypes.MsgApproveAdminProposalResponse
+		require.NoError(t, heroApp.AppCodec().Unmarshal(res.Data, &resp))
+		return resp.Executed, nil
+	}
+
+	admin1, admin2, admin3 := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, admin := range []string{admin1, admin2, admin3} {
+		heroApp.AdminmoduleKeeper.SetAdmin(ctx, admin)
+	}
+
+	require.ErrorIs(t, k.UpdateAdminThreshold(ctx, 4, time.Hour, admin1), heroadmintypes.ErrAdminApproval)
+	require.NoError(t, k.UpdateAdminThreshold(ctx, 2, time.Hour, admin1))
+
+	// a proposal is held until a second admin approves it
+	proposer, err := sdk.AccAddressFromBech32(admin1)
+	require.NoError(t, err)
+	submit, err := adminmoduletypes.NewMsgSubmitProposal(&upgradetypes.SoftwareUpgradeProposal{
+		Title:       "title",
+		Description: "description",
+		Plan:        upgradetypes.Plan{Name: "v2", Height: ctx.BlockHeight() + 100},
+	}, proposer)
+	require.NoError(t, err)
+
+	proposalID, err := heroApp.AdminmoduleKeeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	_, err = deliver(submit)
+	require.NoError(t, err)
+
+	pending := k.GetAllPendingAdminProposal(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, []string{admin1}, pending[0].Approvals)
+	nextID, err := heroApp.AdminmoduleKeeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, proposalID, nextID)
+
+	_, err = approve(sample.AccAddress(), pending[0].Id)
+	require.ErrorIs(t, err, heroadmintypes.ErrUnauthorized)
+	_, err = approve(admin1, pending[0].Id)
+	require.ErrorIs(t, err, heroadmintypes.ErrAdminApproval)
+
+	executed, err := approve(admin2, pending[0].Id)
+	require.NoError(t, err)
+	require.True(t, executed)
+	require.Empty(t, k.GetAllPendingAdminProposal(ctx))
+	nextID, err = heroApp.AdminmoduleKeeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, proposalID+1, nextID)
+
+	// admin changes need the same approvals and are recorded with them
+	admin4 := sample.AccAddress()
+	_, err = deliver(&adminmoduletypes.MsgAddAdmin{Creator: admin1, Admin: admin4})
+	require.NoError(t, err)
+	require.NotContains(t, heroApp.AdminmoduleKeeper.GetAdmins(ctx), admin4)
+
+	pending = k.GetAllPendingAdminProposal(ctx)
+	require.Len(t, pending, 1)
+	executed, err = approve(admin3, pending[0].Id)
+	require.NoError(t, err)
+	require.True(t, executed)
+	require.Contains(t, heroApp.AdminmoduleKeeper.GetAdmins(ctx), admin4)
+
+	changes := k.GetAllAdminChange(ctx)
+	require.Len(t, changes, 1)
+	require.Equal(t, admin4, changes[0].Admin)
+	require.False(t, changes[0].Removed)
+	require.Equal(t, []string{admin1, admin3}, changes[0].Approvals)
+
+	// admins cannot be removed below the threshold
+	require.NoError(t, k.UpdateAdminThreshold(ctx, 4, time.Hour, admin1))
+	_, err = deliver(&adminmoduletypes.MsgDeleteAdmin{Creator: admin1, Admin: admin4})
+	require.NoError(t, err)
+	pending = k.GetAllPendingAdminProposal(ctx)
+	require.Len(t, pending, 1)
+	for _, admin := range []string{admin2, admin3} {
+		executed, err = approve(admin, pending[0].Id)
+		require.NoError(t, err)
+		require.False(t, executed)
+	}
+	_, err = approve(admin4, pending[0].Id)
+	require.ErrorIs(t, err, heroadmintypes.ErrAdminApproval)
+	require.Contains(t, heroApp.AdminmoduleKeeper.GetAdmins(ctx), admin4)
+}
+
+func TestAdminProposalExpiry(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.HeroadminKeeper
+
+	deliver := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return heroApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+	}
+
+	admin1, admin2 := sample.AccAddress(), sample.AccAddress()
+	for _, admin := range []string{admin1, admin2} {
+		heroApp.AdminmoduleKeeper.SetAdmin(ctx, admin)
+	}
+	require.NoError(t, k.UpdateAdminThreshold(ctx, 2, time.Hour, admin1))
+
+	admin3 := sample.AccAddress()
+	_, err := deliver(ctx, &adminmoduletypes.MsgAddAdmin{Creator: admin1, Admin: admin3})
+	require.NoError(t, err)
+	pending := k.GetAllPendingAdminProposal(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), pending[0].ExpiresAt)
+
+	// an expired admin proposal can no longer be approved and is removed at the next block
+	expired := ctx.WithBlockTime(pending[0].ExpiresAt.Add(time.Second))
+	_, err = deliver(expired, heroadmintypes.NewMsgApproveAdminProposal(admin2, pending[0].Id))
+	require.ErrorIs(t, err, heroadmintypes.ErrAdminApproval)
+
+	require.NoError(t, k.RemoveExpiredPendingAdminProposals(expired))
+	require.Empty(t, k.GetAllPendingAdminProposal(ctx))
+	require.NotContains(t, heroApp.AdminmoduleKeeper.GetAdmins(ctx), admin3)
+}
+
+func TestHeldProposalExecution(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.HeroadminKeeper
+
+	admin1, admin2 := sample.AccAddress(), sample.AccAddress()
+	for _, admin := range []string{admin1, admin2} {
+		heroApp.AdminmoduleKeeper.SetAdmin(ctx, admin)
+	}
+	require.NoError(t, k.UpdateAdminThreshold(ctx, 2, time.Hour, admin1))
+
+	proposer, err := sdk.AccAddressFromBech32(admin1)
+	require.NoError(t, err)
+	submit := func(name string) uint64 {
+		msg, err := adminmoduletypes.NewMsgSubmitProposal(&upgradetypes.SoftwareUpgradeProposal{
+			Title:       "title",
+			Description: "description",
+			Plan:        upgradetypes.Plan{Name: name, Height: ctx.BlockHeight() + 100},
+		}, proposer)
+		require.NoError(t, err)
+		res, err := heroApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.NoError(t, err)
+
+		// held proposals have no admin module proposal id yet
+		var resp adminmoduletypes.MsgSubmitProposalResponse
+		require.NoError(t, heroApp.AppCodec().Unmarshal(res.Data, &resp))
+		require.Zero(t, resp.ProposalId)
+
+		pending := k.GetAllPendingAdminProposal(ctx)
+		return pending[len(pending)-1].Id
+	}
+	approve := func(id uint64) error {
+		msg := heroadmintypes.NewMsgApproveAdminProposal(admin2, id)
+		_, err := heroApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	// an approved proposal goes through the whitelist check again and reaches the admin module queue
+	proposalID, err := heroApp.AdminmoduleKeeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	require.NoError(t, approve(submit("v2")))
+
+	proposal, found := heroApp.AdminmoduleKeeper.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, "v2", proposal.GetContent().(*upgradetypes.SoftwareUpgradeProposal).Plan.Name)
+	var queued []uint64
+	heroApp.AdminmoduleKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal govtypes.Proposal) bool {
+		queued = append(queued, proposal.ProposalId)
+		return false
+	})
+	require.Equal(t, []uint64{proposalID}, queued)
+
+	// a proposal type removed from the whitelist while the proposal is held is rejected on approval
+	id := submit("v3")
+	require.NoError(t, k.RemoveProposalWhitelist(ctx, []string{heroadmintypes.ProposalTypeURL(&upgradetypes.SoftwareUpgradeProposal{})}, nil, admin1))
+	require.ErrorContains(t, approve(id), "not whitelisted")
+	_, found = k.GetPendingAdminProposal(ctx, id)
+	require.True(t, found)
+	_, found = heroApp.AdminmoduleKeeper.GetProposal(ctx, proposalID+1)
+	require.False(t, found)
+}
@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	tokenfactorymodule "github.com/strangelove-ventures/hero/x/tokenfactory"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestChannelAllowlist(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+	k.SetAllowedChannel(ctx, tokenfactorytypes.AllowedChannel{ChannelId: "channel-0"})
+
+	mock := &mockTransferApp{}
+	middleware := tokenfactorymodule.NewIBCMiddleware(mock, k)
+	ics4Wrapper := tokenfactorymodule.NewAllowlistICS4Wrapper(mock, k)
+
+	// the minting denom returns only through allowlisted channels
+	returning := "transfer/channel-7/uusdc"
+	ack := middleware.OnRecvPacket(ctx, transferPacket(returning, "10", "channel-7", "channel-0"), nil)
+	require.True(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, transferPacket(returning, "10", "channel-7", "channel-1"), nil)
+	require.False(t, ack.Success())
+
+	// other denoms are not restricted
+	ack = middleware.OnRecvPacket(ctx, transferPacket("uatom", "10", "channel-7", "channel-1"), nil)
+	require.True(t, ack.Success())
+
+	// the minting denom is sent only through allowlisted channels
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, transferPacket("uusdc", "10", "channel-0", "channel-7")))
+	require.ErrorIs(t, ics4Wrapper.SendPacket(ctx, nil, transferPacket("uusdc", "10", "channel-1", "channel-7")), tokenfactorytypes.ErrChannelNotAllowed)
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, transferPacket("uatom", "10", "channel-1", "channel-7")))
+	require.Equal(t, 2, mock.sent)
+}
@@ -1,14 +1,17 @@
 package app
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/strangelove-ventures/hero/cmd"
 	tokenfactory "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibcante "github.com/cosmos/ibc-go/v3/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	consumerante "github.com/cosmos/interchain-security/app/consumer/ante"
@@ -20,88 +23,184 @@ type HandlerOptions struct {
 	tokenfactorykeeper tokenfactory.Keeper
 	IBCKeeper          *ibckeeper.Keeper
 	ConsumerKeeper     ibcconsumerkeeper.Keeper
+	CCV                cmd.CCVConfig
 }
 
-type IsPausedDecorator struct {
-	tokenfactory tokenfactory.Keeper
+// MsgFilterDecorator rejects the transactions holding messages that are not allowed before the CCV
+// channel to the provider chain is established. It replaces consumerante.MsgFilterDecorator, which
+// only allows IBC messages, with an allowlist of type URL prefixes.
+type MsgFilterDecorator struct {
+	consumerKeeper consumerante.ConsumerKeeper
+	allowedMsgs    []string
 }
 
-func NewIsPausedDecorator(tk tokenfactory.Keeper) IsPausedDecorator {
-	return IsPausedDecorator{
-		tokenfactory: tk,
+func NewMsgFilterDecorator(ck consumerante.ConsumerKeeper, allowedMsgs []string) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		consumerKeeper: ck,
+		allowedMsgs:    allowedMsgs,
 	}
 }
 
-func (ad IsPausedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	msgs := tx.GetMsgs()
-	for _, m := range msgs {
-		switch m.(type) {
-		case *banktypes.MsgSend, *banktypes.MsgMultiSend, *transfertypes.MsgTransfer:
-			paused := ad.tokenfactory.GetPaused(ctx)
-			if paused.Paused {
-				return ctx, sdkerrors.Wrapf(tokenfactorytypes.ErrPaused, "can not perform token transfers")
-			}
-		default:
-			continue
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if _, ok := mfd.consumerKeeper.GetProviderChannel(ctx); ok {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, m := range tx.GetMsgs() {
+		if msgType := sdk.MsgTypeURL(m); !mfd.isAllowed(msgType) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not allowed before the CCV channel is established at height %d", msgType, ctx.BlockHeight())
 		}
 	}
+
 	return next(ctx, tx, simulate)
 }
 
-type IsBlacklistedDecorator struct {
+// isAllowed returns whether the message type URL starts with one of the allowed prefixes
+func (mfd MsgFilterDecorator) isAllowed(msgType string) bool {
+	for _, prefix := range mfd.allowedMsgs {
+		if strings.HasPrefix(msgType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// TransferRulesDecorator rejects the bank sends and IBC transfers that the tokenfactory refuses, such
+// as transfers while the token is paused, from or to blacklisted addresses or over the rate limit of
+// a channel. The messages are checked by ValidateMsg, the function behind the CheckTransfer query.
+type TransferRulesDecorator struct {
 	tokenfactory tokenfactory.Keeper
 }
 
-func NewIsBlacklistedDecorator(tk tokenfactory.Keeper) IsBlacklistedDecorator {
-	return IsBlacklistedDecorator{
+func NewTransferRulesDecorator(tk tokenfactory.Keeper) TransferRulesDecorator {
+	return TransferRulesDecorator{
 		tokenfactory: tk,
 	}
 }
 
-func (ad IsBlacklistedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	msgs := tx.GetMsgs()
-	for _, m := range msgs {
-		switch m := m.(type) {
-		case *banktypes.MsgSend, *banktypes.MsgMultiSend, *transfertypes.MsgTransfer:
-			var addresses []string
-			switch m := m.(type) {
-			case *banktypes.MsgSend:
-				for _, c := range m.Amount {
-					if checkIfMintedAsset(ctx, c.Denom, ad.tokenfactory) {
-						addresses = append(addresses, m.ToAddress, m.FromAddress)
-					}
-				}
-			case *banktypes.MsgMultiSend:
-				for _, i := range m.Inputs {
-					for _, c := range i.Coins {
-						if checkIfMintedAsset(ctx, c.Denom, ad.tokenfactory) {
-							addresses = append(addresses, i.Address)
-						}
-					}
-				}
-				for _, o := range m.Outputs {
-					for _, c := range o.Coins {
-						if checkIfMintedAsset(ctx, c.Denom, ad.tokenfactory) {
-							addresses = append(addresses, o.Address)
-						}
-					}
-				}
-			case *transfertypes.MsgTransfer:
-				addresses = append(addresses, m.Sender, m.Receiver)
+func (ad TransferRulesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, m := range tx.GetMsgs() {
+		if reason, err := ad.tokenfactory.ValidateMsg(ctx, m); err != nil {
+			tokenfactory.IncrRejectedTransfer(tokenfactory.RejectionSourceAnte, reason)
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// FeeDecorator replaces ante.MempoolFeeDecorator and ante.DeductFeeDecorator so that fees can
+// also be paid in the minting denom. One unit of a minimum gas price denom is worth FeeConversionRate
+// units of the minting denom. Fee payers paying in the minting denom are subject to the pause and
+// blacklist rules of the token. Fees are sent to the fee collector, which interchain security
+// splits between the consumer redistribution account and the provider fee pool.
+type FeeDecorator struct {
+	ak             ante.AccountKeeper
+	bankKeeper     authtypes.BankKeeper
+	feegrantKeeper ante.FeegrantKeeper
+	tokenfactory   tokenfactory.Keeper
+}
+
+func NewFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, tk tokenfactory.Keeper) FeeDecorator {
+	return FeeDecorator{
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+		tokenfactory:   tk,
+	}
+}
+
+func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := fd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return ctx, fmt.Errorf("Fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	fee := feeTx.GetFee()
+
+	if minGasPrices := ctx.MinGasPrices(); ctx.IsCheckTx() && !simulate && !minGasPrices.IsZero() {
+		mintingDenom := fd.tokenfactory.GetMintingDenom(ctx).Denom
+		requiredFees := RequiredFees(minGasPrices, feeTx.GetGas(), mintingDenom, fd.tokenfactory.FeeConversionRate(ctx))
+		if !fee.IsAnyGTE(requiredFees) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFees)
+		}
+	}
+
+	deductFeesFrom := feeTx.FeePayer()
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		if fd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(deductFeesFrom) {
+			if err := fd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, deductFeesFrom, fee, tx.GetMsgs()); err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, deductFeesFrom)
 			}
-			for _, address := range addresses {
-				_, found := ad.tokenfactory.GetBlacklisted(ctx, address)
-				if found {
-					return ctx, sdkerrors.Wrapf(tokenfactorytypes.ErrUnauthorized, "an address (%s) is blacklisted and can not send or receive tokens", address)
-				}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := fd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if !fee.IsZero() {
+		mintingDenom := fd.tokenfactory.GetMintingDenom(ctx).Denom
+		if amount := fee.AmountOf(mintingDenom); amount.IsPositive() {
+			if reason, err := fd.tokenfactory.ValidateTransfer(ctx, deductFeesFrom.String(), "", sdk.NewCoin(mintingDenom, amount), tokenfactorytypes.TransferPathBank); err != nil {
+				tokenfactory.IncrRejectedTransfer(tokenfactory.RejectionSourceAnte, reason)
+				return ctx, err
 			}
-		default:
-			continue
+		}
+
+		if err := ante.DeductFees(fd.bankKeeper, ctx, deductFeesFromAcc, fee); err != nil {
+			return ctx, err
 		}
 	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	))
+
 	return next(ctx, tx, simulate)
 }
 
+// RequiredFees returns the fees required for the gas at the minimum gas prices, where
+// fee = ceil(minGasPrice * gas). While the fee conversion rate is positive, the fees can
+// also be paid in the minting denom at the conversion rate of the cheapest minimum gas price.
+func RequiredFees(minGasPrices sdk.DecCoins, gas uint64, mintingDenom string, feeConversionRate sdk.Dec) sdk.Coins {
+	if minGasPrices.IsZero() {
+		return sdk.Coins{}
+	}
+
+	glDec := sdk.NewDec(int64(gas))
+	requiredFees := make(sdk.Coins, 0, len(minGasPrices)+1)
+	var mintingDenomFee sdk.Dec
+	for _, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt()))
+
+		if gp.Denom == mintingDenom {
+			continue
+		}
+		if converted := fee.Mul(feeConversionRate); mintingDenomFee.IsNil() || converted.LT(mintingDenomFee) {
+			mintingDenomFee = converted
+		}
+	}
+
+	if feeConversionRate.IsPositive() && !mintingDenomFee.IsNil() && requiredFees.AmountOf(mintingDenom).IsZero() {
+		requiredFees = append(requiredFees, sdk.NewCoin(mintingDenom, mintingDenomFee.Ceil().RoundInt()))
+	}
+
+	return requiredFees.Sort()
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer
@@ -123,29 +222,26 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
-		NewIsBlacklistedDecorator(options.tokenfactorykeeper),
-		NewIsPausedDecorator(options.tokenfactorykeeper),
-		// temporarily disabled so that chain can be tested locally without the provider chain running
-		// consumerante.NewMsgFilterDecorator(options.ConsumerKeeper),
+		NewTransferRulesDecorator(options.tokenfactorykeeper),
+	}
+	// the filter is disabled in app.toml to run the chain locally without a provider chain
+	if options.CCV.MsgFilter {
+		anteDecorators = append(anteDecorators, NewMsgFilterDecorator(options.ConsumerKeeper, options.CCV.PreCCVAllowedMsgs))
+	}
+	anteDecorators = append(anteDecorators,
 		consumerante.NewDisabledModulesDecorator("/cosmos.evidence", "/cosmos.slashing"),
-		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		NewFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.tokenfactorykeeper),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCKeeper),
-	}
+	)
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 
 }
-
-func checkIfMintedAsset(ctx sdk.Context, denom string, k tokenfactory.Keeper) bool {
-	mintingDenom := tokenfactory.Keeper.GetMintingDenom(k, ctx)
-	return mintingDenom.Denom == denom
-}
@@ -0,0 +1,338 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcsimapp "github.com/cosmos/ibc-go/v3/testing/simapp"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/cmd"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// feeTx is a minimal sdk.FeeTx paying fee for gas from payer
+type feeTx struct {
+	fee   sdk.Coins
+	gas   uint64
+	payer sdk.AccAddress
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg             { return nil }
+func (tx feeTx) ValidateBasic() error           { return nil }
+func (tx feeTx) GetGas() uint64                 { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins              { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress       { return tx.payer }
+func (tx feeTx) FeeGranter() (_ sdk.AccAddress) { return }
+
+func TestRequiredFees(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1)))
+
+	require.Equal(t, sdk.Coins{}, app.RequiredFees(sdk.DecCoins{}, 100, "uusdc", sdk.NewDec(2)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), app.RequiredFees(minGasPrices, 100, "uusdc", sdk.ZeroDec()))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("uusdc", 38)),
+		app.RequiredFees(minGasPrices, 100, "uusdc", sdk.NewDecWithPrec(25, 2)),
+	)
+
+	minGasPrices = minGasPrices.Add(sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(1, 1)))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("uusdc", 10)),
+		app.RequiredFees(minGasPrices, 100, "uusdc", sdk.NewDec(2)),
+	)
+}
+
+func TestFeeDecorator(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext().
+		WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.OneDec())))
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	heroApp.TokenfactoryKeeper.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	payer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	heroApp.AccountKeeper.SetAccount(ctx, heroApp.AccountKeeper.NewAccountWithAddress(ctx, payer))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, funds))
+	require.NoError(t, heroApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, payer, funds))
+
+	decorator := app.NewFeeDecorator(heroApp.AccountKeeper, heroApp.BankKeeper, heroApp.FeeGrantKeeper, heroApp.TokenfactoryKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	tx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50)), gas: 100, payer: payer}
+
+	_, err := decorator.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	params := heroApp.TokenfactoryKeeper.GetParams(ctx)
+	params.FeeConversionRate = sdk.NewDecWithPrec(5, 1)
+	heroApp.TokenfactoryKeeper.SetParams(ctx, params)
+
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	feeCollector := heroApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, int64(50), heroApp.BankKeeper.GetBalance(ctx, feeCollector, "uusdc").Amount.Int64())
+	require.Equal(t, int64(950), heroApp.BankKeeper.GetBalance(ctx, payer, "uusdc").Amount.Int64())
+
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrPaused)
+
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+	heroApp.TokenfactoryKeeper.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: payer.String()})
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrBlacklistedSender)
+}
+
+// msgsTx is a minimal sdk.Tx holding msgs
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+// appOptions is a servertypes.AppOptions reading the options from a map
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} { return o[key] }
+
+func TestCCVConfigFromAppOptions(t *testing.T) {
+	// app.toml files written before the ccv section existed keep the filter enabled
+	config := cmd.CCVConfigFromAppOptions(appOptions{})
+	require.True(t, config.MsgFilter)
+	require.Equal(t, cmd.DefaultPreCCVAllowedMsgs, config.PreCCVAllowedMsgs)
+
+	config = cmd.CCVConfigFromAppOptions(appOptions{cmd.FlagMsgFilter: false})
+	require.False(t, config.MsgFilter)
+
+	config = cmd.CCVConfigFromAppOptions(appOptions{
+		cmd.FlagMsgFilter:         true,
+		cmd.FlagPreCCVAllowedMsgs: []interface{}{"/ibc.core.client."},
+	})
+	require.True(t, config.MsgFilter)
+	require.Equal(t, []string{"/ibc.core.client."}, config.PreCCVAllowedMsgs)
+}
+
+func TestMsgFilterDecorator(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+
+	decorator := app.NewMsgFilterDecorator(heroApp.ConsumerKeeper, cmd.DefaultPreCCVAllowedMsgs)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	send := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	mint := tokenfactorytypes.NewMsgMint(sample.AccAddress(), sample.AccAddress(), sdk.NewInt64Coin("uusdc", 1))
+	updateClient := &clienttypes.MsgUpdateClient{}
+
+	// before the CCV channel is established, only tokenfactory and IBC messages are accepted
+	_, err := decorator.AnteHandle(ctx, msgsTx{mint, updateClient}, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, msgsTx{mint, send}, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	heroApp.ConsumerKeeper.SetProviderChannel(ctx, "channel-0")
+	_, err = decorator.AnteHandle(ctx, msgsTx{mint, send}, false, next)
+	require.NoError(t, err)
+}
+
+func TestAnteHandlerMsgFilter(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		appOpts  appOptions
+		expError bool
+	}{
+		{
+			desc:    "standalone",
+			appOpts: appOptions{cmd.FlagMsgFilter: false},
+		},
+		{
+			desc:     "no ccv section",
+			appOpts:  appOptions{},
+			expError: true,
+		},
+		{
+			desc:     "consumer",
+			appOpts:  appOptions{cmd.FlagMsgFilter: true},
+			expError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			setup := func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+				encoding := cmd.MakeEncodingConfig(app.ModuleBasics)
+				testApp := app.New(log.NewNopLogger(), tmdb.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 0, encoding, tc.appOpts)
+				return testApp.(*app.App), app.NewDefaultGenesisState(encoding.Marshaler)
+			}
+			coordinator := icssimapp.NewBasicCoordinator(t)
+			chain := ibctesting.NewTestChain(t, coordinator, setup, "test")
+			heroApp := chain.App.(*app.App)
+			ctx := chain.GetContext()
+
+			heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+				Base:       "uusdc",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+			})
+			heroApp.TokenfactoryKeeper.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+			heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+			coordinator.CommitBlock(chain)
+			sender := heroApp.AccountKeeper.GetAccount(chain.GetContext(), chain.SenderAccount.GetAddress())
+
+			send := banktypes.NewMsgSend(sender.GetAddress(), sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+			_, _, err := ibcsimapp.SignAndDeliver(
+				t, chain.TxConfig, heroApp.GetBaseApp(), chain.GetContext().BlockHeader(),
+				[]sdk.Msg{send},
+				chain.ChainID, []uint64{sender.GetAccountNumber()}, []uint64{sender.GetSequence()},
+				!tc.expError, !tc.expError, chain.SenderPrivKey,
+			)
+			if tc.expError {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckTransferMatchesAnteHandler(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetAllowedChannel(ctx, tokenfactorytypes.AllowedChannel{ChannelId: "channel-0"})
+	require.NoError(t, k.UpdateRateLimit(ctx, "channel-0", tokenfactorytypes.RateLimitOutflow, sdk.NewInt(50), sdk.ZeroDec(), time.Hour, sample.AccAddress()))
+
+	holder := sample.AccAddress()
+	blacklisted := sample.AccAddress()
+	k.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: blacklisted})
+
+	decorator := app.NewTransferRulesDecorator(k)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tc := range []struct {
+		desc    string
+		from    string
+		to      string
+		amount  sdk.Coin
+		channel string
+		paused  bool
+		allowed bool
+	}{
+		{desc: "bank send", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uusdc", 10), allowed: true},
+		{desc: "bank send from blacklisted", from: blacklisted, to: holder, amount: sdk.NewInt64Coin("uusdc", 10)},
+		{desc: "bank send of another denom from blacklisted", from: blacklisted, to: holder, amount: sdk.NewInt64Coin("uatom", 10), allowed: true},
+		{desc: "bank send of another denom while paused", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uatom", 10), paused: true},
+		{desc: "ibc transfer", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uusdc", 10), channel: "channel-0", allowed: true},
+		{desc: "ibc transfer through a channel not allowed", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uusdc", 10), channel: "channel-1"},
+		{desc: "ibc transfer over the rate limit", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uusdc", 60), channel: "channel-0"},
+		{desc: "ibc transfer of another denom to blacklisted", from: holder, to: blacklisted, amount: sdk.NewInt64Coin("uatom", 10), channel: "channel-1"},
+		{desc: "ibc transfer of another denom while paused", from: holder, to: sample.AccAddress(), amount: sdk.NewInt64Coin("uatom", 10), channel: "channel-1", paused: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: tc.paused})
+
+			var msg sdk.Msg
+			request := &tokenfactorytypes.QueryCheckTransferRequest{From: tc.from, To: tc.to, Amount: tc.amount.String()}
+			if tc.channel == "" {
+				msg = banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(tc.from), sdk.MustAccAddressFromBech32(tc.to), sdk.NewCoins(tc.amount))
+			} else {
+				msg = transfertypes.NewMsgTransfer(transfertypes.PortID, tc.channel, tc.amount, tc.from, tc.to, clienttypes.NewHeight(0, 100), 0)
+				request.Path = tokenfactorytypes.TransferPathIbc
+				request.ChannelId = tc.channel
+			}
+
+			_, anteErr := decorator.AnteHandle(ctx, msgsTx{msg}, false, next)
+			response, err := k.CheckTransfer(sdk.WrapSDKContext(ctx), request)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.allowed, anteErr == nil)
+			require.Equal(t, tc.allowed, response.Allowed)
+			if anteErr != nil {
+				require.Equal(t, anteErr.Error(), response.Message)
+			}
+		})
+	}
+}
+
+func TestFeeConversionRateAfterUpgrade(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	payer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	heroApp.AccountKeeper.SetAccount(ctx, heroApp.AccountKeeper.NewAccountWithAddress(ctx, payer))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, funds))
+	require.NoError(t, heroApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, payer, funds))
+
+	// a chain running the first version of the module has no fee conversion rate until the upgrade
+	clearPrefix(prefix.NewStore(ctx.KVStore(heroApp.GetKey(paramstypes.StoreKey)), []byte(tokenfactorytypes.ModuleName+"/")))
+	versions := heroApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	versions[tokenfactorytypes.ModuleName] = 1
+	heroApp.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+	heroApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeName, Height: ctx.BlockHeight()})
+	require.True(t, k.GetParams(ctx).FeeConversionRate.IsZero())
+
+	decorator := app.NewFeeDecorator(heroApp.AccountKeeper, heroApp.BankKeeper, heroApp.FeeGrantKeeper, k)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	tx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50)), gas: 100, payer: payer}
+	checkTx := func() error {
+		ctx := chain.GetContext().
+			WithIsCheckTx(true).
+			WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.OneDec())))
+		_, err := decorator.AnteHandle(ctx, tx, false, next)
+		return err
+	}
+
+	// fees in the minting denom are refused until an admin sets the conversion rate
+	require.ErrorIs(t, checkTx(), sdkerrors.ErrInsufficientFee)
+
+	admin := sample.AccAddress()
+	heroApp.AdminmoduleKeeper.SetAdmin(ctx, admin)
+	submit, err := adminmoduletypes.NewMsgSubmitProposal(proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: tokenfactorytypes.ModuleName, Key: string(tokenfactorytypes.KeyFeeConversionRate), Value: `"0.500000000000000000"`},
+	}), sdk.MustAccAddressFromBech32(admin))
+	require.NoError(t, err)
+	_, err = heroApp.MsgServiceRouter().Handler(submit)(ctx, submit)
+	require.NoError(t, err)
+
+	// the admin module applies the change at the end of the block
+	chain.NextBlock()
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), k.FeeConversionRate(chain.GetContext()))
+	require.NoError(t, checkTx())
+}
@@ -89,7 +89,12 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/strangelove-ventures/hero/cmd"
+	heroadminmodule "github.com/strangelove-ventures/hero/x/heroadmin"
+	heroadminmoduleclient "github.com/strangelove-ventures/hero/x/heroadmin/client"
+	heroadminmodulekeeper "github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+	heroadminmoduletypes "github.com/strangelove-ventures/hero/x/heroadmin/types"
 	tokenfactorymodule "github.com/strangelove-ventures/hero/x/tokenfactory"
+	tokenfactorymoduleclient "github.com/strangelove-ventures/hero/x/tokenfactory/client"
 	tokenfactorymodulekeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
 	tokenfactorymoduletypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
@@ -138,8 +143,15 @@ var (
 				adminmodulecli.NewCmdSubmitCancelUpgradeProposal,
 				upgraderest.ProposalCancelRESTHandler,
 			),
+			tokenfactorymoduleclient.CancelRoleChangeProposalHandler,
+			tokenfactorymoduleclient.SetRateLimitProposalHandler,
+			tokenfactorymoduleclient.RemoveRateLimitProposalHandler,
+			heroadminmoduleclient.AddProposalWhitelistProposalHandler,
+			heroadminmoduleclient.RemoveProposalWhitelistProposalHandler,
+			heroadminmoduleclient.SetAdminThresholdProposalHandler,
 		),
 		tokenfactorymodule.AppModuleBasic{},
+		heroadminmodule.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -205,12 +217,14 @@ type App struct {
 	AdminmoduleKeeper adminmodulemodulekeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
-	ScopedICAHostKeeper     capabilitykeeper.ScopedKeeper
-	ScopedCCVConsumerKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper          capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper     capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper      capabilitykeeper.ScopedKeeper
+	ScopedCCVConsumerKeeper  capabilitykeeper.ScopedKeeper
+	ScopedTokenfactoryKeeper capabilitykeeper.ScopedKeeper
 
 	TokenfactoryKeeper tokenfactorymodulekeeper.Keeper
+	HeroadminKeeper    heroadminmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// mm is the module manager
@@ -247,7 +261,7 @@ func New(
 		authtypes.StoreKey, authz.ModuleName, banktypes.StoreKey, slashingtypes.StoreKey,
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey, ccvconsumertypes.StoreKey,
-		adminmodulemoduletypes.StoreKey, tokenfactorymoduletypes.StoreKey,
+		adminmodulemoduletypes.StoreKey, tokenfactorymoduletypes.StoreKey, heroadminmoduletypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -286,6 +300,7 @@ func New(
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedCCVConsumerKeeper := app.CapabilityKeeper.ScopeToModule(ccvconsumertypes.ModuleName)
+	scopedTokenfactoryKeeper := app.CapabilityKeeper.ScopeToModule(tokenfactorymoduletypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/scopedKeeper
 
 	// add keepers
@@ -350,12 +365,31 @@ func New(
 		scopedIBCKeeper,
 	)
 
-	// Create Transfer Keepers
+	app.TokenfactoryKeeper = *tokenfactorymodulekeeper.NewKeeper(
+		appCodec,
+		keys[tokenfactorymoduletypes.StoreKey],
+		keys[tokenfactorymoduletypes.MemStoreKey],
+		app.GetSubspace(tokenfactorymoduletypes.ModuleName),
+
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedTokenfactoryKeeper,
+	)
+	tokenfactoryModule := tokenfactorymodule.NewAppModule(appCodec, app.TokenfactoryKeeper, app.AccountKeeper, app.BankKeeper)
+	tokenfactoryIBCModule := tokenfactorymodule.NewIBCModule(app.TokenfactoryKeeper)
+
+	// Create Transfer Keepers, transfers of the minting denom are restricted to allowlisted channels
+	// and rate limited per channel
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		tokenfactorymodule.NewAllowlistICS4Wrapper(
+			tokenfactorymodule.NewRateLimitICS4Wrapper(app.IBCKeeper.ChannelKeeper, app.TokenfactoryKeeper),
+			app.TokenfactoryKeeper,
+		),
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -366,6 +400,7 @@ func New(
 	var transferStack ibcporttypes.IBCModule
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = tokenfactorymodule.NewRateLimitMiddleware(transferStack, app.TokenfactoryKeeper)
 	transferStack = tokenfactorymodule.NewIBCMiddleware(transferStack, app.TokenfactoryKeeper)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
@@ -378,7 +413,9 @@ func New(
 		app.MsgServiceRouter(),
 	)
 	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper)
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	var icaHostStack ibcporttypes.IBCModule
+	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostStack = tokenfactorymodule.NewICAHostMiddleware(icaHostStack, appCodec, app.TokenfactoryKeeper)
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -414,34 +451,41 @@ func New(
 	adminRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(proposaltypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(tokenfactorymoduletypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenfactoryKeeper))
+
+	// The admin proposal whitelist and admin approvals are kept by the heroadmin module, its keeper is built
+	// first so that the admin router includes its proposal handler
+	app.HeroadminKeeper = *heroadminmodulekeeper.NewKeeper(
+		appCodec,
+		keys[heroadminmoduletypes.StoreKey],
+		keys[heroadminmoduletypes.MemStoreKey],
+
+		&app.AdminmoduleKeeper,
+		app.MsgServiceRouter(),
+	)
+	heroadminModule := heroadminmodule.NewAppModule(appCodec, app.HeroadminKeeper)
+	adminRouter.AddRoute(heroadminmoduletypes.RouterKey, heroadminmodule.NewProposalHandler(app.HeroadminKeeper))
 
 	app.AdminmoduleKeeper = *adminmodulemodulekeeper.NewKeeper(
 		appCodec,
 		keys[adminmodulemoduletypes.StoreKey],
 		keys[adminmodulemoduletypes.MemStoreKey],
 		adminRouter,
-		IsProposalWhitelisted,
+		// the heroadmin msg server wrapping the admin module checks proposals against the whitelist
+		// with the context, which this callback does not receive
+		func(govtypes.Content) bool { return true },
 	)
-	adminModule := adminmodulemodule.NewAppModule(appCodec, app.AdminmoduleKeeper)
-
-	app.TokenfactoryKeeper = *tokenfactorymodulekeeper.NewKeeper(
-		appCodec,
-		keys[tokenfactorymoduletypes.StoreKey],
-		keys[tokenfactorymoduletypes.MemStoreKey],
-		app.GetSubspace(tokenfactorymoduletypes.ModuleName),
-
-		app.BankKeeper,
-	)
-	tokenfactoryModule := tokenfactorymodule.NewAppModule(appCodec, app.TokenfactoryKeeper, app.AccountKeeper, app.BankKeeper)
+	adminModule := newAdminModule(appCodec, app.AdminmoduleKeeper, app.HeroadminKeeper)
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
-		AddRoute(ccvconsumertypes.ModuleName, consumerModule)
+		AddRoute(ccvconsumertypes.ModuleName, consumerModule).
+		AddRoute(tokenfactorymoduletypes.ModuleName, tokenfactoryIBCModule)
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -472,6 +516,7 @@ func New(
 		consumerModule,
 		adminModule,
 		tokenfactoryModule,
+		heroadminModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 
@@ -498,6 +543,7 @@ func New(
 		ccvconsumertypes.ModuleName,
 		adminmodulemoduletypes.ModuleName,
 		tokenfactorymoduletypes.ModuleName,
+		heroadminmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	)
 
@@ -519,6 +565,7 @@ func New(
 		ccvconsumertypes.ModuleName,
 		adminmodulemoduletypes.ModuleName,
 		tokenfactorymoduletypes.ModuleName,
+		heroadminmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	)
 
@@ -545,6 +592,7 @@ func New(
 		ccvconsumertypes.ModuleName,
 		adminmodulemoduletypes.ModuleName,
 		tokenfactorymoduletypes.ModuleName,
+		heroadminmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
@@ -556,6 +604,7 @@ func New(
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.setupUpgradeHandlers()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -595,6 +644,7 @@ func New(
 			tokenfactorykeeper: app.TokenfactoryKeeper,
 			IBCKeeper:          app.IBCKeeper,
 			ConsumerKeeper:     app.ConsumerKeeper,
+			CCV:                cmd.CCVConfigFromAppOptions(appOpts),
 		},
 	)
 	if err != nil {
@@ -615,6 +665,7 @@ func New(
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
 	app.ScopedCCVConsumerKeeper = scopedCCVConsumerKeeper
+	app.ScopedTokenfactoryKeeper = scopedTokenfactoryKeeper
 	// this line is used by starport scaffolding # stargate/app/beforeInitReturn
 
 	return app
@@ -0,0 +1,47 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestBurnInsufficientBalance(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+	srv := tokenfactorykeeper.NewMsgServerImpl(k)
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	minter := sample.AccAddress()
+	k.SetMinters(ctx, tokenfactorytypes.Minters{Address: minter, Allowance: sdk.NewInt64Coin("uusdc", 0)})
+
+	// tokens held by the module, such as escrowed redemptions, must not be burned in place of the minter's
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, escrow))
+
+	_, err := srv.Burn(sdk.WrapSDKContext(ctx), &tokenfactorytypes.MsgBurn{From: minter, Amount: sdk.NewInt64Coin("uusdc", 50)})
+	require.ErrorIs(t, err, tokenfactorytypes.ErrBurn)
+
+	moduleAddress := heroApp.AccountKeeper.GetModuleAddress(tokenfactorytypes.ModuleName)
+	require.Equal(t, int64(100), heroApp.BankKeeper.GetBalance(ctx, moduleAddress, "uusdc").Amount.Int64())
+
+	_, found := k.GetMinterStats(ctx, minter)
+	require.False(t, found)
+}
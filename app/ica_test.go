@@ -0,0 +1,76 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorymodule "github.com/strangelove-ventures/hero/x/tokenfactory"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// icaPacket returns an ICA packet executing msgs
+func icaPacket(t *testing.T, heroApp *app.App, msgs ...sdk.Msg) channeltypes.Packet {
+	bz, err := icatypes.SerializeCosmosTx(heroApp.AppCodec(), msgs)
+	require.NoError(t, err)
+
+	data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		icatypes.PortPrefix+sample.AccAddress(), "channel-7",
+		icatypes.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func TestICAHostMiddleware(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	middleware := tokenfactorymodule.NewICAHostMiddleware(&mockTransferApp{}, heroApp.AppCodec(), k)
+
+	account := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	blacklisted := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: blacklisted.String()})
+
+	send := banktypes.NewMsgSend(account, blacklisted, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)))
+	ack := middleware.OnRecvPacket(ctx, icaPacket(t, heroApp, send), nil)
+	require.False(t, ack.Success())
+
+	// sends executed through authz are checked as well
+	exec := authz.NewMsgExec(account, []sdk.Msg{send})
+	ack = middleware.OnRecvPacket(ctx, icaPacket(t, heroApp, &exec), nil)
+	require.False(t, ack.Success())
+
+	// other denoms are not restricted
+	send = banktypes.NewMsgSend(account, blacklisted, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	ack = middleware.OnRecvPacket(ctx, icaPacket(t, heroApp, send), nil)
+	require.True(t, ack.Success())
+
+	send = banktypes.NewMsgSend(account, sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)))
+	ack = middleware.OnRecvPacket(ctx, icaPacket(t, heroApp, send), nil)
+	require.True(t, ack.Success())
+
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+	ack = middleware.OnRecvPacket(ctx, icaPacket(t, heroApp, send), nil)
+	require.False(t, ack.Success())
+}
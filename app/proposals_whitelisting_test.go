@@ -4,11 +4,19 @@ import (
 	"encoding/json"
 	"testing"
 
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
 	"github.com/strangelove-ventures/hero/app"
 	"github.com/strangelove-ventures/hero/cmd"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	heroadmintypes "github.com/strangelove-ventures/hero/x/heroadmin/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmdb "github.com/tendermint/tm-db"
@@ -17,7 +25,7 @@ import (
 func TestConsumerWhitelistingKeys(t *testing.T) {
 	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
 	paramKeeper := chain.App.(*app.App).ParamsKeeper
-	for paramKey := range app.WhitelistedParams {
+	for _, paramKey := range heroadmintypes.DefaultWhitelistedParams() {
 		ss, ok := paramKeeper.GetSubspace(paramKey.Subspace)
 		require.True(t, ok, "Unknown subspace %s", paramKey.Subspace)
 		hasKey := ss.Has(chain.GetContext(), []byte(paramKey.Key))
@@ -25,6 +33,53 @@ func TestConsumerWhitelistingKeys(t *testing.T) {
 	}
 }
 
+func TestAdminProposalWhitelist(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+
+	admin := sample.AccAddress()
+	heroApp.AdminmoduleKeeper.SetAdmin(ctx, admin)
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	require.NoError(t, err)
+
+	submit := func(content govtypes.Content) error {
+		msg, err := adminmoduletypes.NewMsgSubmitProposal(content, adminAddr)
+		require.NoError(t, err)
+		_, err = heroApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	paramChange := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: banktypes.ModuleName, Key: "DefaultSendEnabled", Value: "true"},
+	})
+	upgrade := &upgradetypes.SoftwareUpgradeProposal{
+		Title:       "title",
+		Description: "description",
+		Plan:        upgradetypes.Plan{Name: "v2", Height: ctx.BlockHeight() + 100},
+	}
+
+	// the default genesis whitelists software upgrades but not every parameter, the admin keeper
+	// rejects proposals that are not whitelisted
+	require.NoError(t, submit(upgrade))
+	require.ErrorContains(t, submit(paramChange), "not whitelisted")
+
+	k := heroApp.HeroadminKeeper
+	require.NoError(t, k.AddProposalWhitelist(ctx, nil, []heroadmintypes.WhitelistedParam{
+		{Subspace: banktypes.ModuleName, Key: "DefaultSendEnabled"},
+	}, admin))
+	require.NoError(t, submit(paramChange))
+
+	require.NoError(t, k.RemoveProposalWhitelist(ctx, []string{heroadmintypes.ProposalTypeURL(upgrade)}, nil, admin))
+	require.ErrorContains(t, submit(upgrade), "not whitelisted")
+
+	// changes to the whitelist are whitelisted by genesis and can be removed from it as well
+	addUpgrade := heroadmintypes.NewAddProposalWhitelistProposal("title", "description", []string{heroadmintypes.ProposalTypeURL(upgrade)}, nil)
+	require.NoError(t, submit(addUpgrade))
+	require.NoError(t, k.RemoveProposalWhitelist(ctx, []string{heroadmintypes.ProposalTypeURL(addUpgrade)}, nil, admin))
+	require.ErrorContains(t, submit(addUpgrade), "not whitelisted")
+}
+
 func SetupTestingAppConsumer() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	encoding := cmd.MakeEncodingConfig(app.ModuleBasics)
 	testApp := app.New(
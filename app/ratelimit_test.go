@@ -0,0 +1,138 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorymodule "github.com/strangelove-ventures/hero/x/tokenfactory"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// mockTransferApp acknowledges every received packet successfully and records sent packets
+type mockTransferApp struct {
+	porttypes.IBCModule
+	sent int
+}
+
+func (m *mockTransferApp) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *mockTransferApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockTransferApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockTransferApp) SendPacket(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI) error {
+	m.sent++
+	return nil
+}
+
+func (m *mockTransferApp) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI, ibcexported.Acknowledgement) error {
+	return nil
+}
+
+// transferPacket returns an ICS-20 packet of amount denom from the source to the destination channel
+func transferPacket(denom string, amount string, sourceChannel string, destChannel string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, sample.AccAddress(), sample.AccAddress())
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		transfertypes.PortID, sourceChannel,
+		transfertypes.PortID, destChannel,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))))
+
+	mock := &mockTransferApp{}
+	middleware := tokenfactorymodule.NewRateLimitMiddleware(mock, k)
+	ics4Wrapper := tokenfactorymodule.NewRateLimitICS4Wrapper(mock, k)
+
+	// 10% of the supply of 1000 can be received through channel-0 per window
+	admin := sample.AccAddress()
+	require.NoError(t, k.UpdateRateLimit(ctx, "channel-0", tokenfactorytypes.RateLimitInflow, sdk.ZeroInt(), sdk.NewDecWithPrec(1, 1), time.Hour, admin))
+
+	returning := "transfer/channel-7/uusdc"
+	ack := middleware.OnRecvPacket(ctx, transferPacket(returning, "80", "channel-7", "channel-0"), nil)
+	require.True(t, ack.Success())
+
+	ack = middleware.OnRecvPacket(ctx, transferPacket(returning, "30", "channel-7", "channel-0"), nil)
+	require.False(t, ack.Success())
+
+	// other denoms and channels are not limited
+	ack = middleware.OnRecvPacket(ctx, transferPacket("uatom", "1000", "channel-7", "channel-0"), nil)
+	require.True(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, transferPacket(returning, "1000", "channel-7", "channel-1"), nil)
+	require.True(t, ack.Success())
+
+	res, err := k.RateLimit(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryGetRateLimitRequest{ChannelId: "channel-0", Direction: tokenfactorytypes.RateLimitInflow})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), res.Quota)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 80), res.Flow)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 20), res.Remaining)
+
+	// outbound transfers over the quota are rejected before the packet is sent
+	require.NoError(t, k.UpdateRateLimit(ctx, "channel-0", tokenfactorytypes.RateLimitOutflow, sdk.NewInt(50), sdk.ZeroDec(), time.Hour, admin))
+
+	sent := transferPacket("uusdc", "40", "channel-0", "channel-7")
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, sent))
+	require.ErrorIs(t, ics4Wrapper.SendPacket(ctx, nil, transferPacket("uusdc", "40", "channel-0", "channel-7")), tokenfactorytypes.ErrRateLimitExceeded)
+	require.Equal(t, 1, mock.sent)
+
+	// timed out and rejected transfers are refunded to the quota
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, sent, nil))
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, sent))
+
+	errAck := channeltypes.NewErrorAcknowledgement("rejected")
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, sent, errAck.Acknowledgement(), nil))
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, sent))
+	require.Equal(t, 3, mock.sent)
+
+	// the quota is available again in the next window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	ack = middleware.OnRecvPacket(ctx, transferPacket(returning, "100", "channel-7", "channel-0"), nil)
+	require.True(t, ack.Success())
+
+	// a transfer sent in the previous window that times out is not refunded to the current window
+	current := transferPacket("uusdc", "50", "channel-0", "channel-7")
+	current.Sequence = 2
+	require.NoError(t, ics4Wrapper.SendPacket(ctx, nil, current))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, sent, nil))
+	require.ErrorIs(t, ics4Wrapper.SendPacket(ctx, nil, sent), tokenfactorytypes.ErrRateLimitExceeded)
+
+	// a transfer sent in the current window is refunded once
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, current, nil))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, current, nil))
+	res, err = k.RateLimit(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryGetRateLimitRequest{ChannelId: "channel-0", Direction: tokenfactorytypes.RateLimitOutflow})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 0), res.Flow)
+}
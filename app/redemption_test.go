@@ -0,0 +1,100 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestRedemptionLifecycle(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	wctx := sdk.WrapSDKContext(ctx)
+	k := heroApp.TokenfactoryKeeper
+	srv := tokenfactorykeeper.NewMsgServerImpl(k)
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	minter := sample.AccAddress()
+	k.SetMinters(ctx, tokenfactorytypes.Minters{Address: minter, Allowance: sdk.NewInt64Coin("uusdc", 0)})
+
+	holder := sample.AccAddress()
+	holderAddress := sdk.MustAccAddressFromBech32(holder)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, coins))
+	require.NoError(t, heroApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, holderAddress, coins))
+
+	moduleAddress := heroApp.AccountKeeper.GetModuleAddress(tokenfactorytypes.ModuleName)
+	balances := func() (int64, int64, int64) {
+		return heroApp.BankKeeper.GetBalance(ctx, holderAddress, "uusdc").Amount.Int64(),
+			heroApp.BankKeeper.GetBalance(ctx, moduleAddress, "uusdc").Amount.Int64(),
+			heroApp.BankKeeper.GetSupply(ctx, "uusdc").Amount.Int64()
+	}
+	status := func(id uint64) tokenfactorytypes.RedemptionStatus {
+		res, err := k.Redemption(wctx, &tokenfactorytypes.QueryGetRedemptionRequest{Id: id})
+		require.NoError(t, err)
+		return res.Redemption.Status
+	}
+
+	_, err := srv.RequestRedemption(wctx, tokenfactorytypes.NewMsgRequestRedemption(holder, sdk.NewInt64Coin("stake", 60)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrWrongDenom)
+
+	// a request escrows the tokens in the module until a minter resolves it
+	res, err := srv.RequestRedemption(wctx, tokenfactorytypes.NewMsgRequestRedemption(holder, sdk.NewInt64Coin("uusdc", 60)))
+	require.NoError(t, err)
+	fulfilled := res.Id
+	holderBalance, moduleBalance, supply := balances()
+	require.Equal(t, []int64{40, 60, 100}, []int64{holderBalance, moduleBalance, supply})
+	require.Equal(t, tokenfactorytypes.RedemptionPending, status(fulfilled))
+
+	_, err = srv.FulfillRedemption(wctx, tokenfactorytypes.NewMsgFulfillRedemption(holder, fulfilled))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+
+	// fulfilling burns the escrowed tokens and counts them as burned by the minter
+	_, err = srv.FulfillRedemption(wctx, tokenfactorytypes.NewMsgFulfillRedemption(minter, fulfilled))
+	require.NoError(t, err)
+	holderBalance, moduleBalance, supply = balances()
+	require.Equal(t, []int64{40, 0, 40}, []int64{holderBalance, moduleBalance, supply})
+	require.Equal(t, tokenfactorytypes.RedemptionFulfilled, status(fulfilled))
+	stats, found := k.GetMinterStats(ctx, minter)
+	require.True(t, found)
+	require.Equal(t, int64(60), stats.Burned.Amount.Int64())
+
+	_, err = srv.RejectRedemption(wctx, tokenfactorytypes.NewMsgRejectRedemption(minter, fulfilled))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrRedemption)
+
+	// rejecting returns the escrowed tokens, but not to a holder blacklisted in the meantime
+	res, err = srv.RequestRedemption(wctx, tokenfactorytypes.NewMsgRequestRedemption(holder, sdk.NewInt64Coin("uusdc", 40)))
+	require.NoError(t, err)
+	rejected := res.Id
+	k.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: holder})
+	_, err = srv.RejectRedemption(wctx, tokenfactorytypes.NewMsgRejectRedemption(minter, rejected))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrBlacklistedRecipient)
+	require.Equal(t, tokenfactorytypes.RedemptionPending, status(rejected))
+
+	k.RemoveBlacklisted(ctx, holder)
+	_, err = srv.RejectRedemption(wctx, tokenfactorytypes.NewMsgRejectRedemption(minter, rejected))
+	require.NoError(t, err)
+	holderBalance, moduleBalance, supply = balances()
+	require.Equal(t, []int64{40, 0, 40}, []int64{holderBalance, moduleBalance, supply})
+	require.Equal(t, tokenfactorytypes.RedemptionRejected, status(rejected))
+
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+	_, err = srv.RequestRedemption(wctx, tokenfactorytypes.NewMsgRequestRedemption(holder, sdk.NewInt64Coin("uusdc", 40)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrPaused)
+}
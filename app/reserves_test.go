@@ -0,0 +1,73 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestReserveEnforcementOnMint(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	wctx := sdk.WrapSDKContext(ctx)
+	k := heroApp.TokenfactoryKeeper
+	srv := tokenfactorykeeper.NewMsgServerImpl(k)
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	minter, attester, holder := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetMinters(ctx, tokenfactorytypes.Minters{Address: minter, Allowance: sdk.NewInt64Coin("uusdc", 1000)})
+	k.SetAttester(ctx, tokenfactorytypes.Attester{Address: attester})
+
+	mint := func(amount int64) error {
+		_, err := srv.Mint(wctx, tokenfactorytypes.NewMsgMint(minter, holder, sdk.NewInt64Coin("uusdc", amount)))
+		return err
+	}
+	attest := func(reserves int64, timestamp time.Time) error {
+		_, err := srv.SubmitReserveAttestation(wctx, tokenfactorytypes.NewMsgSubmitReserveAttestation(attester, sdk.NewInt64Coin("uusdc", reserves), timestamp, "auditor", "hash"))
+		return err
+	}
+
+	// mints are not limited by reserves until enforcement is enabled
+	require.NoError(t, mint(10))
+	k.SetParams(ctx, tokenfactorytypes.NewParams(true, tokenfactorytypes.DefaultRoleChangeDelay, tokenfactorytypes.DefaultFeeConversionRate))
+	require.ErrorIs(t, mint(10), tokenfactorytypes.ErrReservesExceeded)
+
+	// attestations from the future or older than the latest one are rejected
+	require.ErrorIs(t, attest(100, ctx.BlockTime().Add(time.Second)), tokenfactorytypes.ErrAttestation)
+	require.NoError(t, attest(100, ctx.BlockTime()))
+	require.ErrorIs(t, attest(1000, ctx.BlockTime().Add(-time.Second)), tokenfactorytypes.ErrAttestation)
+
+	// the total supply after the mint must stay within the latest attested reserves
+	require.NoError(t, mint(90))
+	require.ErrorIs(t, mint(1), tokenfactorytypes.ErrReservesExceeded)
+	require.Equal(t, int64(100), heroApp.BankKeeper.GetSupply(ctx, "uusdc").Amount.Int64())
+
+	require.NoError(t, attest(150, ctx.BlockTime()))
+	res, err := k.LatestReserveAttestation(wctx, &tokenfactorytypes.QueryLatestReserveAttestationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(150), res.ReserveAttestation.Reserves.Amount.Int64())
+	require.NoError(t, mint(50))
+	require.ErrorIs(t, mint(1), tokenfactorytypes.ErrReservesExceeded)
+
+	// a lower attestation blocks further mints without touching the existing supply
+	require.NoError(t, attest(120, ctx.BlockTime()))
+	require.ErrorIs(t, mint(1), tokenfactorytypes.ErrReservesExceeded)
+	require.Equal(t, int64(150), heroApp.BankKeeper.GetSupply(ctx, "uusdc").Amount.Int64())
+}
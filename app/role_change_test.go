@@ -0,0 +1,83 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestRoleChangeTimelockAfterUpgrade(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	k := heroApp.TokenfactoryKeeper
+	srv := tokenfactorykeeper.NewMsgServerImpl(k)
+
+	owner, pauser := sample.AccAddress(), sample.AccAddress()
+	updatePauser := func(address string) error {
+		_, err := srv.UpdatePauser(sdk.WrapSDKContext(chain.GetContext()), tokenfactorytypes.NewMsgUpdatePauser(owner, address))
+		return err
+	}
+	currentPauser := func() string {
+		holder, _ := k.GetRoleHolder(chain.GetContext(), tokenfactorytypes.RolePauser)
+		return holder
+	}
+
+	// a chain running the first version of the module has no role change delay param until the upgrade
+	ctx := chain.GetContext()
+	k.SetOwner(ctx, tokenfactorytypes.Owner{Address: owner})
+	clearPrefix(prefix.NewStore(ctx.KVStore(heroApp.GetKey(paramstypes.StoreKey)), []byte(tokenfactorytypes.ModuleName+"/")))
+	versions := heroApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	versions[tokenfactorytypes.ModuleName] = 1
+	heroApp.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+	require.Panics(t, func() { _ = updatePauser(pauser) })
+
+	heroApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeName, Height: ctx.BlockHeight()})
+	require.Equal(t, tokenfactorytypes.DefaultRoleChangeDelay, k.RoleChangeDelay(ctx))
+
+	// without a delay the role changes immediately
+	require.NoError(t, updatePauser(pauser))
+	require.Equal(t, pauser, currentPauser())
+
+	// with a delay the change is queued and executed at the beginning of the first block after the delay
+	k.SetParams(ctx, tokenfactorytypes.NewParams(tokenfactorytypes.DefaultEnforceReserves, time.Hour, tokenfactorytypes.DefaultFeeConversionRate))
+	next := sample.AccAddress()
+	require.NoError(t, updatePauser(next))
+	res, err := k.RoleChangeAll(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryAllRoleChangeRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.RoleChange, 1)
+	require.Equal(t, pauser, res.RoleChange[0].Previous)
+
+	nextBlockAfter := func(d time.Duration) {
+		chain.CurrentHeader.Time = chain.CurrentHeader.Time.Add(d)
+		chain.NextBlock()
+	}
+	nextBlockAfter(30 * time.Minute)
+	require.Equal(t, pauser, currentPauser())
+
+	nextBlockAfter(30 * time.Minute)
+	require.Equal(t, next, currentPauser())
+	require.Empty(t, k.GetAllRoleChange(chain.GetContext()))
+
+	// the outgoing holder can cancel a queued change before it executes
+	require.NoError(t, updatePauser(sample.AccAddress()))
+	roleChange := k.GetAllRoleChange(chain.GetContext())[0]
+	_, err = srv.CancelRoleChange(sdk.WrapSDKContext(chain.GetContext()), tokenfactorytypes.NewMsgCancelRoleChange(owner, roleChange.Id))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+	_, err = srv.CancelRoleChange(sdk.WrapSDKContext(chain.GetContext()), tokenfactorytypes.NewMsgCancelRoleChange(next, roleChange.Id))
+	require.NoError(t, err)
+
+	nextBlockAfter(time.Hour)
+	require.Equal(t, next, currentPauser())
+}
@@ -0,0 +1,65 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestTokenfactoryMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	cfg.EnableServiceLabel = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	k.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+	for i := 0; i < 3; i++ {
+		k.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: sample.AccAddress()})
+	}
+
+	// transfers rejected by the ante handler are counted even when only checked
+	decorator := app.NewTransferRulesDecorator(k)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	send := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)))
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(true), msgsTx{send}, false, next)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrPaused)
+	require.Equal(t, 1, sink.Data()[0].Counters["tokenfactory.rejected_transfer;source=ante;reason="+tokenfactorytypes.CheckReasonPaused.String()].Count)
+
+	// the state gauges are only set by the end of the block, not by checking transactions
+	require.Equal(t, float32(0), sink.Data()[0].Gauges["tokenfactory.paused"].Value)
+	require.Equal(t, float32(0), sink.Data()[0].Gauges["tokenfactory.blacklist_size"].Value)
+
+	chain.NextBlock()
+	require.Equal(t, float32(1), sink.Data()[0].Gauges["tokenfactory.paused"].Value)
+	require.Equal(t, float32(3), sink.Data()[0].Gauges["tokenfactory.blacklist_size"].Value)
+
+	ctx = chain.GetContext()
+	k.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+	k.RemoveBlacklisted(ctx, k.GetAllBlacklisted(ctx)[0].Address)
+	chain.NextBlock()
+	require.Equal(t, float32(0), sink.Data()[0].Gauges["tokenfactory.paused"].Value)
+	require.Equal(t, float32(2), sink.Data()[0].Gauges["tokenfactory.blacklist_size"].Value)
+}
@@ -0,0 +1,114 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestTransferWithAuthorization(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	wctx := sdk.WrapSDKContext(ctx)
+	srv := tokenfactorykeeper.NewMsgServerImpl(heroApp.TokenfactoryKeeper)
+
+	heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uusdc",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+	})
+	heroApp.TokenfactoryKeeper.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+
+	key := secp256k1.GenPrivKey()
+	holder := sdk.AccAddress(key.PubKey().Address())
+	account := heroApp.AccountKeeper.NewAccountWithAddress(ctx, holder)
+	require.NoError(t, account.SetPubKey(key.PubKey()))
+	heroApp.AccountKeeper.SetAccount(ctx, account)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	require.NoError(t, heroApp.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, funds))
+	require.NoError(t, heroApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, holder, funds))
+
+	relayer := sample.AccAddress()
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	authorizeTo := func(to sdk.AccAddress, nonce string, validAfter, validBefore time.Time) *tokenfactorytypes.MsgTransferWithAuthorization {
+		authorization := tokenfactorytypes.TransferAuthorization{
+			From:        holder.String(),
+			To:          to.String(),
+			Amount:      sdk.NewInt64Coin("uusdc", 10),
+			ValidAfter:  validAfter,
+			ValidBefore: validBefore,
+			Nonce:       nonce,
+		}
+		signature, err := key.Sign(authorization.GetSignBytes(ctx.ChainID()))
+		require.NoError(t, err)
+		return tokenfactorytypes.NewMsgTransferWithAuthorization(relayer, authorization, signature)
+	}
+	authorize := func(nonce string, validAfter, validBefore time.Time) *tokenfactorytypes.MsgTransferWithAuthorization {
+		return authorizeTo(recipient, nonce, validAfter, validBefore)
+	}
+
+	now := ctx.BlockTime()
+	msg := authorize("1", now.Add(-time.Minute), now.Add(time.Hour))
+
+	_, err := srv.TransferWithAuthorization(wctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), heroApp.BankKeeper.GetBalance(ctx, holder, "uusdc").Amount.Int64())
+	require.Equal(t, int64(10), heroApp.BankKeeper.GetBalance(ctx, recipient, "uusdc").Amount.Int64())
+
+	// replaying a used nonce fails
+	_, err = srv.TransferWithAuthorization(wctx, msg)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrAuthorization)
+
+	// authorizations outside of their validity window fail
+	_, err = srv.TransferWithAuthorization(wctx, authorize("2", now.Add(-time.Hour), now.Add(-time.Minute)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrAuthorization)
+	_, err = srv.TransferWithAuthorization(wctx, authorize("2", now.Add(time.Minute), now.Add(time.Hour)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrAuthorization)
+
+	// a signature over different terms fails
+	tampered := authorize("2", now.Add(-time.Minute), now.Add(time.Hour))
+	tampered.Authorization.Amount = sdk.NewInt64Coin("uusdc", 90)
+	_, err = srv.TransferWithAuthorization(wctx, tampered)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrAuthorization)
+
+	// a cancelled nonce can no longer be used
+	_, err = srv.CancelAuthorization(wctx, tokenfactorytypes.NewMsgCancelAuthorization(holder.String(), "3"))
+	require.NoError(t, err)
+	_, err = srv.TransferWithAuthorization(wctx, authorize("3", now.Add(-time.Minute), now.Add(time.Hour)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrAuthorization)
+
+	// module accounts cannot receive authorized transfers
+	feeCollector := heroApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = srv.TransferWithAuthorization(wctx, authorizeTo(feeCollector, "4", now.Add(-time.Minute), now.Add(time.Hour)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, heroApp.BankKeeper.GetBalance(ctx, feeCollector, "uusdc").IsZero())
+
+	// authorized transfers are refused while sending the denom is disabled
+	params := heroApp.BankKeeper.GetParams(ctx)
+	heroApp.BankKeeper.SetParams(ctx, params.SetSendEnabledParam("uusdc", false))
+	_, err = srv.TransferWithAuthorization(wctx, authorize("4", now.Add(-time.Minute), now.Add(time.Hour)))
+	require.ErrorIs(t, err, banktypes.ErrSendDisabled)
+	heroApp.BankKeeper.SetParams(ctx, params)
+
+	// blacklisted holders cannot transfer
+	heroApp.TokenfactoryKeeper.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: holder.String()})
+	_, err = srv.TransferWithAuthorization(wctx, authorize("4", now.Add(-time.Minute), now.Add(time.Hour)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrBlacklistedSender)
+	require.Equal(t, int64(90), heroApp.BankKeeper.GetBalance(ctx, holder, "uusdc").Amount.Int64())
+}
@@ -0,0 +1,87 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	heroadmintypes "github.com/strangelove-ventures/hero/x/heroadmin/types"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestUpgradeMigratesBaselineState(t *testing.T) {
+	chain := ibctesting.NewTestChain(t, icssimapp.NewBasicCoordinator(t), SetupTestingAppConsumer, "test")
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	k := heroApp.TokenfactoryKeeper
+
+	minterController := tokenfactorytypes.MinterController{Minter: sample.AccAddress(), Controller: sample.AccAddress()}
+	k.SetMinterController(ctx, minterController)
+	k.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: sample.AccAddress()})
+
+	// reset the tokenfactory state to the one of a chain running the first version of the module
+	store := ctx.KVStore(heroApp.GetKey(tokenfactorytypes.StoreKey))
+	for _, key := range [][]byte{
+		tokenfactorytypes.KeyPrefix(tokenfactorytypes.MinterControllerByMinterKeyPrefix),
+	} {
+		clearPrefix(prefix.NewStore(store, key))
+	}
+	store.Delete(tokenfactorytypes.KeyPrefix(tokenfactorytypes.BlacklistedCountKey))
+	store.Delete(tokenfactorytypes.PortKey)
+	store.Delete(tokenfactorytypes.BridgePortKey)
+
+	// the first version of the module had no params
+	clearPrefix(prefix.NewStore(ctx.KVStore(heroApp.GetKey(paramstypes.StoreKey)), []byte(tokenfactorytypes.ModuleName+"/")))
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	// the heroadmin module is added by the upgrade
+	clearPrefix(ctx.KVStore(heroApp.GetKey(heroadmintypes.StoreKey)))
+	versionStore := prefix.NewStore(ctx.KVStore(heroApp.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	versionStore.Delete([]byte(heroadmintypes.ModuleName))
+
+	versions := heroApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	versions[tokenfactorytypes.ModuleName] = 1
+	heroApp.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+
+	require.Empty(t, k.GetMinterControllersByMinter(ctx, minterController.Minter))
+	require.Zero(t, k.GetBlacklistedCount(ctx))
+	require.Empty(t, heroApp.HeroadminKeeper.GetAllWhitelistedProposalType(ctx))
+
+	heroApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeName, Height: ctx.BlockHeight()})
+
+	require.Equal(t, []tokenfactorytypes.MinterController{minterController}, k.GetMinterControllersByMinter(ctx, minterController.Minter))
+	require.Equal(t, tokenfactorytypes.DefaultParams(), k.GetParams(ctx))
+	require.Equal(t, uint64(len(k.GetAllBlacklisted(ctx))), k.GetBlacklistedCount(ctx))
+	require.Equal(t, tokenfactorytypes.PortID, k.GetPort(ctx))
+	require.Equal(t, tokenfactorytypes.BridgePortID, k.GetBridgePort(ctx))
+	require.True(t, k.IsBound(ctx, tokenfactorytypes.PortID))
+	require.True(t, k.IsBound(ctx, tokenfactorytypes.BridgePortID))
+	require.ElementsMatch(t, heroadmintypes.DefaultWhitelistedProposalTypes(), heroApp.HeroadminKeeper.GetAllWhitelistedProposalType(ctx))
+	require.ElementsMatch(t, heroadmintypes.DefaultWhitelistedParams(), heroApp.HeroadminKeeper.GetAllWhitelistedParam(ctx))
+	require.Equal(t, heroadmintypes.DefaultAdminProposalExpiry, heroApp.HeroadminKeeper.GetAdminProposalExpiry(ctx))
+
+	versions = heroApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(4), versions[tokenfactorytypes.ModuleName])
+	require.Equal(t, uint64(1), versions[heroadmintypes.ModuleName])
+}
+
+func clearPrefix(store sdk.KVStore) {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagMsgFilter enables the filter of the messages delivered before the CCV channel is established
+	FlagMsgFilter = "ccv.msg-filter"
+	// FlagPreCCVAllowedMsgs sets the messages allowed before the CCV channel is established
+	FlagPreCCVAllowedMsgs = "ccv.pre-ccv-allowed-msgs"
+)
+
+// DefaultPreCCVAllowedMsgs are the type URL prefixes of the messages allowed before the CCV
+// channel is established: IBC messages, so that the channel can be opened and clients updated,
+// and tokenfactory messages, so that the token can be administered.
+var DefaultPreCCVAllowedMsgs = []string{"/ibc.", "/hero.tokenfactory."}
+
+// CCVConfig defines the interchain security section of the app.toml file.
+type CCVConfig struct {
+	// MsgFilter rejects the transactions holding messages that are not allowed before the CCV
+	// channel to the provider chain is established
+	MsgFilter bool `mapstructure:"msg-filter"`
+	// PreCCVAllowedMsgs are the type URL prefixes of the messages allowed before the CCV channel
+	// is established
+	PreCCVAllowedMsgs []string `mapstructure:"pre-ccv-allowed-msgs"`
+}
+
+// DefaultCCVConfig returns the interchain security section written to new app.toml files, with
+// the message filter enabled.
+func DefaultCCVConfig() CCVConfig {
+	return CCVConfig{
+		MsgFilter:         true,
+		PreCCVAllowedMsgs: DefaultPreCCVAllowedMsgs,
+	}
+}
+
+// CCVConfigFromAppOptions reads the interchain security section of the app options. The message
+// filter is only disabled by an explicit msg-filter = false, so that nodes whose app.toml predates
+// the section keep filtering, and the default allowlist is used when no message is allowed.
+func CCVConfigFromAppOptions(appOpts servertypes.AppOptions) CCVConfig {
+	config := CCVConfig{
+		MsgFilter:         true,
+		PreCCVAllowedMsgs: cast.ToStringSlice(appOpts.Get(FlagPreCCVAllowedMsgs)),
+	}
+	if msgFilter := appOpts.Get(FlagMsgFilter); msgFilter != nil {
+		config.MsgFilter = cast.ToBool(msgFilter)
+	}
+	if len(config.PreCCVAllowedMsgs) == 0 {
+		config.PreCCVAllowedMsgs = DefaultPreCCVAllowedMsgs
+	}
+	return config
+}
+
+// CCVConfigTemplate is the app.toml template of the interchain security section.
+const CCVConfigTemplate = `
+###############################################################################
+###                        Interchain Security                              ###
+###############################################################################
+
+[ccv]
+
+# Reject the transactions holding messages that are not allowed before the CCV channel to the
+# provider chain is established. Disable to run the chain locally without a provider chain.
+msg-filter = {{ .CCV.MsgFilter }}
+
+# Type URL prefixes of the messages allowed before the CCV channel is established.
+pre-ccv-allowed-msgs = [{{ range .CCV.PreCCVAllowedMsgs }}{{ printf "%q, " . }}{{ end }}]
+`
@@ -419,6 +419,8 @@ func (a appCreator) appExport(
 func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		CCV CCVConfig `mapstructure:"ccv"`
 	}
 
 	srvCfg := serverconfig.DefaultConfig()
@@ -427,7 +429,8 @@ func initAppConfig() (string, interface{}) {
 
 	config := CustomAppConfig{
 		Config: *srvCfg,
+		CCV:    DefaultCCVConfig(),
 	}
 
-	return serverconfig.DefaultConfigTemplate, config
+	return serverconfig.DefaultConfigTemplate + CCVConfigTemplate, config
 }
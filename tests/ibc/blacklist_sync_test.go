@@ -0,0 +1,251 @@
+package ibc_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory"
+	tokenfactorykeeper "github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// BlacklistSyncTestSuite sends blacklist updates from the blacklister of chainA to chainB over a
+// channel between the blacklist sync ports of two in-process Hero chains
+type BlacklistSyncTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestBlacklistSyncTestSuite(t *testing.T) {
+	suite.Run(t, new(BlacklistSyncTestSuite))
+}
+
+func (s *BlacklistSyncTestSuite) SetupTest() {
+	s.coordinator, s.chainA, s.chainB = newCoordinator(s.T())
+	s.path = newPath(s.chainA, s.chainB, tokenfactorytypes.PortID, tokenfactorytypes.Version)
+	s.path.SetChannelOrdered()
+}
+
+// approveChannel adds the channel to the blacklist sync channels of the chain as its owner
+func (s *BlacklistSyncTestSuite) approveChannel(chain *ibctesting.TestChain, channelID string) error {
+	heroApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+	owner := sample.AccAddress()
+	heroApp.TokenfactoryKeeper.SetOwner(ctx, tokenfactorytypes.Owner{Address: owner})
+	srv := tokenfactorykeeper.NewMsgServerImpl(heroApp.TokenfactoryKeeper)
+	_, err := srv.AddBlacklistSyncChannel(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgAddBlacklistSyncChannel(owner, channelID))
+	return err
+}
+
+// setupChannel opens the channel and approves it on both chains, with the sender account of chainA
+// as its blacklister
+func (s *BlacklistSyncTestSuite) setupChannel() {
+	s.coordinator.Setup(s.path)
+	s.Require().NoError(s.approveChannel(s.chainA, s.path.EndpointA.ChannelID))
+	s.Require().NoError(s.approveChannel(s.chainB, s.path.EndpointB.ChannelID))
+
+	heroApp := s.chainA.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklister(s.chainA.GetContext(), tokenfactorytypes.Blacklister{Address: s.chainA.SenderAccount.GetAddress().String()})
+	s.coordinator.CommitBlock(s.chainA, s.chainB)
+}
+
+// broadcast sends the blacklist state of address on chainA and returns the sent packet
+func (s *BlacklistSyncTestSuite) broadcast(address string) channeltypes.Packet {
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(2 * tokenfactorytypes.MinBlacklistUpdateTimeout).UnixNano())
+	res, err := s.chainA.SendMsgs(tokenfactorytypes.NewMsgBroadcastBlacklistUpdate(s.chainA.SenderAccount.GetAddress().String(), address, timeoutTimestamp))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	return packet
+}
+
+// isBlacklisted returns whether address is blacklisted on the chain
+func (s *BlacklistSyncTestSuite) isBlacklisted(chain *ibctesting.TestChain, address string) bool {
+	heroApp := chain.App.(*app.App)
+	_, found := heroApp.TokenfactoryKeeper.GetBlacklisted(chain.GetContext(), address)
+	return found
+}
+
+func (s *BlacklistSyncTestSuite) TestBlacklistSync() {
+	s.setupChannel()
+	address := sample.AccAddress()
+
+	heroApp := s.chainA.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(s.chainA.GetContext(), tokenfactorytypes.Blacklisted{Address: address})
+	packet := s.broadcast(address)
+
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().True(s.isBlacklisted(s.chainB, address))
+
+	heroApp.TokenfactoryKeeper.RemoveBlacklisted(s.chainA.GetContext(), address)
+	packet = s.broadcast(address)
+
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().False(s.isBlacklisted(s.chainB, address))
+}
+
+func (s *BlacklistSyncTestSuite) TestUnauthorizedChannel() {
+	s.setupChannel()
+	address := sample.AccAddress()
+
+	// chainB no longer accepts updates from chainA
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.RemoveBlacklistSyncChannel(s.chainB.GetContext(), s.path.EndpointB.ChannelID)
+
+	heroApp = s.chainA.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(s.chainA.GetContext(), tokenfactorytypes.Blacklisted{Address: address})
+	packet := s.broadcast(address)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+	s.Require().False(s.isBlacklisted(s.chainB, address))
+
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrNotSyncChannel.Error())
+
+	// chainA accepts the error acknowledgement
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	commitment := heroApp.IBCKeeper.ChannelKeeper.GetPacketCommitment(s.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	s.Require().Empty(commitment)
+}
+
+func (s *BlacklistSyncTestSuite) TestHandshakeValidation() {
+	heroApp := s.chainA.App.(*app.App)
+	module := tokenfactory.NewBlacklistSyncIBCModule(heroApp.TokenfactoryKeeper)
+	ctx := s.chainA.GetContext()
+	counterparty := channeltypes.NewCounterparty(tokenfactorytypes.PortID, "channel-0")
+
+	// the blacklist sync application only accepts ordered channels of its version on its port
+	err := module.OnChanOpenInit(ctx, channeltypes.UNORDERED, nil, tokenfactorytypes.PortID, "channel-0", nil, counterparty, tokenfactorytypes.Version)
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+	err = module.OnChanOpenInit(ctx, channeltypes.ORDERED, nil, "transfer", "channel-0", nil, counterparty, tokenfactorytypes.Version)
+	s.Require().ErrorIs(err, porttypes.ErrInvalidPort)
+	err = module.OnChanOpenInit(ctx, channeltypes.ORDERED, nil, tokenfactorytypes.PortID, "channel-0", nil, counterparty, "blacklistsync-2")
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelVersion)
+
+	_, err = module.OnChanOpenTry(ctx, channeltypes.ORDERED, nil, tokenfactorytypes.PortID, "channel-0", nil, counterparty, "blacklistsync-2")
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelVersion)
+	err = module.OnChanOpenAck(ctx, tokenfactorytypes.PortID, "channel-0", "channel-0", "blacklistsync-2")
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelVersion)
+
+	// channels can not be closed by users
+	err = module.OnChanCloseInit(ctx, tokenfactorytypes.PortID, "channel-0")
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	s.setupChannel()
+	s.Require().Equal(tokenfactorytypes.Version, s.path.EndpointA.GetChannel().Version)
+	s.Require().Equal(tokenfactorytypes.Version, s.path.EndpointB.GetChannel().Version)
+}
+
+func (s *BlacklistSyncTestSuite) TestChannelApproval() {
+	address := sample.AccAddress()
+	heroApp := s.chainA.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklister(s.chainA.GetContext(), tokenfactorytypes.Blacklister{Address: s.chainA.SenderAccount.GetAddress().String()})
+	heroApp.TokenfactoryKeeper.SetBlacklisted(s.chainA.GetContext(), tokenfactorytypes.Blacklisted{Address: address})
+
+	// channels that are not open on the blacklist sync port can not be approved
+	s.coordinator.SetupConnections(s.path)
+	s.Require().ErrorIs(s.approveChannel(s.chainA, "channel-0"), tokenfactorytypes.ErrSyncChannelNotOpen)
+	s.Require().NoError(s.path.EndpointA.ChanOpenInit())
+	s.Require().ErrorIs(s.approveChannel(s.chainA, s.path.EndpointA.ChannelID), tokenfactorytypes.ErrSyncChannelNotOpen)
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+
+	// a channel opened by a third party carries no updates until the owner approves it
+	ctx := s.chainA.GetContext()
+	srv := tokenfactorykeeper.NewMsgServerImpl(heroApp.TokenfactoryKeeper)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(2 * tokenfactorytypes.MinBlacklistUpdateTimeout).UnixNano())
+	_, err := srv.BroadcastBlacklistUpdate(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgBroadcastBlacklistUpdate(s.chainA.SenderAccount.GetAddress().String(), address, timeoutTimestamp))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrNotSyncChannel)
+
+	s.Require().NoError(s.approveChannel(s.chainA, s.path.EndpointA.ChannelID))
+	packet := s.broadcast(address)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().Contains(string(ackBytes), tokenfactorytypes.ErrNotSyncChannel.Error())
+	s.Require().False(s.isBlacklisted(s.chainB, address))
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+
+	s.Require().NoError(s.approveChannel(s.chainB, s.path.EndpointB.ChannelID))
+	packet = s.broadcast(address)
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().True(s.isBlacklisted(s.chainB, address))
+}
+
+func (s *BlacklistSyncTestSuite) TestMinimumTimeout() {
+	s.setupChannel()
+
+	heroApp := s.chainA.App.(*app.App)
+	ctx := s.chainA.GetContext()
+	srv := tokenfactorykeeper.NewMsgServerImpl(heroApp.TokenfactoryKeeper)
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Minute).UnixNano())
+	_, err := srv.BroadcastBlacklistUpdate(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgBroadcastBlacklistUpdate(s.chainA.SenderAccount.GetAddress().String(), sample.AccAddress(), timeoutTimestamp))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrInvalidPacket)
+}
+
+func (s *BlacklistSyncTestSuite) TestTimeoutReplacesChannel() {
+	s.setupChannel()
+	address := sample.AccAddress()
+
+	heroApp := s.chainA.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(s.chainA.GetContext(), tokenfactorytypes.Blacklisted{Address: address})
+	packet := s.broadcast(address)
+
+	// the timeout closes the ordered channel, which is no longer used for updates on either chain
+	s.coordinator.IncrementTimeBy(3 * tokenfactorytypes.MinBlacklistUpdateTimeout)
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+	s.Require().Equal(channeltypes.CLOSED, s.path.EndpointA.GetChannel().State)
+	_, found := heroApp.TokenfactoryKeeper.GetBlacklistSyncChannel(s.chainA.GetContext(), s.path.EndpointA.ChannelID)
+	s.Require().False(found)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	proof, height := s.chainA.QueryProof(host.ChannelKey(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID))
+	_, err := s.chainB.SendMsgs(channeltypes.NewMsgChannelCloseConfirm(
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, proof, height, s.chainB.SenderAccount.GetAddress().String(),
+	))
+	s.Require().NoError(err)
+	heroApp = s.chainB.App.(*app.App)
+	_, found = heroApp.TokenfactoryKeeper.GetBlacklistSyncChannel(s.chainB.GetContext(), s.path.EndpointB.ChannelID)
+	s.Require().False(found)
+
+	// the owners add a replacement channel on the same connection
+	replacement := newPath(s.chainA, s.chainB, tokenfactorytypes.PortID, tokenfactorytypes.Version)
+	replacement.SetChannelOrdered()
+	replacement.EndpointA.ClientID, replacement.EndpointB.ClientID = s.path.EndpointA.ClientID, s.path.EndpointB.ClientID
+	replacement.EndpointA.ConnectionID, replacement.EndpointB.ConnectionID = s.path.EndpointA.ConnectionID, s.path.EndpointB.ConnectionID
+	s.coordinator.CreateChannels(replacement)
+	s.Require().NoError(s.approveChannel(s.chainA, replacement.EndpointA.ChannelID))
+	s.Require().NoError(s.approveChannel(s.chainB, replacement.EndpointB.ChannelID))
+	s.path = replacement
+
+	packet = s.broadcast(address)
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().True(s.isBlacklisted(s.chainB, address))
+}
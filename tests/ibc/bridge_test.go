@@ -0,0 +1,323 @@
+package ibc_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/x/tokenfactory"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// BridgeTestSuite burns uusdc on one in-process Hero chain and mints it on the other over a
+// channel between their bridge ports. The sender account of chainA starts with 1000uusdc and the
+// bridge minter of chainB may mint 500uusdc.
+type BridgeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestBridgeTestSuite(t *testing.T) {
+	suite.Run(t, new(BridgeTestSuite))
+}
+
+func (s *BridgeTestSuite) SetupTest() {
+	s.coordinator, s.chainA, s.chainB = newCoordinator(s.T())
+	s.path = newPath(s.chainA, s.chainB, tokenfactorytypes.BridgePortID, tokenfactorytypes.BridgeVersion)
+	s.coordinator.Setup(s.path)
+
+	for _, endpoint := range []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB} {
+		heroApp := endpoint.Chain.App.(*app.App)
+		ctx := endpoint.Chain.GetContext()
+
+		heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:       "uusdc",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+		})
+		heroApp.TokenfactoryKeeper.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+		heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+		heroApp.TokenfactoryKeeper.SetBridgeRoute(ctx, tokenfactorytypes.BridgeRoute{
+			ChannelId: endpoint.ChannelID,
+			Sent:      sdk.ZeroInt(),
+			Received:  sdk.ZeroInt(),
+			InFlight:  sdk.ZeroInt(),
+		})
+	}
+
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetMinters(s.chainB.GetContext(), tokenfactorytypes.Minters{
+		Address:   tokenfactorytypes.BridgeMinterAddress().String(),
+		Allowance: sdk.NewInt64Coin("uusdc", 500),
+	})
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	heroApp = s.chainA.App.(*app.App)
+	s.Require().NoError(heroApp.BankKeeper.MintCoins(s.chainA.GetContext(), tokenfactorytypes.ModuleName, funds))
+	s.Require().NoError(heroApp.BankKeeper.SendCoinsFromModuleToAccount(s.chainA.GetContext(), tokenfactorytypes.ModuleName, s.chainA.SenderAccount.GetAddress(), funds))
+	s.coordinator.CommitBlock(s.chainA, s.chainB)
+}
+
+// bridgeTransfer burns amount uusdc from the sender account of chainA to be minted to the sender
+// account of chainB and returns the sent packet
+func (s *BridgeTestSuite) bridgeTransfer(amount int64, timeoutTimestamp uint64) channeltypes.Packet {
+	res, err := s.chainA.SendMsgs(tokenfactorytypes.NewMsgBridgeTransfer(
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.path.EndpointA.ChannelID,
+		s.chainB.SenderAccount.GetAddress().String(),
+		sdk.NewInt64Coin("uusdc", amount),
+		timeoutTimestamp,
+	))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	return packet
+}
+
+// timeout returns a timeout timestamp far after the current time of chainB
+func (s *BridgeTestSuite) timeout() uint64 {
+	return uint64(s.chainB.GetContext().BlockTime().UnixNano()) + 1e12
+}
+
+// balance returns the uusdc balance of the sender account of the chain
+func (s *BridgeTestSuite) balance(chain *ibctesting.TestChain) int64 {
+	heroApp := chain.App.(*app.App)
+	return heroApp.BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), "uusdc").Amount.Int64()
+}
+
+// supply returns the uusdc supply of the chain
+func (s *BridgeTestSuite) supply(chain *ibctesting.TestChain) int64 {
+	heroApp := chain.App.(*app.App)
+	return heroApp.BankKeeper.GetSupply(chain.GetContext(), "uusdc").Amount.Int64()
+}
+
+// route returns the bridge route of the endpoint
+func (s *BridgeTestSuite) route(endpoint *ibctesting.Endpoint) tokenfactorytypes.BridgeRoute {
+	heroApp := endpoint.Chain.App.(*app.App)
+	route, found := heroApp.TokenfactoryKeeper.GetBridgeRoute(endpoint.Chain.GetContext(), endpoint.ChannelID)
+	s.Require().True(found)
+	return route
+}
+
+// recv receives the packet on chainB and returns the written acknowledgement
+func (s *BridgeTestSuite) recv(packet channeltypes.Packet) ([]byte, channeltypes.Acknowledgement) {
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ackBytes, ack
+}
+
+func (s *BridgeTestSuite) TestBridgeTransfer() {
+	packet := s.bridgeTransfer(100, s.timeout())
+	s.Require().Equal(int64(900), s.balance(s.chainA))
+	s.Require().Equal(int64(900), s.supply(s.chainA))
+	s.Require().Equal(sdk.NewInt(100), s.route(s.path.EndpointA).InFlight)
+
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	// chainB mints native uusdc within the allowance of its bridge minter
+	s.Require().Equal(int64(100), s.balance(s.chainB))
+	s.Require().Equal(int64(100), s.supply(s.chainB))
+	heroApp := s.chainB.App.(*app.App)
+	minter, found := heroApp.TokenfactoryKeeper.GetMinters(s.chainB.GetContext(), tokenfactorytypes.BridgeMinterAddress().String())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin("uusdc", 400), minter.Allowance)
+
+	routeA := s.route(s.path.EndpointA)
+	s.Require().True(routeA.InFlight.IsZero())
+	s.Require().Equal(sdk.NewInt(100), routeA.Sent)
+	s.Require().Equal(sdk.NewInt(100), s.route(s.path.EndpointB).Received)
+}
+
+func (s *BridgeTestSuite) TestAllowanceExceededRefundsSender() {
+	packet := s.bridgeTransfer(600, s.timeout())
+	s.Require().Equal(int64(400), s.balance(s.chainA))
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrAllowanceExceeded.Error())
+
+	// nothing is minted on chainB
+	s.Require().Equal(int64(0), s.supply(s.chainB))
+	s.Require().True(s.route(s.path.EndpointB).Received.IsZero())
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+	s.Require().Equal(int64(1000), s.supply(s.chainA))
+
+	routeA := s.route(s.path.EndpointA)
+	s.Require().True(routeA.InFlight.IsZero())
+	s.Require().True(routeA.Sent.IsZero())
+}
+
+func (s *BridgeTestSuite) TestBlacklistedReceiverRefundsSender() {
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(s.chainB.GetContext(), tokenfactorytypes.Blacklisted{Address: s.chainB.SenderAccount.GetAddress().String()})
+
+	packet := s.bridgeTransfer(100, s.timeout())
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrBlacklistedRecipient.Error())
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestUnknownRouteRefundsSender() {
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.RemoveBridgeRoute(s.chainB.GetContext(), s.path.EndpointB.ChannelID)
+
+	packet := s.bridgeTransfer(100, s.timeout())
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrNotBridgeRoute.Error())
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestTimeoutRefundsSender() {
+	packet := s.bridgeTransfer(100, uint64(s.chainB.GetContext().BlockTime().UnixNano())+1)
+	s.Require().Equal(int64(900), s.balance(s.chainA))
+
+	s.coordinator.CommitNBlocks(s.chainB, 3)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+	s.Require().Equal(int64(1000), s.supply(s.chainA))
+	s.Require().True(s.route(s.path.EndpointA).InFlight.IsZero())
+	s.Require().Equal(int64(0), s.supply(s.chainB))
+}
+
+func (s *BridgeTestSuite) TestBridgeStatsRecordedForBridgeMinter() {
+	packet := s.bridgeTransfer(100, uint64(s.chainB.GetContext().BlockTime().UnixNano())+1)
+
+	s.coordinator.CommitNBlocks(s.chainB, 3)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+
+	// the burn and the refund are recorded for the bridge minter rather than the sender
+	heroApp := s.chainA.App.(*app.App)
+	ctx := s.chainA.GetContext()
+	stats, found := heroApp.TokenfactoryKeeper.GetMinterStats(ctx, tokenfactorytypes.BridgeMinterAddress().String())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin("uusdc", 100), stats.Burned)
+	s.Require().Equal(sdk.NewInt64Coin("uusdc", 100), stats.Minted)
+	_, found = heroApp.TokenfactoryKeeper.GetMinterStats(ctx, s.chainA.SenderAccount.GetAddress().String())
+	s.Require().False(found)
+
+	// so are the mints of received transfers
+	s.Require().NoError(s.path.RelayPacket(s.bridgeTransfer(100, s.timeout())))
+	heroApp = s.chainB.App.(*app.App)
+	stats, found = heroApp.TokenfactoryKeeper.GetMinterStats(s.chainB.GetContext(), tokenfactorytypes.BridgeMinterAddress().String())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin("uusdc", 100), stats.Minted)
+}
+
+func (s *BridgeTestSuite) TestReservesExceededRefundsSender() {
+	heroApp := s.chainB.App.(*app.App)
+	ctx := s.chainB.GetContext()
+	params := tokenfactorytypes.DefaultParams()
+	params.EnforceReserves = true
+	heroApp.TokenfactoryKeeper.SetParams(ctx, params)
+	heroApp.TokenfactoryKeeper.AppendReserveAttestation(ctx, tokenfactorytypes.ReserveAttestation{
+		Reserves: sdk.NewInt64Coin("uusdc", 50),
+	})
+
+	packet := s.bridgeTransfer(100, s.timeout())
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrReservesExceeded.Error())
+	s.Require().Equal(int64(0), s.supply(s.chainB))
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestPausedRefundsSender() {
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.SetPaused(s.chainB.GetContext(), tokenfactorytypes.Paused{Paused: true})
+
+	packet := s.bridgeTransfer(100, s.timeout())
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrPaused.Error())
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestBridgeMinterNotSetRefundsSender() {
+	heroApp := s.chainB.App.(*app.App)
+	heroApp.TokenfactoryKeeper.RemoveMinters(s.chainB.GetContext(), tokenfactorytypes.BridgeMinterAddress().String())
+
+	packet := s.bridgeTransfer(100, s.timeout())
+
+	ackBytes, ack := s.recv(packet)
+	s.Require().False(ack.Success())
+	s.Require().Contains(ack.GetError(), tokenfactorytypes.ErrBridgeMinterNotSet.Error())
+
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ackBytes))
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestBridgeTransferWhilePaused() {
+	heroApp := s.chainA.App.(*app.App)
+	ctx := s.chainA.GetContext()
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+
+	srv := keeper.NewMsgServerImpl(heroApp.TokenfactoryKeeper)
+	_, err := srv.BridgeTransfer(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgBridgeTransfer(
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.path.EndpointA.ChannelID,
+		s.chainB.SenderAccount.GetAddress().String(),
+		sdk.NewInt64Coin("uusdc", 100),
+		s.timeout(),
+	))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrPaused)
+	s.Require().Equal(int64(1000), s.balance(s.chainA))
+}
+
+func (s *BridgeTestSuite) TestHandshakeValidation() {
+	heroApp := s.chainA.App.(*app.App)
+	module := tokenfactory.NewIBCModule(heroApp.TokenfactoryKeeper)
+	ctx := s.chainA.GetContext()
+	counterparty := channeltypes.NewCounterparty(tokenfactorytypes.BridgePortID, "channel-1")
+
+	// the bridge only accepts unordered channels of its version
+	err := module.OnChanOpenInit(ctx, channeltypes.ORDERED, nil, tokenfactorytypes.BridgePortID, "channel-1", nil, counterparty, tokenfactorytypes.BridgeVersion)
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+	err = module.OnChanOpenInit(ctx, channeltypes.UNORDERED, nil, tokenfactorytypes.BridgePortID, "channel-1", nil, counterparty, tokenfactorytypes.Version)
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelVersion)
+
+	// channels of the blacklist sync port are still routed to the blacklist sync application
+	err = module.OnChanOpenInit(ctx, channeltypes.UNORDERED, nil, tokenfactorytypes.PortID, "channel-1", nil, counterparty, tokenfactorytypes.Version)
+	s.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+	err = module.OnChanOpenInit(ctx, channeltypes.ORDERED, nil, "transfer", "channel-1", nil, counterparty, tokenfactorytypes.Version)
+	s.Require().ErrorIs(err, porttypes.ErrInvalidPort)
+
+	s.Require().Equal(tokenfactorytypes.BridgeVersion, s.path.EndpointA.GetChannel().Version)
+	s.Require().Equal(channeltypes.UNORDERED, s.path.EndpointA.GetChannel().Ordering)
+}
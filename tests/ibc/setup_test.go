@@ -0,0 +1,79 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	icssimapp "github.com/cosmos/interchain-security/testutil/simapp"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/strangelove-ventures/hero/app"
+	"github.com/strangelove-ventures/hero/cmd"
+)
+
+// newCoordinator returns a coordinator of two Hero chains that counterparty clients can be
+// created and updated on
+func newCoordinator(t *testing.T) (*ibctesting.Coordinator, *ibctesting.TestChain, *ibctesting.TestChain) {
+	coordinator := icssimapp.NewBasicCoordinator(t)
+	for i := 1; i <= 2; i++ {
+		chainID := ibctesting.GetChainID(i)
+		chain := ibctesting.NewTestChain(t, coordinator, SetupTestingAppConsumer, chainID)
+
+		heroApp := chain.App.(*app.App)
+		ctx := chain.GetContext()
+
+		// the account numbers assigned by the app differ from the ones of the genesis accounts
+		chain.SenderAccount = heroApp.AccountKeeper.GetAccount(ctx, chain.SenderAccount.GetAddress())
+
+		// without a provider, the consumer module has no validators to record the historical info
+		// that counterparty clients are updated with
+		for _, val := range chain.Vals.Validators {
+			pubKey, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+			require.NoError(t, err)
+			ccValidator, err := consumertypes.NewCCValidator(val.Address, val.VotingPower, pubKey)
+			require.NoError(t, err)
+			heroApp.ConsumerKeeper.SetCCValidator(ctx, ccValidator)
+		}
+		coordinator.CommitBlock(chain)
+		coordinator.Chains[chainID] = chain
+	}
+
+	return coordinator, coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+// newPath returns a path between the chains whose channel uses the port and version on both ends
+func newPath(chainA, chainB *ibctesting.TestChain, portID, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = portID
+		endpoint.ChannelConfig.Version = version
+
+		// clients must match the unbonding period of the consumer module
+		heroApp := endpoint.Chain.App.(*app.App)
+		endpoint.ClientConfig.(*ibctesting.TendermintConfig).UnbondingPeriod = heroApp.ConsumerKeeper.UnbondingTime(endpoint.Chain.GetContext())
+	}
+	return path
+}
+
+func SetupTestingAppConsumer() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := cmd.MakeEncodingConfig(app.ModuleBasics)
+	testApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		0,
+		encoding,
+		simapp.EmptyAppOptions{},
+	)
+
+	return testApp.(*app.App), app.NewDefaultGenesisState(encoding.Marshaler)
+}
@@ -0,0 +1,248 @@
+package ibc_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcsimapp "github.com/cosmos/ibc-go/v3/testing/simapp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/strangelove-ventures/hero/app"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// TokenfactoryIBCTestSuite runs transfers of the minting denom between two in-process Hero chains
+// connected by a transfer channel. Both chains mint uusdc, and the sender account of chainA starts
+// with 1000uusdc.
+type TokenfactoryIBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+
+	// voucher is the denom of uusdc of chainA on chainB
+	voucher string
+}
+
+func TestTokenfactoryIBCTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenfactoryIBCTestSuite))
+}
+
+func (s *TokenfactoryIBCTestSuite) SetupTest() {
+	s.coordinator, s.chainA, s.chainB = newCoordinator(s.T())
+	s.path = newPath(s.chainA, s.chainB, ibctesting.TransferPort, transfertypes.Version)
+	s.coordinator.Setup(s.path)
+
+	for _, endpoint := range []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB} {
+		heroApp := endpoint.Chain.App.(*app.App)
+		ctx := endpoint.Chain.GetContext()
+
+		heroApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:       "uusdc",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc"}},
+		})
+		heroApp.TokenfactoryKeeper.SetMintingDenom(ctx, tokenfactorytypes.MintingDenom{Denom: "uusdc"})
+		heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: false})
+		heroApp.TokenfactoryKeeper.SetAllowedChannel(ctx, tokenfactorytypes.AllowedChannel{ChannelId: endpoint.ChannelID})
+	}
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	heroApp := s.chainA.App.(*app.App)
+	s.Require().NoError(heroApp.BankKeeper.MintCoins(s.chainA.GetContext(), tokenfactorytypes.ModuleName, funds))
+	s.Require().NoError(heroApp.BankKeeper.SendCoinsFromModuleToAccount(s.chainA.GetContext(), tokenfactorytypes.ModuleName, s.chainA.SenderAccount.GetAddress(), funds))
+	s.coordinator.CommitBlock(s.chainA, s.chainB)
+
+	s.voucher = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, "uusdc")).IBCDenom()
+}
+
+// transferMsg returns a MsgTransfer of amount from the sender account of the source endpoint to the
+// sender account of its counterparty
+func (s *TokenfactoryIBCTestSuite) transferMsg(source *ibctesting.Endpoint, amount sdk.Coin, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		source.ChannelConfig.PortID, source.ChannelID, amount,
+		source.Chain.SenderAccount.GetAddress().String(), source.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0,
+	)
+}
+
+// sendTransfer sends amount to the counterparty of the source endpoint and returns the sent packet
+func (s *TokenfactoryIBCTestSuite) sendTransfer(source *ibctesting.Endpoint, amount sdk.Coin) channeltypes.Packet {
+	res, err := source.Chain.SendMsgs(s.transferMsg(source, amount, clienttypes.NewHeight(0, 110)))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	return packet
+}
+
+// sendFailingTransfer delivers a transfer that is expected to be rejected and returns the error
+func (s *TokenfactoryIBCTestSuite) sendFailingTransfer(source *ibctesting.Endpoint, amount sdk.Coin) error {
+	return s.sendFailingMsgs(source.Chain, s.transferMsg(source, amount, clienttypes.NewHeight(0, 110)))
+}
+
+// sendFailingMsgs delivers a tx of the sender account of the chain that is expected to be rejected
+// and returns the error
+func (s *TokenfactoryIBCTestSuite) sendFailingMsgs(chain *ibctesting.TestChain, msgs ...sdk.Msg) error {
+	_, _, err := ibcsimapp.SignAndDeliver(
+		s.T(), chain.TxConfig, chain.App.GetBaseApp(), chain.GetContext().BlockHeader(),
+		msgs, chain.ChainID, []uint64{chain.SenderAccount.GetAccountNumber()}, []uint64{chain.SenderAccount.GetSequence()},
+		false, false, chain.SenderPrivKey,
+	)
+	return err
+}
+
+// balance returns the balance of denom of the sender account of the chain
+func (s *TokenfactoryIBCTestSuite) balance(chain *ibctesting.TestChain, denom string) int64 {
+	heroApp := chain.App.(*app.App)
+	return heroApp.BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), denom).Amount.Int64()
+}
+
+// keeper returns the app of the chain and its current context
+func (s *TokenfactoryIBCTestSuite) keeper(chain *ibctesting.TestChain) (*app.App, sdk.Context) {
+	return chain.App.(*app.App), chain.GetContext()
+}
+
+func (s *TokenfactoryIBCTestSuite) TestTransferRoundTrip() {
+	packet := s.sendTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(100), s.balance(s.chainB, s.voucher))
+
+	packet = s.sendTransfer(s.path.EndpointB, sdk.NewInt64Coin(s.voucher, 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(0), s.balance(s.chainB, s.voucher))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestTimeoutRefundsSender() {
+	timeoutHeight := clienttypes.NewHeight(0, uint64(s.chainB.CurrentHeader.Height)+1)
+
+	res, err := s.chainA.SendMsgs(s.transferMsg(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100), timeoutHeight))
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+
+	s.coordinator.CommitNBlocks(s.chainB, 3)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(0), s.balance(s.chainB, s.voucher))
+	heroApp, ctx := s.keeper(s.chainA)
+	commitment := heroApp.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	s.Require().Empty(commitment)
+}
+
+func (s *TokenfactoryIBCTestSuite) TestAnteRejectsBlacklistedSender() {
+	heroApp, ctx := s.keeper(s.chainA)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: s.chainA.SenderAccount.GetAddress().String()})
+
+	err := s.sendFailingTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrBlacklistedSender)
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestAnteRejectsTransferWhilePaused() {
+	heroApp, ctx := s.keeper(s.chainA)
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+
+	err := s.sendFailingTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrPaused)
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestErrorAckRefundsSender() {
+	// chainB refuses its minting denom for blacklisted receivers
+	heroApp, ctx := s.keeper(s.chainB)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: s.chainB.SenderAccount.GetAddress().String()})
+
+	packet := s.sendTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(0), s.balance(s.chainB, s.voucher))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestPausedInboundTransfer() {
+	packet := s.sendTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	// the minting denom returning to chainA is refused while chainA is paused
+	heroApp, ctx := s.keeper(s.chainA)
+	heroApp.TokenfactoryKeeper.SetPaused(ctx, tokenfactorytypes.Paused{Paused: true})
+
+	// transfers that do not go through the ante handler are refused as well
+	cacheCtx, _ := ctx.CacheContext()
+	err := heroApp.TransferKeeper.SendTransfer(
+		cacheCtx, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.NewInt64Coin("uusdc", 100),
+		s.chainA.SenderAccount.GetAddress(), s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+	)
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrPaused)
+
+	packet = s.sendTransfer(s.path.EndpointB, sdk.NewInt64Coin(s.voucher, 100))
+	s.Require().Equal(int64(0), s.balance(s.chainB, s.voucher))
+
+	// chainA acknowledges the packet with an error and chainB refunds the sender
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(100), s.balance(s.chainB, s.voucher))
+
+	// the minting denom is received once chainA is unpaused
+	heroApp.TokenfactoryKeeper.SetPaused(s.chainA.GetContext(), tokenfactorytypes.Paused{Paused: false})
+	packet = s.sendTransfer(s.path.EndpointB, sdk.NewInt64Coin(s.voucher, 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(0), s.balance(s.chainB, s.voucher))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestBlacklistedInboundTransfer() {
+	packet := s.sendTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	// the minting denom returning to a blacklisted receiver on chainA is refused
+	heroApp, ctx := s.keeper(s.chainA)
+	heroApp.TokenfactoryKeeper.SetBlacklisted(ctx, tokenfactorytypes.Blacklisted{Address: s.chainA.SenderAccount.GetAddress().String()})
+
+	packet = s.sendTransfer(s.path.EndpointB, sdk.NewInt64Coin(s.voucher, 100))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+	s.Require().Equal(int64(100), s.balance(s.chainB, s.voucher))
+}
+
+func (s *TokenfactoryIBCTestSuite) TestRateLimitCannotBeSplitWithinTx() {
+	heroApp, ctx := s.keeper(s.chainA)
+	channelID := s.path.EndpointA.ChannelID
+	s.Require().NoError(heroApp.TokenfactoryKeeper.UpdateRateLimit(
+		ctx, channelID, tokenfactorytypes.RateLimitOutflow, sdk.NewInt(150), sdk.ZeroDec(), time.Hour, "",
+	))
+
+	// each transfer is within the quota on its own, but the tx moves more than the quota
+	transfer := s.transferMsg(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100), clienttypes.NewHeight(0, 110))
+	err := s.sendFailingMsgs(s.chainA, transfer, transfer)
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrRateLimitExceeded)
+	// the ante handler accepted the tx, so its sequence is used
+	s.Require().NoError(s.chainA.SenderAccount.SetSequence(s.chainA.SenderAccount.GetSequence() + 1))
+	s.Require().Equal(int64(1000), s.balance(s.chainA, "uusdc"))
+
+	rateLimit, found := heroApp.TokenfactoryKeeper.GetRateLimit(s.chainA.GetContext(), channelID, tokenfactorytypes.RateLimitOutflow)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.IsZero())
+
+	// the quota is left untouched by the rejected txs
+	s.sendTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+
+	err = s.sendFailingTransfer(s.path.EndpointA, sdk.NewInt64Coin("uusdc", 100))
+	s.Require().ErrorIs(err, tokenfactorytypes.ErrRateLimitExceeded)
+	s.Require().Equal(int64(900), s.balance(s.chainA, "uusdc"))
+}
@@ -8,7 +8,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	portkeeper "github.com/cosmos/ibc-go/v3/modules/core/05-port/keeper"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 	"github.com/stretchr/testify/require"
@@ -18,19 +27,59 @@ import (
 )
 
 func TokenfactoryKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, _, ctx := TokenfactoryKeeperWithBank(t)
+	return k, ctx
+}
+
+// TokenfactoryKeeperWithBank returns a tokenfactory keeper backed by a bank keeper, along with the
+// bank keeper so that tests can set denom metadata and balances.
+func TokenfactoryKeeperWithBank(t testing.TB) (*keeper.Keeper, bankkeeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	capabilityStoreKey := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	capabilityMemStoreKey := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
+	authStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTransientStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(capabilityStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(capabilityMemStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(authStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTransientStoreKey, storetypes.StoreTypeTransient, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTransientStoreKey)
 
-	paramsSubspace := typesparams.NewSubspace(cdc,
+	capabilityKeeper := capabilitykeeper.NewKeeper(cdc, capabilityStoreKey, capabilityMemStoreKey)
+	portKeeper := portkeeper.NewKeeper(capabilityKeeper.ScopeToModule(ibchost.ModuleName))
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		authStoreKey,
+		paramsKeeper.Subspace(authtypes.ModuleName),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{types.ModuleName: {authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		bankStoreKey,
+		accountKeeper,
+		paramsKeeper.Subspace(banktypes.ModuleName),
+		nil,
+	)
+
+	paramsSubspace := paramstypes.NewSubspace(cdc,
 		types.Amino,
 		storeKey,
 		memStoreKey,
@@ -41,13 +90,19 @@ func TokenfactoryKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		accountKeeper,
+		bankKeeper,
 		nil,
+		&portKeeper,
+		capabilityKeeper.ScopeToModule(types.ModuleName),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
 
-	return k, ctx
+	return k, bankKeeper, ctx
 }
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group heroadmin queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdListWhitelistedProposalType())
+	cmd.AddCommand(CmdListWhitelistedParam())
+	cmd.AddCommand(CmdShowAdminThreshold())
+	cmd.AddCommand(CmdListPendingAdminProposal())
+	cmd.AddCommand(CmdShowPendingAdminProposal())
+	cmd.AddCommand(CmdListAdminChange())
+	// this line is used by starport scaffolding # 1
+
+	return cmd
+}
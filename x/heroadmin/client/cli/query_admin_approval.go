@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+func CmdListAdminChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-admin-change",
+		Short: "list the history of admin additions and removals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllAdminChangeRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AdminChangeAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAdminThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-admin-threshold",
+		Short: "shows how many admins must approve admin proposals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAdminThresholdRequest{}
+
+			res, err := queryClient.AdminThreshold(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPendingAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-admin-proposal",
+		Short: "list all admin proposals awaiting approval",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPendingAdminProposalRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingAdminProposalAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPendingAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pending-admin-proposal [id]",
+		Short: "shows an admin proposal awaiting approval",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPendingAdminProposalRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.PendingAdminProposal(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
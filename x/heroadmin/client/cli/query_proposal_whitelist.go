@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+func CmdListWhitelistedProposalType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-whitelisted-proposal-type",
+		Short: "list all proposal types admins may submit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllWhitelistedProposalTypeRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WhitelistedProposalTypeAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListWhitelistedParam() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-whitelisted-param",
+		Short: "list all parameters admins may change",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllWhitelistedParamRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WhitelistedParamAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"time"
+
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+// NewCmdSubmitSetAdminThresholdProposal implements a command handler for submitting a proposal
+// setting how many admins must approve admin proposals through the admin module, and how long
+// they stay pending.
+func NewCmdSubmitSetAdminThresholdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-admin-threshold [threshold] [expiry]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set how many admins must approve admin proposals and how long they stay pending",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argThreshold, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argExpiry, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAdminThresholdProposal(title, description, argThreshold, argExpiry)
+
+			msg, err := adminmoduletypes.NewMsgSubmitProposal(content, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+const (
+	FlagProposalTypes = "proposal-types"
+	FlagParams        = "params"
+)
+
+// NewCmdSubmitAddProposalWhitelistProposal implements a command handler for submitting a proposal
+// adding proposal types and parameters to the admin proposal whitelist through the admin module.
+func NewCmdSubmitAddProposalWhitelistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-proposal-whitelist",
+		Args:  cobra.NoArgs,
+		Short: "Whitelist proposal types and parameters for admin proposals",
+		Example: "add-proposal-whitelist --proposal-types /cosmos.upgrade.v1beta1.SoftwareUpgradeProposal " +
+			"--params bank/SendEnabled,transfer/SendEnabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposalWhitelistProposal(cmd, types.NewAddProposalWhitelistProposal)
+		},
+	}
+
+	addProposalWhitelistFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveProposalWhitelistProposal implements a command handler for submitting a proposal
+// removing proposal types and parameters from the admin proposal whitelist through the admin module.
+func NewCmdSubmitRemoveProposalWhitelistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-proposal-whitelist",
+		Args:  cobra.NoArgs,
+		Short: "Remove proposal types and parameters from the admin proposal whitelist",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposalWhitelistProposal(cmd, types.NewRemoveProposalWhitelistProposal)
+		},
+	}
+
+	addProposalWhitelistFlags(cmd)
+
+	return cmd
+}
+
+func addProposalWhitelistFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagProposalTypes, nil, "comma separated proposal content type urls")
+	cmd.Flags().StringSlice(FlagParams, nil, "comma separated parameters written as subspace/key")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func submitProposalWhitelistProposal(
+	cmd *cobra.Command,
+	newContent func(title, description string, proposalTypes []string, params []types.WhitelistedParam) govtypes.Content,
+) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	proposalTypes, err := cmd.Flags().GetStringSlice(FlagProposalTypes)
+	if err != nil {
+		return err
+	}
+
+	argParams, err := cmd.Flags().GetStringSlice(FlagParams)
+	if err != nil {
+		return err
+	}
+
+	params := make([]types.WhitelistedParam, 0, len(argParams))
+	for _, arg := range argParams {
+		param, err := types.ParseWhitelistedParam(arg)
+		if err != nil {
+			return err
+		}
+		params = append(params, param)
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	content := newContent(title, description, proposalTypes, params)
+
+	msg, err := adminmoduletypes.NewMsgSubmitProposal(content, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/strangelove-ventures/hero/x/heroadmin/client/cli"
+)
+
+var (
+	AddProposalWhitelistProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddProposalWhitelistProposal, emptyRestHandler)
+	RemoveProposalWhitelistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveProposalWhitelistProposal, emptyRestHandler)
+	SetAdminThresholdProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitSetAdminThresholdProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-heroadmin",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for heroadmin proposals")
+		},
+	}
+}
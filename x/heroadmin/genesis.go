@@ -0,0 +1,79 @@
+package heroadmin
+
+import (
+	"github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the whitelistedProposalType
+	for _, elem := range genState.WhitelistedProposalTypeList {
+		k.SetWhitelistedProposalType(ctx, elem)
+	}
+	// Set all the whitelistedParam
+	for _, elem := range genState.WhitelistedParamList {
+		k.SetWhitelistedParam(ctx, elem)
+	}
+	k.SetAdminThreshold(ctx, genState.AdminThreshold)
+	k.SetAdminProposalExpiry(ctx, genState.AdminProposalExpiry)
+	// Set all the pendingAdminProposal
+	for _, elem := range genState.PendingAdminProposalList {
+		k.SetPendingAdminProposal(ctx, elem)
+	}
+
+	// Set pendingAdminProposal count
+	k.SetPendingAdminProposalCount(ctx, genState.PendingAdminProposalCount)
+	// Set all the adminChange
+	for _, elem := range genState.AdminChangeList {
+		k.SetAdminChange(ctx, elem)
+	}
+
+	// Set adminChange count
+	k.SetAdminChangeCount(ctx, genState.AdminChangeCount)
+	// this line is used by starport scaffolding # genesis/module/init
+
+	if err := ctx.EventManager().EmitTypedEvents(genesisEvents(genState)...); err != nil {
+		panic(err)
+	}
+}
+
+// genesisEvents returns the events describing the state loaded from genesis so that
+// indexers can rebuild the state from events alone. Genesis events have no actor.
+func genesisEvents(genState types.GenesisState) []proto.Message {
+	var events []proto.Message
+
+	if len(genState.WhitelistedProposalTypeList) > 0 || len(genState.WhitelistedParamList) > 0 {
+		proposalTypes := make([]string, 0, len(genState.WhitelistedProposalTypeList))
+		for _, elem := range genState.WhitelistedProposalTypeList {
+			proposalTypes = append(proposalTypes, elem.TypeUrl)
+		}
+		events = append(events, &types.EventProposalWhitelistAdded{ProposalTypes: proposalTypes, Params: genState.WhitelistedParamList})
+	}
+	events = append(events, &types.EventAdminThresholdChanged{Current: genState.AdminThreshold, Expiry: genState.AdminProposalExpiry})
+	for _, elem := range genState.PendingAdminProposalList {
+		events = append(events, &types.EventAdminProposalSubmitted{Proposal: elem})
+	}
+
+	return events
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+
+	genesis.WhitelistedProposalTypeList = k.GetAllWhitelistedProposalType(ctx)
+	genesis.WhitelistedParamList = k.GetAllWhitelistedParam(ctx)
+	genesis.AdminThreshold = k.GetAdminThreshold(ctx)
+	genesis.AdminProposalExpiry = k.GetAdminProposalExpiry(ctx)
+	genesis.PendingAdminProposalList = k.GetAllPendingAdminProposal(ctx)
+	genesis.PendingAdminProposalCount = k.GetPendingAdminProposalCount(ctx)
+	genesis.AdminChangeList = k.GetAllAdminChange(ctx)
+	genesis.AdminChangeCount = k.GetAdminChangeCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,96 @@
+package heroadmin_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/heroadmin"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		WhitelistedProposalTypeList: []types.WhitelistedProposalType{
+			{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal"},
+			{TypeUrl: "/hero.tokenfactory.SetRateLimitProposal"},
+		},
+		WhitelistedParamList: []types.WhitelistedParam{
+			{Subspace: "bank", Key: "SendEnabled"},
+			{Subspace: "transfer", Key: "ReceiveEnabled"},
+		},
+		AdminThreshold:      2,
+		AdminProposalExpiry: time.Hour,
+		PendingAdminProposalList: []types.PendingAdminProposal{
+			{
+				Id:        0,
+				Proposer:  "cosmos1admin",
+				Approvals: []string{"cosmos1admin"},
+			},
+			{
+				Id:        1,
+				Proposer:  "cosmos1admin",
+				Approvals: []string{"cosmos1admin"},
+			},
+		},
+		PendingAdminProposalCount: 2,
+		AdminChangeList: []types.AdminChange{
+			{
+				Id:        0,
+				Admin:     "cosmos1admin",
+				Approvals: []string{"cosmos1other"},
+				Height:    1,
+			},
+			{
+				Id:        1,
+				Admin:     "cosmos1other",
+				Removed:   true,
+				Approvals: []string{"cosmos1admin"},
+				Height:    2,
+			},
+		},
+		AdminChangeCount: 2,
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.HeroadminKeeper(t)
+	heroadmin.InitGenesis(ctx, *k, genesisState)
+	got := heroadmin.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.WhitelistedProposalTypeList, got.WhitelistedProposalTypeList)
+	require.ElementsMatch(t, genesisState.WhitelistedParamList, got.WhitelistedParamList)
+	require.Equal(t, genesisState.AdminThreshold, got.AdminThreshold)
+	require.Equal(t, genesisState.AdminProposalExpiry, got.AdminProposalExpiry)
+	require.ElementsMatch(t, genesisState.PendingAdminProposalList, got.PendingAdminProposalList)
+	require.Equal(t, genesisState.PendingAdminProposalCount, got.PendingAdminProposalCount)
+	require.ElementsMatch(t, genesisState.AdminChangeList, got.AdminChangeList)
+	require.Equal(t, genesisState.AdminChangeCount, got.AdminChangeCount)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
+
+func TestGenesisWhitelistRemovable(t *testing.T) {
+	k, ctx := keepertest.HeroadminKeeper(t)
+	heroadmin.InitGenesis(ctx, *k, *types.DefaultGenesis())
+
+	// the whitelist proposals are whitelisted by genesis and can be removed like any other entry
+	removal := types.NewRemoveProposalWhitelistProposal("title", "description", []string{
+		types.ProposalTypeURL(&types.AddProposalWhitelistProposal{}),
+	}, nil)
+	require.True(t, k.IsProposalWhitelisted(ctx, removal))
+	require.NoError(t, heroadmin.NewProposalHandler(*k)(ctx, removal))
+
+	addition := types.NewAddProposalWhitelistProposal("title", "description", []string{
+		types.ProposalTypeURL(&types.AddProposalWhitelistProposal{}),
+	}, nil)
+	require.False(t, k.IsProposalWhitelisted(ctx, addition))
+
+	got := heroadmin.ExportGenesis(ctx, *k)
+	require.NotContains(t, got.WhitelistedProposalTypeList, types.WhitelistedProposalType{TypeUrl: types.ProposalTypeURL(addition)})
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAdminThreshold set the number of admins that must approve admin module messages
+func (k Keeper) SetAdminThreshold(ctx sdk.Context, threshold uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, threshold)
+	store.Set(types.KeyPrefix(types.AdminThresholdKey), bz)
+}
+
+// GetAdminThreshold returns the number of admins that must approve admin module messages
+func (k Keeper) GetAdminThreshold(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.AdminThresholdKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAdminProposalExpiry set how long admin module messages stay pending before they expire
+func (k Keeper) SetAdminProposalExpiry(ctx sdk.Context, expiry time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expiry))
+	store.Set(types.KeyPrefix(types.AdminProposalExpiryKey), bz)
+}
+
+// GetAdminProposalExpiry returns how long admin module messages stay pending before they expire
+func (k Keeper) GetAdminProposalExpiry(ctx sdk.Context) time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.AdminProposalExpiryKey))
+	if bz == nil {
+		return 0
+	}
+	return time.Duration(binary.BigEndian.Uint64(bz))
+}
+
+// adminApprovedKey marks a context in which an admin module message approved by the admins is
+// executed, its value holds the approvals
+type adminApprovedKey struct{}
+
+// RequiresAdminApproval returns true if admin module messages must be approved by more than one
+// admin and are not executed as an approved pendingAdminProposal.
+func (k Keeper) RequiresAdminApproval(ctx sdk.Context) bool {
+	if ctx.Value(adminApprovedKey{}) != nil {
+		return false
+	}
+	return k.GetAdminThreshold(ctx) > 1
+}
+
+// AdminApprovals returns the admins that approved the admin module message executed in the context,
+// or only its signer when it was sent directly.
+func (k Keeper) AdminApprovals(ctx sdk.Context, signer string) []string {
+	if approvals, ok := ctx.Value(adminApprovedKey{}).([]string); ok {
+		return approvals
+	}
+	return []string{signer}
+}
+
+// IsAdmin returns true if the address is an admin of the admin module
+func (k Keeper) IsAdmin(ctx sdk.Context, address string) bool {
+	for _, admin := range k.adminKeeper.GetAdmins(ctx) {
+		if admin == address {
+			return true
+		}
+	}
+	return false
+}
+
+// countAdminApprovals returns the number of approvals given by current admins
+func (k Keeper) countAdminApprovals(ctx sdk.Context, approvals []string) uint64 {
+	var count uint64
+	for _, approval := range approvals {
+		if k.IsAdmin(ctx, approval) {
+			count++
+		}
+	}
+	return count
+}
+
+// UpdateAdminThreshold sets how many admins must approve admin module messages and how long they stay
+// pending. A threshold of 0 or 1 lets any admin act alone.
+func (k Keeper) UpdateAdminThreshold(ctx sdk.Context, threshold uint64, expiry time.Duration, actor string) error {
+	if admins := uint64(len(k.adminKeeper.GetAdmins(ctx))); threshold > admins {
+		return sdkerrors.Wrapf(types.ErrAdminApproval, "threshold %d is above the number of admins %d", threshold, admins)
+	}
+
+	if expiry <= 0 {
+		return sdkerrors.Wrap(types.ErrAdminApproval, "admin proposal expiry must be positive")
+	}
+
+	previous := k.GetAdminThreshold(ctx)
+	k.SetAdminThreshold(ctx, threshold)
+	k.SetAdminProposalExpiry(ctx, expiry)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminThresholdChanged{
+		Previous: previous,
+		Current:  threshold,
+		Actor:    actor,
+		Expiry:   expiry,
+	})
+}
+
+// CheckAdminRemoval returns an error if removing an admin would leave fewer admins than the threshold.
+func (k Keeper) CheckAdminRemoval(ctx sdk.Context) error {
+	admins := uint64(len(k.adminKeeper.GetAdmins(ctx)))
+	if threshold := k.GetAdminThreshold(ctx); admins <= threshold {
+		return sdkerrors.Wrapf(types.ErrAdminApproval, "removing an admin would leave fewer admins than the threshold %d", threshold)
+	}
+	return nil
+}
+
+// SubmitPendingAdminProposal holds an admin module message until enough admins approve it, the
+// proposer approves it by submitting it. It expires if it is not approved within the admin proposal expiry.
+func (k Keeper) SubmitPendingAdminProposal(ctx sdk.Context, proposer string, msg sdk.Msg) (uint64, error) {
+	if !types.IsAdminProposalMsg(msg) {
+		return 0, sdkerrors.Wrapf(types.ErrAdminApproval, "%s cannot be held for admin approval", sdk.MsgTypeURL(msg))
+	}
+
+	if !k.IsAdmin(ctx, proposer) {
+		return 0, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not an admin", proposer)
+	}
+
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	pendingAdminProposal := types.PendingAdminProposal{
+		Proposer:    proposer,
+		Msg:         any,
+		Approvals:   []string{proposer},
+		SubmittedAt: ctx.BlockTime(),
+		ExpiresAt:   ctx.BlockTime().Add(k.GetAdminProposalExpiry(ctx)),
+	}
+
+	pendingAdminProposal.Id = k.AppendPendingAdminProposal(ctx, pendingAdminProposal)
+
+	return pendingAdminProposal.Id, ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalSubmitted{
+		Proposal: pendingAdminProposal,
+	})
+}
+
+// RecordAdminChange appends an admin addition or removal to the admin change history.
+func (k Keeper) RecordAdminChange(ctx sdk.Context, admin string, removed bool, approvals []string) error {
+	adminChange := types.AdminChange{
+		Admin:     admin,
+		Removed:   removed,
+		Approvals: approvals,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+	}
+
+	adminChange.Id = k.AppendAdminChange(ctx, adminChange)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminChanged{Change: adminChange})
+}
+
+// executeAdminProposal routes the message of a pendingAdminProposal once enough admins have approved
+// it. The message is handled as if it was sent directly, so the checks of the admin module still apply.
+// Only the admin module messages gated by the admin threshold are ever routed.
+func (k Keeper) executeAdminProposal(ctx sdk.Context, pendingAdminProposal types.PendingAdminProposal) error {
+	msg, err := pendingAdminProposal.GetMessage()
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrAdminApproval, err.Error())
+	}
+
+	handler := k.router.Handler(msg)
+	if !types.IsAdminProposalMsg(msg) || handler == nil {
+		return sdkerrors.Wrapf(types.ErrAdminApproval, "unsupported admin proposal %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx.WithValue(adminApprovedKey{}, pendingAdminProposal.Approvals), msg)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	k.RemovePendingAdminProposal(ctx, pendingAdminProposal.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalExecuted{Id: pendingAdminProposal.Id})
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+func createNPendingAdminProposal(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PendingAdminProposal {
+	items := make([]types.PendingAdminProposal, n)
+	for i := range items {
+		items[i].Proposer = sample.AccAddress()
+		items[i].Approvals = []string{items[i].Proposer}
+		items[i].SubmittedAt = time.Unix(int64(i), 0).UTC()
+		items[i].ExpiresAt = time.Unix(int64(i)+10, 0).UTC()
+		items[i].Id = keeper.AppendPendingAdminProposal(ctx, items[i])
+	}
+	return items
+}
+
+func createNAdminChange(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AdminChange {
+	items := make([]types.AdminChange, n)
+	for i := range items {
+		items[i].Admin = sample.AccAddress()
+		items[i].Removed = i%2 == 1
+		items[i].Approvals = []string{sample.AccAddress()}
+		items[i].Height = int64(i)
+		items[i].Time = time.Unix(int64(i), 0).UTC()
+		items[i].Id = keeper.AppendAdminChange(ctx, items[i])
+	}
+	return items
+}
+
+func TestPendingAdminProposalGet(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	items := createNPendingAdminProposal(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetPendingAdminProposal(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestPendingAdminProposalRemove(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	items := createNPendingAdminProposal(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePendingAdminProposal(ctx, item.Id)
+		_, found := keeper.GetPendingAdminProposal(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestPendingAdminProposalGetAll(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	items := createNPendingAdminProposal(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPendingAdminProposal(ctx)),
+	)
+	require.Equal(t, uint64(len(items)), keeper.GetPendingAdminProposalCount(ctx))
+}
+
+func TestRemoveExpiredPendingAdminProposals(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	items := createNPendingAdminProposal(keeper, ctx, 10)
+	require.NoError(t, keeper.RemoveExpiredPendingAdminProposals(ctx.WithBlockTime(items[4].ExpiresAt.Add(time.Second))))
+	require.ElementsMatch(t,
+		nullify.Fill(items[5:]),
+		nullify.Fill(keeper.GetAllPendingAdminProposal(ctx)),
+	)
+}
+
+func TestAdminChangeGetAll(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	items := createNAdminChange(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetAdminChange(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAdminChange(ctx)),
+	)
+	require.Equal(t, uint64(len(items)), keeper.GetAdminChangeCount(ctx))
+}
+
+func TestRequiresAdminApproval(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	require.False(t, keeper.RequiresAdminApproval(ctx))
+
+	keeper.SetAdminThreshold(ctx, 1)
+	require.Equal(t, uint64(1), keeper.GetAdminThreshold(ctx))
+	require.False(t, keeper.RequiresAdminApproval(ctx))
+
+	keeper.SetAdminThreshold(ctx, 2)
+	require.True(t, keeper.RequiresAdminApproval(ctx))
+
+	signer := sample.AccAddress()
+	require.Equal(t, []string{signer}, keeper.AdminApprovals(ctx, signer))
+}
+
+func TestSubmitPendingAdminProposalAllowlist(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+
+	// only the admin module messages gated by the admin threshold are held for approval
+	_, err := keeper.SubmitPendingAdminProposal(ctx, sample.AccAddress(), types.NewMsgApproveAdminProposal(sample.AccAddress(), 0))
+	require.ErrorIs(t, err, types.ErrAdminApproval)
+	require.Empty(t, keeper.GetAllPendingAdminProposal(ctx))
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAdminChangeCount get the total number of adminChange
+func (k Keeper) GetAdminChangeCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.AdminChangeCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAdminChangeCount set the total number of adminChange
+func (k Keeper) SetAdminChangeCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.AdminChangeCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendAdminChange appends a adminChange in the store with a new id and update the count
+func (k Keeper) AppendAdminChange(
+	ctx sdk.Context,
+	adminChange types.AdminChange,
+) uint64 {
+	// Create the adminChange
+	count := k.GetAdminChangeCount(ctx)
+
+	// Set the ID of the appended value
+	adminChange.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminChangeKey))
+	appendedValue := k.cdc.MustMarshal(&adminChange)
+	store.Set(GetAdminChangeIDBytes(adminChange.Id), appendedValue)
+
+	// Update adminChange count
+	k.SetAdminChangeCount(ctx, count+1)
+
+	return count
+}
+
+// SetAdminChange set a specific adminChange in the store
+func (k Keeper) SetAdminChange(ctx sdk.Context, adminChange types.AdminChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminChangeKey))
+	b := k.cdc.MustMarshal(&adminChange)
+	store.Set(GetAdminChangeIDBytes(adminChange.Id), b)
+}
+
+// GetAdminChange returns a adminChange from its id
+func (k Keeper) GetAdminChange(ctx sdk.Context, id uint64) (val types.AdminChange, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminChangeKey))
+	b := store.Get(GetAdminChangeIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAdminChange returns all adminChange
+func (k Keeper) GetAllAdminChange(ctx sdk.Context) (list []types.AdminChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminChangeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AdminChange
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAdminChangeIDBytes returns the byte representation of the ID
+func GetAdminChangeIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetAdminChangeIDFromBytes returns ID in uint64 format from a byte array
+func GetAdminChangeIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type adminMsgServer struct {
+	adminmoduletypes.MsgServer
+
+	Keeper
+}
+
+// NewAdminMsgServerImpl wraps the admin module msg server so that submitted proposals are checked against
+// the proposal whitelist, and admin module messages are held until the admin threshold is reached when
+// more than one admin must approve them.
+func NewAdminMsgServerImpl(msgServer adminmoduletypes.MsgServer, keeper Keeper) adminmoduletypes.MsgServer {
+	return &adminMsgServer{MsgServer: msgServer, Keeper: keeper}
+}
+
+var _ adminmoduletypes.MsgServer = adminMsgServer{}
+
+// SubmitProposal checks the proposal against the proposal whitelist and submits it to the admin module,
+// or holds it for approval by the other admins. Held proposals are checked again when executed. The
+// admin keeper does not pass the context to its whitelist callback, so the whitelist is only checked here.
+//
+// The response of a held proposal carries proposal id 0, which the admin module never assigns, and the
+// id of the pending admin proposal is emitted in an EventAdminProposalSubmitted instead.
+func (k adminMsgServer) SubmitProposal(goCtx context.Context, msg *adminmoduletypes.MsgSubmitProposal) (*adminmoduletypes.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if content := msg.GetContent(); content == nil || !k.IsProposalWhitelisted(ctx, content) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "proposal content is not whitelisted")
+	}
+
+	if k.RequiresAdminApproval(ctx) {
+		if _, err := k.SubmitPendingAdminProposal(ctx, msg.Proposer, msg); err != nil {
+			return nil, err
+		}
+		return &adminmoduletypes.MsgSubmitProposalResponse{}, nil
+	}
+
+	return k.MsgServer.SubmitProposal(goCtx, msg)
+}
+
+// AddAdmin adds an admin, or holds the addition for approval by the other admins, and records it in
+// the admin change history.
+func (k adminMsgServer) AddAdmin(goCtx context.Context, msg *adminmoduletypes.MsgAddAdmin) (*adminmoduletypes.MsgAddAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.RequiresAdminApproval(ctx) {
+		if _, err := k.SubmitPendingAdminProposal(ctx, msg.Creator, msg); err != nil {
+			return nil, err
+		}
+		return &adminmoduletypes.MsgAddAdminResponse{}, nil
+	}
+
+	res, err := k.MsgServer.AddAdmin(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RecordAdminChange(ctx, msg.Admin, false, k.AdminApprovals(ctx, msg.Creator)); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteAdmin removes an admin, or holds the removal for approval by the other admins, and records it
+// in the admin change history. Admins cannot be removed below the admin threshold.
+func (k adminMsgServer) DeleteAdmin(goCtx context.Context, msg *adminmoduletypes.MsgDeleteAdmin) (*adminmoduletypes.MsgDeleteAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.RequiresAdminApproval(ctx) {
+		if _, err := k.SubmitPendingAdminProposal(ctx, msg.Creator, msg); err != nil {
+			return nil, err
+		}
+		return &adminmoduletypes.MsgDeleteAdminResponse{}, nil
+	}
+
+	if err := k.CheckAdminRemoval(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := k.MsgServer.DeleteAdmin(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RecordAdminChange(ctx, msg.Admin, true, k.AdminApprovals(ctx, msg.Creator)); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
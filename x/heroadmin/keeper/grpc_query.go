@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AdminThreshold(c context.Context, req *types.QueryAdminThresholdRequest) (*types.QueryAdminThresholdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAdminThresholdResponse{
+		Threshold: k.GetAdminThreshold(ctx),
+		Admins:    uint64(len(k.adminKeeper.GetAdmins(ctx))),
+		Expiry:    k.GetAdminProposalExpiry(ctx),
+	}, nil
+}
+
+func (k Keeper) PendingAdminProposalAll(c context.Context, req *types.QueryAllPendingAdminProposalRequest) (*types.QueryAllPendingAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingAdminProposals []types.PendingAdminProposal
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingAdminProposalStore := prefix.NewStore(store, types.KeyPrefix(types.PendingAdminProposalKey))
+
+	pageRes, err := query.Paginate(pendingAdminProposalStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingAdminProposal types.PendingAdminProposal
+		if err := k.cdc.Unmarshal(value, &pendingAdminProposal); err != nil {
+			return err
+		}
+
+		pendingAdminProposals = append(pendingAdminProposals, pendingAdminProposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingAdminProposalResponse{PendingAdminProposal: pendingAdminProposals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingAdminProposal(c context.Context, req *types.QueryGetPendingAdminProposalRequest) (*types.QueryGetPendingAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pendingAdminProposal, found := k.GetPendingAdminProposal(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingAdminProposalResponse{PendingAdminProposal: pendingAdminProposal}, nil
+}
+
+func (k Keeper) AdminChangeAll(c context.Context, req *types.QueryAllAdminChangeRequest) (*types.QueryAllAdminChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var adminChanges []types.AdminChange
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	adminChangeStore := prefix.NewStore(store, types.KeyPrefix(types.AdminChangeKey))
+
+	pageRes, err := query.Paginate(adminChangeStore, req.Pagination, func(key []byte, value []byte) error {
+		var adminChange types.AdminChange
+		if err := k.cdc.Unmarshal(value, &adminChange); err != nil {
+			return err
+		}
+
+		adminChanges = append(adminChanges, adminChange)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAdminChangeResponse{AdminChange: adminChanges, Pagination: pageRes}, nil
+}
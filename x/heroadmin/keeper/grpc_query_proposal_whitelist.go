@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WhitelistedProposalTypeAll(c context.Context, req *types.QueryAllWhitelistedProposalTypeRequest) (*types.QueryAllWhitelistedProposalTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var whitelistedProposalTypes []types.WhitelistedProposalType
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	whitelistedProposalTypeStore := prefix.NewStore(store, types.KeyPrefix(types.WhitelistedProposalTypeKeyPrefix))
+
+	pageRes, err := query.Paginate(whitelistedProposalTypeStore, req.Pagination, func(key []byte, value []byte) error {
+		var whitelistedProposalType types.WhitelistedProposalType
+		if err := k.cdc.Unmarshal(value, &whitelistedProposalType); err != nil {
+			return err
+		}
+
+		whitelistedProposalTypes = append(whitelistedProposalTypes, whitelistedProposalType)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllWhitelistedProposalTypeResponse{WhitelistedProposalType: whitelistedProposalTypes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) WhitelistedParamAll(c context.Context, req *types.QueryAllWhitelistedParamRequest) (*types.QueryAllWhitelistedParamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var whitelistedParams []types.WhitelistedParam
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	whitelistedParamStore := prefix.NewStore(store, types.KeyPrefix(types.WhitelistedParamKeyPrefix))
+
+	pageRes, err := query.Paginate(whitelistedParamStore, req.Pagination, func(key []byte, value []byte) error {
+		var whitelistedParam types.WhitelistedParam
+		if err := k.cdc.Unmarshal(value, &whitelistedParam); err != nil {
+			return err
+		}
+
+		whitelistedParams = append(whitelistedParams, whitelistedParam)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllWhitelistedParamResponse{WhitelistedParam: whitelistedParams, Pagination: pageRes}, nil
+}
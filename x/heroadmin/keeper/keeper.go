@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+		memKey   storetypes.StoreKey
+
+		adminKeeper types.AdminKeeper
+		router      types.MsgRouter
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+
+	adminKeeper types.AdminKeeper,
+	router types.MsgRouter,
+) *Keeper {
+	return &Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		memKey:      memKey,
+		adminKeeper: adminKeeper,
+		router:      router,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
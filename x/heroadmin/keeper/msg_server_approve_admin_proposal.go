@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ApproveAdminProposal(goCtx context.Context, msg *types.MsgApproveAdminProposal) (*types.MsgApproveAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAdmin(ctx, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not an admin")
+	}
+
+	pendingAdminProposal, found := k.GetPendingAdminProposal(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAdminApproval, "pending admin proposal with id %d doesn't exist", msg.Id)
+	}
+
+	if ctx.BlockTime().After(pendingAdminProposal.ExpiresAt) {
+		return nil, sdkerrors.Wrapf(types.ErrAdminApproval, "pending admin proposal with id %d has expired", msg.Id)
+	}
+
+	if pendingAdminProposal.HasApproval(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrAdminApproval, "you have already approved this admin proposal")
+	}
+
+	pendingAdminProposal.Approvals = append(pendingAdminProposal.Approvals, msg.From)
+
+	k.SetPendingAdminProposal(ctx, pendingAdminProposal)
+
+	approvals := k.countAdminApprovals(ctx, pendingAdminProposal.Approvals)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalApproved{
+		Id:        pendingAdminProposal.Id,
+		Approver:  msg.From,
+		Approvals: approvals,
+	}); err != nil {
+		return nil, err
+	}
+
+	executed := approvals >= k.GetAdminThreshold(ctx)
+	if executed {
+		if err := k.executeAdminProposal(ctx, pendingAdminProposal); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgApproveAdminProposalResponse{Executed: executed}, nil
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingAdminProposalCount get the total number of pendingAdminProposal
+func (k Keeper) GetPendingAdminProposalCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PendingAdminProposalCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPendingAdminProposalCount set the total number of pendingAdminProposal
+func (k Keeper) SetPendingAdminProposalCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PendingAdminProposalCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendPendingAdminProposal appends a pendingAdminProposal in the store with a new id and update the count
+func (k Keeper) AppendPendingAdminProposal(
+	ctx sdk.Context,
+	pendingAdminProposal types.PendingAdminProposal,
+) uint64 {
+	// Create the pendingAdminProposal
+	count := k.GetPendingAdminProposalCount(ctx)
+
+	// Set the ID of the appended value
+	pendingAdminProposal.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAdminProposalKey))
+	appendedValue := k.cdc.MustMarshal(&pendingAdminProposal)
+	store.Set(GetPendingAdminProposalIDBytes(pendingAdminProposal.Id), appendedValue)
+
+	// Update pendingAdminProposal count
+	k.SetPendingAdminProposalCount(ctx, count+1)
+
+	return count
+}
+
+// SetPendingAdminProposal set a specific pendingAdminProposal in the store
+func (k Keeper) SetPendingAdminProposal(ctx sdk.Context, pendingAdminProposal types.PendingAdminProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAdminProposalKey))
+	b := k.cdc.MustMarshal(&pendingAdminProposal)
+	store.Set(GetPendingAdminProposalIDBytes(pendingAdminProposal.Id), b)
+}
+
+// GetPendingAdminProposal returns a pendingAdminProposal from its id
+func (k Keeper) GetPendingAdminProposal(ctx sdk.Context, id uint64) (val types.PendingAdminProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAdminProposalKey))
+	b := store.Get(GetPendingAdminProposalIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingAdminProposal removes a pendingAdminProposal from the store
+func (k Keeper) RemovePendingAdminProposal(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAdminProposalKey))
+	store.Delete(GetPendingAdminProposalIDBytes(id))
+}
+
+// GetAllPendingAdminProposal returns all pendingAdminProposal
+func (k Keeper) GetAllPendingAdminProposal(ctx sdk.Context) (list []types.PendingAdminProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAdminProposalKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingAdminProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPendingAdminProposalIDBytes returns the byte representation of the ID
+func GetPendingAdminProposalIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetPendingAdminProposalIDFromBytes returns ID in uint64 format from a byte array
+func GetPendingAdminProposalIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// RemoveExpiredPendingAdminProposals removes every pendingAdminProposal that expired before the current block time
+func (k Keeper) RemoveExpiredPendingAdminProposals(ctx sdk.Context) error {
+	for _, pendingAdminProposal := range k.GetAllPendingAdminProposal(ctx) {
+		if !ctx.BlockTime().After(pendingAdminProposal.ExpiresAt) {
+			continue
+		}
+
+		k.RemovePendingAdminProposal(ctx, pendingAdminProposal.Id)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminProposalExpired{Id: pendingAdminProposal.Id}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
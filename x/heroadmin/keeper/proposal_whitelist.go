@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+// SetWhitelistedProposalType set a specific whitelistedProposalType in the store from its index
+func (k Keeper) SetWhitelistedProposalType(ctx sdk.Context, whitelistedProposalType types.WhitelistedProposalType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedProposalTypeKeyPrefix))
+	b := k.cdc.MustMarshal(&whitelistedProposalType)
+	store.Set(types.WhitelistedProposalTypeKey(
+		whitelistedProposalType.TypeUrl,
+	), b)
+}
+
+// HasWhitelistedProposalType returns whether the proposal type is whitelisted
+func (k Keeper) HasWhitelistedProposalType(ctx sdk.Context, typeUrl string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedProposalTypeKeyPrefix))
+	return store.Has(types.WhitelistedProposalTypeKey(typeUrl))
+}
+
+// RemoveWhitelistedProposalType removes a whitelistedProposalType from the store
+func (k Keeper) RemoveWhitelistedProposalType(ctx sdk.Context, typeUrl string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedProposalTypeKeyPrefix))
+	store.Delete(types.WhitelistedProposalTypeKey(typeUrl))
+}
+
+// GetAllWhitelistedProposalType returns all whitelistedProposalType
+func (k Keeper) GetAllWhitelistedProposalType(ctx sdk.Context) (list []types.WhitelistedProposalType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedProposalTypeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.WhitelistedProposalType
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetWhitelistedParam set a specific whitelistedParam in the store from its index
+func (k Keeper) SetWhitelistedParam(ctx sdk.Context, whitelistedParam types.WhitelistedParam) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedParamKeyPrefix))
+	b := k.cdc.MustMarshal(&whitelistedParam)
+	store.Set(types.WhitelistedParamKey(
+		whitelistedParam.Subspace,
+		whitelistedParam.Key,
+	), b)
+}
+
+// HasWhitelistedParam returns whether the parameter is whitelisted
+func (k Keeper) HasWhitelistedParam(ctx sdk.Context, subspace, key string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedParamKeyPrefix))
+	return store.Has(types.WhitelistedParamKey(subspace, key))
+}
+
+// RemoveWhitelistedParam removes a whitelistedParam from the store
+func (k Keeper) RemoveWhitelistedParam(ctx sdk.Context, subspace, key string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedParamKeyPrefix))
+	store.Delete(types.WhitelistedParamKey(subspace, key))
+}
+
+// GetAllWhitelistedParam returns all whitelistedParam
+func (k Keeper) GetAllWhitelistedParam(ctx sdk.Context) (list []types.WhitelistedParam) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WhitelistedParamKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.WhitelistedParam
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsProposalWhitelisted returns whether admins may submit the proposal content through the admin module.
+// Parameter changes must each be whitelisted, any other proposal must have its type whitelisted.
+func (k Keeper) IsProposalWhitelisted(ctx sdk.Context, content govtypes.Content) bool {
+	switch c := content.(type) {
+	case *proposal.ParameterChangeProposal:
+		for _, change := range c.Changes {
+			if !k.HasWhitelistedParam(ctx, change.Subspace, change.Key) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return k.HasWhitelistedProposalType(ctx, types.ProposalTypeURL(content))
+	}
+}
+
+// AddProposalWhitelist whitelists the proposal types and parameters.
+func (k Keeper) AddProposalWhitelist(ctx sdk.Context, proposalTypes []string, params []types.WhitelistedParam, actor string) error {
+	for _, typeUrl := range proposalTypes {
+		k.SetWhitelistedProposalType(ctx, types.WhitelistedProposalType{TypeUrl: typeUrl})
+	}
+	for _, param := range params {
+		k.SetWhitelistedParam(ctx, param)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventProposalWhitelistAdded{
+		ProposalTypes: proposalTypes,
+		Params:        params,
+		Actor:         actor,
+	})
+}
+
+// RemoveProposalWhitelist removes the proposal types and parameters from the whitelist. It fails if any of
+// them is not whitelisted.
+func (k Keeper) RemoveProposalWhitelist(ctx sdk.Context, proposalTypes []string, params []types.WhitelistedParam, actor string) error {
+	for _, typeUrl := range proposalTypes {
+		if !k.HasWhitelistedProposalType(ctx, typeUrl) {
+			return sdkerrors.Wrapf(types.ErrNotWhitelisted, "proposal type %s", typeUrl)
+		}
+		k.RemoveWhitelistedProposalType(ctx, typeUrl)
+	}
+	for _, param := range params {
+		if !k.HasWhitelistedParam(ctx, param.Subspace, param.Key) {
+			return sdkerrors.Wrapf(types.ErrNotWhitelisted, "param %s/%s", param.Subspace, param.Key)
+		}
+		k.RemoveWhitelistedParam(ctx, param.Subspace, param.Key)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventProposalWhitelistRemoved{
+		ProposalTypes: proposalTypes,
+		Params:        params,
+		Actor:         actor,
+	})
+}
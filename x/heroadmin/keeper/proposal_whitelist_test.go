@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createProposalWhitelist(keeper *keeper.Keeper, ctx sdk.Context) ([]types.WhitelistedProposalType, []types.WhitelistedParam) {
+	proposalTypes := types.DefaultWhitelistedProposalTypes()
+	for _, item := range proposalTypes {
+		keeper.SetWhitelistedProposalType(ctx, item)
+	}
+	params := types.DefaultWhitelistedParams()
+	for _, item := range params {
+		keeper.SetWhitelistedParam(ctx, item)
+	}
+	return proposalTypes, params
+}
+
+func TestProposalWhitelistGetAll(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	proposalTypes, params := createProposalWhitelist(keeper, ctx)
+	require.ElementsMatch(t, proposalTypes, keeper.GetAllWhitelistedProposalType(ctx))
+	require.ElementsMatch(t, params, keeper.GetAllWhitelistedParam(ctx))
+}
+
+func TestProposalWhitelistRemove(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	proposalTypes, params := createProposalWhitelist(keeper, ctx)
+	for _, item := range proposalTypes {
+		keeper.RemoveWhitelistedProposalType(ctx, item.TypeUrl)
+		require.False(t, keeper.HasWhitelistedProposalType(ctx, item.TypeUrl))
+	}
+	for _, item := range params {
+		keeper.RemoveWhitelistedParam(ctx, item.Subspace, item.Key)
+		require.False(t, keeper.HasWhitelistedParam(ctx, item.Subspace, item.Key))
+	}
+}
+
+func TestIsProposalWhitelisted(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	upgrade := &upgradetypes.SoftwareUpgradeProposal{Title: "title", Description: "description"}
+	paramChange := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		{Subspace: "bank", Key: "SendEnabled", Value: "true"},
+		{Subspace: "transfer", Key: "SendEnabled", Value: "true"},
+	})
+	whitelist := types.NewAddProposalWhitelistProposal("title", "description", []string{"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal"}, nil)
+
+	// nothing is whitelisted yet, not even changes to the whitelist itself
+	require.False(t, keeper.IsProposalWhitelisted(ctx, upgrade))
+	require.False(t, keeper.IsProposalWhitelisted(ctx, paramChange))
+	require.False(t, keeper.IsProposalWhitelisted(ctx, whitelist))
+
+	actor := sample.AccAddress()
+	require.NoError(t, keeper.AddProposalWhitelist(ctx, []string{types.ProposalTypeURL(upgrade)}, []types.WhitelistedParam{
+		{Subspace: "bank", Key: "SendEnabled"},
+	}, actor))
+	require.True(t, keeper.IsProposalWhitelisted(ctx, upgrade))
+
+	// every changed parameter must be whitelisted
+	require.False(t, keeper.IsProposalWhitelisted(ctx, paramChange))
+	require.NoError(t, keeper.AddProposalWhitelist(ctx, nil, []types.WhitelistedParam{
+		{Subspace: "transfer", Key: "SendEnabled"},
+	}, actor))
+	require.True(t, keeper.IsProposalWhitelisted(ctx, paramChange))
+
+	require.NoError(t, keeper.RemoveProposalWhitelist(ctx, []string{types.ProposalTypeURL(upgrade)}, nil, actor))
+	require.False(t, keeper.IsProposalWhitelisted(ctx, upgrade))
+}
+
+func TestRemoveProposalWhitelistNotFound(t *testing.T) {
+	keeper, ctx := keepertest.HeroadminKeeper(t)
+	createProposalWhitelist(keeper, ctx)
+
+	err := keeper.RemoveProposalWhitelist(ctx, nil, []types.WhitelistedParam{
+		{Subspace: "bank", Key: "SendEnabled"},
+		{Subspace: "bank", Key: "DefaultSendEnabled"},
+	}, sample.AccAddress())
+	require.ErrorIs(t, err, types.ErrNotWhitelisted)
+}
@@ -0,0 +1,30 @@
+package heroadmin
+
+import (
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/strangelove-ventures/hero/x/heroadmin/keeper"
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+)
+
+// NewProposalHandler creates a governance handler for the heroadmin proposals submitted through the admin module.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddProposalWhitelistProposal:
+			return k.AddProposalWhitelist(ctx, c.ProposalTypes, c.Params, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		case *types.RemoveProposalWhitelistProposal:
+			return k.RemoveProposalWhitelist(ctx, c.ProposalTypes, c.Params, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		case *types.SetAdminThresholdProposal:
+			return k.UpdateAdminThreshold(ctx, c.Threshold, c.Expiry, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized heroadmin proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultAdminProposalExpiry is how long admin module messages stay pending on a new chain
+const DefaultAdminProposalExpiry = 7 * 24 * time.Hour
+
+var _ cdctypes.UnpackInterfacesMessage = PendingAdminProposal{}
+
+// IsAdminProposalMsg returns true if the message may be held as a pendingAdminProposal and executed
+// once approved, only the admin module messages gated by the admin threshold are.
+func IsAdminProposalMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *adminmoduletypes.MsgSubmitProposal, *adminmoduletypes.MsgAddAdmin, *adminmoduletypes.MsgDeleteAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetMessage returns the admin module message wrapped by the pendingAdminProposal.
+func (p PendingAdminProposal) GetMessage() (sdk.Msg, error) {
+	msg, ok := p.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("pending admin proposal %d does not contain a message", p.Id)
+	}
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p PendingAdminProposal) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(p.Msg, &msg)
+}
+
+// HasApproval returns true if the address has approved the pendingAdminProposal.
+func (p PendingAdminProposal) HasApproval(address string) bool {
+	for _, approval := range p.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
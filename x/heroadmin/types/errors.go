@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/heroadmin module sentinel errors
+var (
+	ErrNotWhitelisted = sdkerrors.Register(ModuleName, 2, "proposal whitelist entry is not set")
+	ErrAdminApproval  = sdkerrors.Register(ModuleName, 3, "admin proposal requires admin approval")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 4, "unauthorized")
+)
@@ -0,0 +1,1942 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heroadmin/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventProposalWhitelistAdded is emitted when proposal types or parameters are added to the admin proposal whitelist.
+type EventProposalWhitelistAdded struct {
+	ProposalTypes []string           `protobuf:"bytes,1,rep,name=proposalTypes,proto3" json:"proposalTypes,omitempty"`
+	Params        []WhitelistedParam `protobuf:"bytes,2,rep,name=params,proto3" json:"params"`
+	Actor         string             `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (m *EventProposalWhitelistAdded) Reset()         { *m = EventProposalWhitelistAdded{} }
+func (m *EventProposalWhitelistAdded) String() string { return proto.CompactTextString(m) }
+func (*EventProposalWhitelistAdded) ProtoMessage()    {}
+func (*EventProposalWhitelistAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{0}
+}
+func (m *EventProposalWhitelistAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalWhitelistAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalWhitelistAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalWhitelistAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalWhitelistAdded.Merge(m, src)
+}
+func (m *EventProposalWhitelistAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalWhitelistAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalWhitelistAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalWhitelistAdded proto.InternalMessageInfo
+
+func (m *EventProposalWhitelistAdded) GetProposalTypes() []string {
+	if m != nil {
+		return m.ProposalTypes
+	}
+	return nil
+}
+
+func (m *EventProposalWhitelistAdded) GetParams() []WhitelistedParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *EventProposalWhitelistAdded) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+// EventProposalWhitelistRemoved is emitted when proposal types or parameters are removed from the admin proposal whitelist.
+type EventProposalWhitelistRemoved struct {
+	ProposalTypes []string           `protobuf:"bytes,1,rep,name=proposalTypes,proto3" json:"proposalTypes,omitempty"`
+	Params        []WhitelistedParam `protobuf:"bytes,2,rep,name=params,proto3" json:"params"`
+	Actor         string             `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (m *EventProposalWhitelistRemoved) Reset()         { *m = EventProposalWhitelistRemoved{} }
+func (m *EventProposalWhitelistRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventProposalWhitelistRemoved) ProtoMessage()    {}
+func (*EventProposalWhitelistRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{1}
+}
+func (m *EventProposalWhitelistRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalWhitelistRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalWhitelistRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalWhitelistRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalWhitelistRemoved.Merge(m, src)
+}
+func (m *EventProposalWhitelistRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalWhitelistRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalWhitelistRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalWhitelistRemoved proto.InternalMessageInfo
+
+func (m *EventProposalWhitelistRemoved) GetProposalTypes() []string {
+	if m != nil {
+		return m.ProposalTypes
+	}
+	return nil
+}
+
+func (m *EventProposalWhitelistRemoved) GetParams() []WhitelistedParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *EventProposalWhitelistRemoved) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+// EventAdminThresholdChanged is emitted when the number of admin approvals required for admin module messages changes.
+type EventAdminThresholdChanged struct {
+	Previous uint64 `protobuf:"varint,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	Current  uint64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Actor    string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// how long admin proposals stay pending before they expire
+	Expiry time.Duration `protobuf:"bytes,4,opt,name=expiry,proto3,stdduration" json:"expiry"`
+}
+
+func (m *EventAdminThresholdChanged) Reset()         { *m = EventAdminThresholdChanged{} }
+func (m *EventAdminThresholdChanged) String() string { return proto.CompactTextString(m) }
+func (*EventAdminThresholdChanged) ProtoMessage()    {}
+func (*EventAdminThresholdChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{2}
+}
+func (m *EventAdminThresholdChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminThresholdChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminThresholdChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminThresholdChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminThresholdChanged.Merge(m, src)
+}
+func (m *EventAdminThresholdChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminThresholdChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminThresholdChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminThresholdChanged proto.InternalMessageInfo
+
+func (m *EventAdminThresholdChanged) GetPrevious() uint64 {
+	if m != nil {
+		return m.Previous
+	}
+	return 0
+}
+
+func (m *EventAdminThresholdChanged) GetCurrent() uint64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *EventAdminThresholdChanged) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *EventAdminThresholdChanged) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+// EventAdminProposalSubmitted is emitted when an admin module message is held for approval by the other admins.
+// A held MsgSubmitProposal returns a MsgSubmitProposalResponse with proposal id 0, which the admin module never
+// assigns, and the id of the pending admin proposal is only carried by this event. The admin module id is assigned
+// when the proposal is executed.
+type EventAdminProposalSubmitted struct {
+	Proposal PendingAdminProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *EventAdminProposalSubmitted) Reset()         { *m = EventAdminProposalSubmitted{} }
+func (m *EventAdminProposalSubmitted) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalSubmitted) ProtoMessage()    {}
+func (*EventAdminProposalSubmitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{3}
+}
+func (m *EventAdminProposalSubmitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalSubmitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalSubmitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalSubmitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalSubmitted.Merge(m, src)
+}
+func (m *EventAdminProposalSubmitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalSubmitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalSubmitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalSubmitted proto.InternalMessageInfo
+
+func (m *EventAdminProposalSubmitted) GetProposal() PendingAdminProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return PendingAdminProposal{}
+}
+
+// EventAdminProposalApproved is emitted when an admin approves a pending admin proposal.
+type EventAdminProposalApproved struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver  string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	Approvals uint64 `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (m *EventAdminProposalApproved) Reset()         { *m = EventAdminProposalApproved{} }
+func (m *EventAdminProposalApproved) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalApproved) ProtoMessage()    {}
+func (*EventAdminProposalApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{4}
+}
+func (m *EventAdminProposalApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalApproved.Merge(m, src)
+}
+func (m *EventAdminProposalApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalApproved proto.InternalMessageInfo
+
+func (m *EventAdminProposalApproved) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventAdminProposalApproved) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *EventAdminProposalApproved) GetApprovals() uint64 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
+}
+
+// EventAdminProposalExecuted is emitted when a pending admin proposal reaches the threshold and is executed.
+type EventAdminProposalExecuted struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventAdminProposalExecuted) Reset()         { *m = EventAdminProposalExecuted{} }
+func (m *EventAdminProposalExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalExecuted) ProtoMessage()    {}
+func (*EventAdminProposalExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{5}
+}
+func (m *EventAdminProposalExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalExecuted.Merge(m, src)
+}
+func (m *EventAdminProposalExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalExecuted proto.InternalMessageInfo
+
+func (m *EventAdminProposalExecuted) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// EventAdminProposalExpired is emitted when an expired pending admin proposal is removed.
+type EventAdminProposalExpired struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventAdminProposalExpired) Reset()         { *m = EventAdminProposalExpired{} }
+func (m *EventAdminProposalExpired) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalExpired) ProtoMessage()    {}
+func (*EventAdminProposalExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{6}
+}
+func (m *EventAdminProposalExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalExpired.Merge(m, src)
+}
+func (m *EventAdminProposalExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalExpired proto.InternalMessageInfo
+
+func (m *EventAdminProposalExpired) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// EventAdminChanged is emitted when an admin is added to or removed from the admin module.
+type EventAdminChanged struct {
+	Change AdminChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change"`
+}
+
+func (m *EventAdminChanged) Reset()         { *m = EventAdminChanged{} }
+func (m *EventAdminChanged) String() string { return proto.CompactTextString(m) }
+func (*EventAdminChanged) ProtoMessage()    {}
+func (*EventAdminChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751cfd1b32bc9e62, []int{7}
+}
+func (m *EventAdminChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminChanged.Merge(m, src)
+}
+func (m *EventAdminChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminChanged proto.InternalMessageInfo
+
+func (m *EventAdminChanged) GetChange() AdminChange {
+	if m != nil {
+		return m.Change
+	}
+	return AdminChange{}
+}
+
+func init() {
+	proto.RegisterType((*EventProposalWhitelistAdded)(nil), "hero.heroadmin.EventProposalWhitelistAdded")
+	proto.RegisterType((*EventProposalWhitelistRemoved)(nil), "hero.heroadmin.EventProposalWhitelistRemoved")
+	proto.RegisterType((*EventAdminThresholdChanged)(nil), "hero.heroadmin.EventAdminThresholdChanged")
+	proto.RegisterType((*EventAdminProposalSubmitted)(nil), "hero.heroadmin.EventAdminProposalSubmitted")
+	proto.RegisterType((*EventAdminProposalApproved)(nil), "hero.heroadmin.EventAdminProposalApproved")
+	proto.RegisterType((*EventAdminProposalExecuted)(nil), "hero.heroadmin.EventAdminProposalExecuted")
+	proto.RegisterType((*EventAdminProposalExpired)(nil), "hero.heroadmin.EventAdminProposalExpired")
+	proto.RegisterType((*EventAdminChanged)(nil), "hero.heroadmin.EventAdminChanged")
+}
+
+func init() { proto.RegisterFile("heroadmin/events.proto", fileDescriptor_751cfd1b32bc9e62) }
+
+var fileDescriptor_751cfd1b32bc9e62 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xc1, 0x8b, 0xd3, 0x4e,
+	0x14, 0xee, 0xb4, 0xfd, 0xf5, 0xb7, 0x9d, 0xe2, 0x82, 0x61, 0x91, 0x6c, 0xab, 0xd9, 0x12, 0xf6,
+	0x50, 0x50, 0x13, 0xa8, 0x20, 0x88, 0x20, 0xb4, 0xba, 0x1e, 0xa5, 0xc4, 0x05, 0xc1, 0xcb, 0x92,
+	0x76, 0xde, 0x26, 0x03, 0x69, 0x66, 0x98, 0x4c, 0x6a, 0xf7, 0xbf, 0xf0, 0xa8, 0x78, 0xf6, 0x7f,
+	0xd9, 0xe3, 0x1e, 0x3d, 0xa9, 0xb4, 0xff, 0x88, 0xcc, 0x24, 0xd3, 0x6c, 0xd7, 0xdc, 0xbd, 0x94,
+	0xbc, 0xf7, 0xbe, 0xf7, 0xbd, 0xaf, 0x5f, 0xbe, 0xe0, 0x07, 0x31, 0x08, 0x16, 0x92, 0x25, 0x4d,
+	0x7d, 0x58, 0x41, 0x2a, 0x33, 0x8f, 0x0b, 0x26, 0x99, 0x75, 0xa8, 0xfa, 0xde, 0x6e, 0xd8, 0x3f,
+	0x8a, 0x58, 0xc4, 0xf4, 0xc8, 0x57, 0x4f, 0x05, 0xaa, 0xef, 0x44, 0x8c, 0x45, 0x09, 0xf8, 0xba,
+	0x9a, 0xe7, 0x97, 0x3e, 0xc9, 0x45, 0x28, 0x29, 0x4b, 0xcb, 0xb9, 0x5b, 0xb1, 0x73, 0xc1, 0x38,
+	0xcb, 0xc2, 0xe4, 0xe2, 0x53, 0x4c, 0x25, 0x24, 0x34, 0x93, 0x86, 0xa3, 0xc2, 0xe8, 0xdf, 0x8b,
+	0x90, 0x73, 0xc1, 0x56, 0x61, 0x52, 0xcc, 0xdd, 0xaf, 0x08, 0x0f, 0xce, 0x94, 0xb4, 0x59, 0xc9,
+	0xf0, 0xc1, 0x10, 0x4c, 0x08, 0x01, 0x62, 0x9d, 0xe2, 0x7b, 0x86, 0xfb, 0xfc, 0x8a, 0x43, 0x66,
+	0xa3, 0x61, 0x6b, 0xd4, 0x0d, 0xf6, 0x9b, 0xd6, 0x2b, 0xdc, 0xe1, 0xa1, 0x08, 0x97, 0x99, 0xdd,
+	0x1c, 0xb6, 0x46, 0xbd, 0xf1, 0xd0, 0xdb, 0xff, 0x83, 0xde, 0x8e, 0x15, 0xc8, 0x4c, 0x01, 0xa7,
+	0xed, 0xeb, 0x9f, 0x27, 0x8d, 0xa0, 0xdc, 0xb2, 0x8e, 0xf0, 0x7f, 0xe1, 0x42, 0x32, 0x61, 0xb7,
+	0x86, 0x68, 0xd4, 0x0d, 0x8a, 0xc2, 0xfd, 0x86, 0xf0, 0xa3, 0x7a, 0x6d, 0x01, 0x2c, 0xd9, 0xea,
+	0x1f, 0xab, 0xfb, 0x8e, 0x70, 0x5f, 0xab, 0x9b, 0x28, 0x8e, 0xf3, 0x58, 0x40, 0x16, 0xb3, 0x84,
+	0xbc, 0x8e, 0xc3, 0x34, 0x02, 0x62, 0xf5, 0xf1, 0x01, 0x17, 0xb0, 0xa2, 0x2c, 0x57, 0xaa, 0xd0,
+	0xa8, 0x1d, 0xec, 0x6a, 0xcb, 0xc6, 0xff, 0x2f, 0x72, 0x21, 0x20, 0x95, 0x76, 0x53, 0x8f, 0x4c,
+	0x59, 0x7f, 0xca, 0x7a, 0x89, 0x3b, 0xb0, 0xe6, 0x54, 0x5c, 0xd9, 0xed, 0x21, 0x1a, 0xf5, 0xc6,
+	0xc7, 0x5e, 0x91, 0x0c, 0xcf, 0x24, 0xc3, 0x7b, 0x53, 0x26, 0x63, 0x7a, 0xa0, 0x94, 0x7f, 0xf9,
+	0x75, 0x82, 0x82, 0x72, 0xc5, 0x05, 0x3c, 0xa8, 0x64, 0x1a, 0x27, 0xdf, 0xe7, 0xf3, 0x25, 0x95,
+	0x12, 0x88, 0xf5, 0x56, 0xe9, 0x2c, 0x9a, 0x5a, 0x67, 0x6f, 0x7c, 0x7a, 0xd7, 0x9e, 0x19, 0xa4,
+	0x84, 0xa6, 0xd1, 0x1e, 0x41, 0x69, 0xd1, 0x6e, 0xd7, 0xbd, 0xbc, 0xed, 0x86, 0x41, 0x4d, 0x74,
+	0xd8, 0x80, 0x58, 0x87, 0xb8, 0x49, 0x49, 0xe9, 0x43, 0x93, 0x6a, 0x77, 0x8a, 0x20, 0x82, 0xd0,
+	0x16, 0x74, 0x83, 0x5d, 0x6d, 0x3d, 0xc4, 0x5d, 0x13, 0xd2, 0x4c, 0xfb, 0xd0, 0x0e, 0xaa, 0x86,
+	0xfb, 0xa4, 0xee, 0xce, 0xd9, 0x1a, 0x16, 0xb9, 0xfc, 0xfb, 0x8e, 0xfb, 0x18, 0x1f, 0xd7, 0xa1,
+	0x39, 0x15, 0x35, 0xe0, 0x77, 0xf8, 0x7e, 0x05, 0x36, 0xef, 0xf1, 0x05, 0xee, 0x2c, 0xf4, 0x63,
+	0xe9, 0xce, 0xe0, 0xae, 0x3b, 0xb7, 0xd0, 0x26, 0x37, 0xc5, 0xc2, 0x74, 0x76, 0xbd, 0x71, 0xd0,
+	0xcd, 0xc6, 0x41, 0xbf, 0x37, 0x0e, 0xfa, 0xbc, 0x75, 0x1a, 0x37, 0x5b, 0xa7, 0xf1, 0x63, 0xeb,
+	0x34, 0x3e, 0x3e, 0x8f, 0xa8, 0x8c, 0xf3, 0xb9, 0xb7, 0x60, 0x4b, 0x3f, 0x93, 0x42, 0xa1, 0x13,
+	0xb6, 0x82, 0xa7, 0xea, 0x7a, 0x2e, 0x20, 0xf3, 0x15, 0xbd, 0xbf, 0xf6, 0xab, 0x8f, 0x57, 0xaa,
+	0x24, 0xcf, 0x3b, 0xfa, 0x85, 0x3f, 0xfb, 0x33, 0x00, 0x92, 0x3c, 0xc1, 0xa7, 0x58, 0x04, 0x00,
+	0x00,
+}
+
+func (m *EventProposalWhitelistAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalWhitelistAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalWhitelistAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposalTypes) > 0 {
+		for iNdEx := len(m.ProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposalTypes[iNdEx])
+			copy(dAtA[i:], m.ProposalTypes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalWhitelistRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalWhitelistRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalWhitelistRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposalTypes) > 0 {
+		for iNdEx := len(m.ProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposalTypes[iNdEx])
+			copy(dAtA[i:], m.ProposalTypes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminThresholdChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminThresholdChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminThresholdChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Current != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Previous != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Previous))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalSubmitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalSubmitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalSubmitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approvals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventProposalWhitelistAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposalTypes) > 0 {
+		for _, s := range m.ProposalTypes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProposalWhitelistRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposalTypes) > 0 {
+		for _, s := range m.ProposalTypes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminThresholdChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Previous != 0 {
+		n += 1 + sovEvents(uint64(m.Previous))
+	}
+	if m.Current != 0 {
+		n += 1 + sovEvents(uint64(m.Current))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventAdminProposalSubmitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventAdminProposalApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovEvents(uint64(m.Approvals))
+	}
+	return n
+}
+
+func (m *EventAdminProposalExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventAdminProposalExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventAdminChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Change.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventProposalWhitelistAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalWhitelistAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalWhitelistAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypes = append(m.ProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, WhitelistedParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposalWhitelistRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalWhitelistRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalWhitelistRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypes = append(m.ProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, WhitelistedParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminThresholdChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminThresholdChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminThresholdChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			m.Previous = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Previous |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminProposalSubmitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminProposalSubmitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminProposalSubmitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminProposalApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminProposalApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminProposalApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminProposalExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminProposalExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminProposalExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminProposalExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminProposalExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminProposalExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AdminKeeper defines the expected admin module keeper used to check who may approve admin proposals
+type AdminKeeper interface {
+	GetAdmins(ctx sdk.Context) []string
+}
+
+// MsgRouter defines the expected router used to execute admin module messages once they are approved
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		WhitelistedProposalTypeList: DefaultWhitelistedProposalTypes(),
+		WhitelistedParamList:        DefaultWhitelistedParams(),
+		AdminProposalExpiry:         DefaultAdminProposalExpiry,
+		PendingAdminProposalList:    []PendingAdminProposal{},
+		AdminChangeList:             []AdminChange{},
+		// this line is used by starport scaffolding # genesis/types/default
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in whitelistedProposalType and whitelistedParam
+	proposalTypes := make([]string, 0, len(gs.WhitelistedProposalTypeList))
+	for _, elem := range gs.WhitelistedProposalTypeList {
+		proposalTypes = append(proposalTypes, elem.TypeUrl)
+	}
+	if len(proposalTypes) > 0 || len(gs.WhitelistedParamList) > 0 {
+		if err := ValidateProposalWhitelist(proposalTypes, gs.WhitelistedParamList); err != nil {
+			return err
+		}
+	}
+	if gs.AdminProposalExpiry <= 0 {
+		return fmt.Errorf("admin proposal expiry must be positive")
+	}
+	// Check for duplicated ID in pendingAdminProposal
+	pendingAdminProposalIdMap := make(map[uint64]bool)
+	pendingAdminProposalCount := gs.GetPendingAdminProposalCount()
+	for _, elem := range gs.PendingAdminProposalList {
+		if _, ok := pendingAdminProposalIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pendingAdminProposal")
+		}
+		if elem.Id >= pendingAdminProposalCount {
+			return fmt.Errorf("pendingAdminProposal id should be lower or equal than the last id")
+		}
+		pendingAdminProposalIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in adminChange
+	adminChangeIdMap := make(map[uint64]bool)
+	adminChangeCount := gs.GetAdminChangeCount()
+	for _, elem := range gs.AdminChangeList {
+		if _, ok := adminChangeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for adminChange")
+		}
+		if elem.Id >= adminChangeCount {
+			return fmt.Errorf("adminChange id should be lower or equal than the last id")
+		}
+		adminChangeIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, pendingAdminProposal := range gs.PendingAdminProposalList {
+		if err := pendingAdminProposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heroadmin/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the heroadmin module's genesis state.
+type GenesisState struct {
+	WhitelistedProposalTypeList []WhitelistedProposalType `protobuf:"bytes,1,rep,name=whitelistedProposalTypeList,proto3" json:"whitelistedProposalTypeList"`
+	WhitelistedParamList        []WhitelistedParam        `protobuf:"bytes,2,rep,name=whitelistedParamList,proto3" json:"whitelistedParamList"`
+	AdminThreshold              uint64                    `protobuf:"varint,3,opt,name=adminThreshold,proto3" json:"adminThreshold,omitempty"`
+	AdminProposalExpiry         time.Duration             `protobuf:"bytes,4,opt,name=adminProposalExpiry,proto3,stdduration" json:"adminProposalExpiry"`
+	PendingAdminProposalList    []PendingAdminProposal    `protobuf:"bytes,5,rep,name=pendingAdminProposalList,proto3" json:"pendingAdminProposalList"`
+	PendingAdminProposalCount   uint64                    `protobuf:"varint,6,opt,name=pendingAdminProposalCount,proto3" json:"pendingAdminProposalCount,omitempty"`
+	AdminChangeList             []AdminChange             `protobuf:"bytes,7,rep,name=adminChangeList,proto3" json:"adminChangeList"`
+	AdminChangeCount            uint64                    `protobuf:"varint,8,opt,name=adminChangeCount,proto3" json:"adminChangeCount,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0be1115dc59236, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetWhitelistedProposalTypeList() []WhitelistedProposalType {
+	if m != nil {
+		return m.WhitelistedProposalTypeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhitelistedParamList() []WhitelistedParam {
+	if m != nil {
+		return m.WhitelistedParamList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminThreshold() uint64 {
+	if m != nil {
+		return m.AdminThreshold
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAdminProposalExpiry() time.Duration {
+	if m != nil {
+		return m.AdminProposalExpiry
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingAdminProposalList() []PendingAdminProposal {
+	if m != nil {
+		return m.PendingAdminProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdminProposalCount() uint64 {
+	if m != nil {
+		return m.PendingAdminProposalCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAdminChangeList() []AdminChange {
+	if m != nil {
+		return m.AdminChangeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminChangeCount() uint64 {
+	if m != nil {
+		return m.AdminChangeCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "hero.heroadmin.GenesisState")
+}
+
+func init() { proto.RegisterFile("heroadmin/genesis.proto", fileDescriptor_3c0be1115dc59236) }
+
+var fileDescriptor_3c0be1115dc59236 = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0xd8, 0x58, 0xcb, 0x54, 0xaa, 0x8c, 0x05, 0xd3, 0x16, 0xd2, 0x50, 0x44, 0x17, 0xc1,
+	0x19, 0xa8, 0xe0, 0xc9, 0x4b, 0xb7, 0x8a, 0x07, 0x3d, 0x2c, 0x6b, 0x45, 0xe8, 0xa5, 0xcc, 0x9a,
+	0xe9, 0x64, 0x20, 0x3b, 0x6f, 0x98, 0x99, 0xac, 0xdd, 0x6f, 0xe1, 0xd1, 0x8f, 0xd4, 0x63, 0x8f,
+	0x9e, 0x54, 0x76, 0x3f, 0x85, 0x37, 0xc9, 0x24, 0xdb, 0xac, 0x31, 0xed, 0x25, 0x24, 0xef, 0xf7,
+	0x7b, 0xbf, 0x3f, 0xbc, 0xa0, 0xc7, 0x19, 0x37, 0xc0, 0xd2, 0x89, 0x54, 0x54, 0x70, 0xc5, 0xad,
+	0xb4, 0x44, 0x1b, 0x70, 0x80, 0xb7, 0x4a, 0x80, 0x5c, 0xa3, 0xbb, 0xdb, 0x02, 0x04, 0x78, 0x88,
+	0x96, 0x6f, 0x15, 0x6b, 0x37, 0x16, 0x00, 0x22, 0xe7, 0xd4, 0x7f, 0x8d, 0x8b, 0x73, 0x9a, 0x16,
+	0x86, 0x39, 0x09, 0xaa, 0xc6, 0x0f, 0x1a, 0x79, 0x6d, 0x40, 0x83, 0x65, 0xf9, 0xd9, 0xd7, 0x4c,
+	0x3a, 0x9e, 0x4b, 0xeb, 0x96, 0x1a, 0x0d, 0xc7, 0x3f, 0xcf, 0x98, 0xd6, 0x06, 0xa6, 0x2c, 0xaf,
+	0xf0, 0x83, 0x3f, 0x21, 0xba, 0xff, 0xae, 0xca, 0xf6, 0xd1, 0x31, 0xc7, 0x31, 0xa0, 0xbd, 0x6b,
+	0x0d, 0x9e, 0x0e, 0x6b, 0xdd, 0x93, 0x99, 0xe6, 0x1f, 0xa4, 0x75, 0x51, 0x90, 0xac, 0xf5, 0x37,
+	0x0f, 0x9f, 0x91, 0x7f, 0x0b, 0x90, 0xcf, 0xdd, 0x2b, 0x83, 0xf0, 0xf2, 0xe7, 0x7e, 0x6f, 0x74,
+	0x9b, 0x22, 0x3e, 0x45, 0xdb, 0xab, 0x30, 0x33, 0x6c, 0xe2, 0x9d, 0xee, 0x78, 0xa7, 0xe4, 0x36,
+	0xa7, 0x92, 0x5b, 0x5b, 0x74, 0x6a, 0xe0, 0xa7, 0x68, 0xcb, 0x6f, 0x9d, 0x64, 0x86, 0xdb, 0x0c,
+	0xf2, 0x34, 0x5a, 0x4b, 0x82, 0x7e, 0x38, 0x6a, 0x4d, 0xf1, 0x27, 0xf4, 0xc8, 0x4f, 0x96, 0xe1,
+	0xde, 0x5e, 0x68, 0x69, 0x66, 0x51, 0x98, 0x04, 0xfd, 0xcd, 0xc3, 0x1d, 0x52, 0xdd, 0x81, 0x2c,
+	0xef, 0x40, 0xde, 0xd4, 0x77, 0x18, 0x6c, 0x94, 0xde, 0xdf, 0x7f, 0xed, 0x07, 0xa3, 0xae, 0x7d,
+	0x7c, 0x8e, 0x22, 0xcd, 0x55, 0x2a, 0x95, 0x38, 0x5a, 0x45, 0x7d, 0xbd, 0xbb, 0xbe, 0xde, 0x93,
+	0x76, 0xbd, 0x61, 0x07, 0xbf, 0xae, 0x78, 0xa3, 0x16, 0x7e, 0x8d, 0x76, 0xba, 0xb0, 0x63, 0x28,
+	0x94, 0x8b, 0xd6, 0x7d, 0xe3, 0x9b, 0x09, 0xf8, 0x3d, 0x7a, 0xe0, 0xbd, 0x8f, 0x33, 0xa6, 0x44,
+	0x75, 0xe5, 0x7b, 0x3e, 0xdc, 0x5e, 0x3b, 0xdc, 0x51, 0x43, 0xab, 0x33, 0xb5, 0x37, 0xf1, 0x73,
+	0xf4, 0x70, 0x65, 0x54, 0x25, 0xd8, 0xf0, 0x09, 0xfe, 0x9b, 0x0f, 0x86, 0x97, 0xf3, 0x38, 0xb8,
+	0x9a, 0xc7, 0xc1, 0xef, 0x79, 0x1c, 0x7c, 0x5b, 0xc4, 0xbd, 0xab, 0x45, 0xdc, 0xfb, 0xb1, 0x88,
+	0x7b, 0xa7, 0xaf, 0x84, 0x74, 0x59, 0x31, 0x26, 0x5f, 0x60, 0x42, 0xad, 0x33, 0xe5, 0x4a, 0x0e,
+	0x53, 0xfe, 0x62, 0xca, 0x95, 0x2b, 0x0c, 0xb7, 0xb4, 0xcc, 0x44, 0x2f, 0x68, 0xf3, 0x73, 0xbb,
+	0x99, 0xe6, 0x76, 0xbc, 0xee, 0x4f, 0xf4, 0xf2, 0xef, 0x00, 0x60, 0x0d, 0xfc, 0xb5, 0x79, 0x03,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminChangeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AdminChangeCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AdminChangeList) > 0 {
+		for iNdEx := len(m.AdminChangeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminChangeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PendingAdminProposalCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingAdminProposalCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PendingAdminProposalList) > 0 {
+		for iNdEx := len(m.PendingAdminProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AdminProposalExpiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdminProposalExpiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.AdminThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AdminThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WhitelistedParamList) > 0 {
+		for iNdEx := len(m.WhitelistedParamList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedParamList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WhitelistedProposalTypeList) > 0 {
+		for iNdEx := len(m.WhitelistedProposalTypeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedProposalTypeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedProposalTypeList) > 0 {
+		for _, e := range m.WhitelistedProposalTypeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistedParamList) > 0 {
+		for _, e := range m.WhitelistedParamList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovGenesis(uint64(m.AdminThreshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AdminProposalExpiry)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingAdminProposalList) > 0 {
+		for _, e := range m.PendingAdminProposalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingAdminProposalCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingAdminProposalCount))
+	}
+	if len(m.AdminChangeList) > 0 {
+		for _, e := range m.AdminChangeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AdminChangeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AdminChangeCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedProposalTypeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedProposalTypeList = append(m.WhitelistedProposalTypeList, WhitelistedProposalType{})
+			if err := m.WhitelistedProposalTypeList[len(m.WhitelistedProposalTypeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedParamList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedParamList = append(m.WhitelistedParamList, WhitelistedParam{})
+			if err := m.WhitelistedParamList[len(m.WhitelistedParamList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AdminProposalExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminProposalList = append(m.PendingAdminProposalList, PendingAdminProposal{})
+			if err := m.PendingAdminProposalList[len(m.PendingAdminProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminProposalCount", wireType)
+			}
+			m.PendingAdminProposalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingAdminProposalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminChangeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminChangeList = append(m.AdminChangeList, AdminChange{})
+			if err := m.AdminChangeList[len(m.AdminChangeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminChangeCount", wireType)
+			}
+			m.AdminChangeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminChangeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
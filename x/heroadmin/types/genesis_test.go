@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/hero/x/heroadmin/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				WhitelistedProposalTypeList: []types.WhitelistedProposalType{
+					{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal"},
+				},
+				WhitelistedParamList: []types.WhitelistedParam{
+					{Subspace: "bank", Key: "SendEnabled"},
+				},
+				AdminThreshold:      2,
+				AdminProposalExpiry: time.Hour,
+				PendingAdminProposalList: []types.PendingAdminProposal{
+					{Id: 0},
+					{Id: 1},
+				},
+				PendingAdminProposalCount: 2,
+				AdminChangeList: []types.AdminChange{
+					{Id: 0},
+					{Id: 1},
+				},
+				AdminChangeCount: 2,
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc:     "empty whitelist",
+			genState: &types.GenesisState{AdminProposalExpiry: time.Hour},
+			valid:    true,
+		},
+		{
+			desc: "duplicated whitelistedProposalType",
+			genState: &types.GenesisState{
+				WhitelistedProposalTypeList: []types.WhitelistedProposalType{
+					{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal"},
+					{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid whitelistedProposalType",
+			genState: &types.GenesisState{
+				WhitelistedProposalTypeList: []types.WhitelistedProposalType{
+					{TypeUrl: "SoftwareUpgradeProposal"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated whitelistedParam",
+			genState: &types.GenesisState{
+				WhitelistedParamList: []types.WhitelistedParam{
+					{Subspace: "bank", Key: "SendEnabled"},
+					{Subspace: "bank", Key: "SendEnabled"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid whitelistedParam",
+			genState: &types.GenesisState{
+				WhitelistedParamList: []types.WhitelistedParam{
+					{Subspace: "bank"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc:     "unset admin proposal expiry",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "duplicated pendingAdminProposal",
+			genState: &types.GenesisState{
+				AdminProposalExpiry: time.Hour,
+				PendingAdminProposalList: []types.PendingAdminProposal{
+					{Id: 0},
+					{Id: 0},
+				},
+				PendingAdminProposalCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid pendingAdminProposal count",
+			genState: &types.GenesisState{
+				AdminProposalExpiry: time.Hour,
+				PendingAdminProposalList: []types.PendingAdminProposal{
+					{Id: 1},
+				},
+				PendingAdminProposalCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated adminChange",
+			genState: &types.GenesisState{
+				AdminProposalExpiry: time.Hour,
+				AdminChangeList: []types.AdminChange{
+					{Id: 0},
+					{Id: 0},
+				},
+				AdminChangeCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid adminChange count",
+			genState: &types.GenesisState{
+				AdminProposalExpiry: time.Hour,
+				AdminChangeList: []types.AdminChange{
+					{Id: 1},
+				},
+				AdminChangeCount: 0,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
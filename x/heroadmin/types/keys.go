@@ -0,0 +1,50 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "heroadmin"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_heroadmin"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	WhitelistedProposalTypeKeyPrefix = "WhitelistedProposalType/value/"
+	WhitelistedParamKeyPrefix        = "WhitelistedParam/value/"
+)
+
+const (
+	AdminThresholdKey            = "AdminThreshold/value/"
+	AdminProposalExpiryKey       = "AdminProposalExpiry/value/"
+	PendingAdminProposalKey      = "PendingAdminProposal/value/"
+	PendingAdminProposalCountKey = "PendingAdminProposal/count/"
+	AdminChangeKey               = "AdminChange/value/"
+	AdminChangeCountKey          = "AdminChange/count/"
+)
+
+// WhitelistedProposalTypeKey returns the store key to retrieve a WhitelistedProposalType from the index fields
+func WhitelistedProposalTypeKey(typeUrl string) []byte {
+	return append([]byte(typeUrl), []byte("/")...)
+}
+
+// WhitelistedParamKey returns the store key to retrieve a WhitelistedParam from the index fields
+func WhitelistedParamKey(subspace, key string) []byte {
+	var k []byte
+
+	k = append(k, []byte(subspace)...)
+	k = append(k, []byte("/")...)
+	k = append(k, []byte(key)...)
+	k = append(k, []byte("/")...)
+
+	return k
+}
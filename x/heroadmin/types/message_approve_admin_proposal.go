@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveAdminProposal = "approve_admin_proposal"
+
+var _ sdk.Msg = &MsgApproveAdminProposal{}
+
+func NewMsgApproveAdminProposal(from string, id uint64) *MsgApproveAdminProposal {
+	return &MsgApproveAdminProposal{
+		From: from,
+		Id:   id,
+	}
+}
+
+func (msg *MsgApproveAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveAdminProposal) Type() string {
+	return TypeMsgApproveAdminProposal
+}
+
+func (msg *MsgApproveAdminProposal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgApproveAdminProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveAdminProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgApproveAdminProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgApproveAdminProposal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgApproveAdminProposal{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgApproveAdminProposal{
+				From: sample.AccAddress(),
+				Id:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddProposalWhitelist defines the type for an AddProposalWhitelistProposal
+	ProposalTypeAddProposalWhitelist = "AddProposalWhitelist"
+	// ProposalTypeRemoveProposalWhitelist defines the type for a RemoveProposalWhitelistProposal
+	ProposalTypeRemoveProposalWhitelist = "RemoveProposalWhitelist"
+	// ProposalTypeSetAdminThreshold defines the type for a SetAdminThresholdProposal
+	ProposalTypeSetAdminThreshold = "SetAdminThreshold"
+)
+
+var (
+	_ govtypes.Content = &AddProposalWhitelistProposal{}
+	_ govtypes.Content = &RemoveProposalWhitelistProposal{}
+	_ govtypes.Content = &SetAdminThresholdProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddProposalWhitelist)
+	govtypes.RegisterProposalTypeCodec(&AddProposalWhitelistProposal{}, "heroadmin/AddProposalWhitelistProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveProposalWhitelist)
+	govtypes.RegisterProposalTypeCodec(&RemoveProposalWhitelistProposal{}, "heroadmin/RemoveProposalWhitelistProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetAdminThreshold)
+	govtypes.RegisterProposalTypeCodec(&SetAdminThresholdProposal{}, "heroadmin/SetAdminThresholdProposal")
+}
+
+// NewAddProposalWhitelistProposal creates a proposal adding proposal types and parameters to the admin proposal whitelist
+func NewAddProposalWhitelistProposal(title, description string, proposalTypes []string, params []WhitelistedParam) govtypes.Content {
+	return &AddProposalWhitelistProposal{
+		Title:         title,
+		Description:   description,
+		ProposalTypes: proposalTypes,
+		Params:        params,
+	}
+}
+
+func (p *AddProposalWhitelistProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddProposalWhitelistProposal) ProposalType() string {
+	return ProposalTypeAddProposalWhitelist
+}
+
+func (p *AddProposalWhitelistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateProposalWhitelist(p.ProposalTypes, p.Params)
+}
+
+func (p AddProposalWhitelistProposal) String() string {
+	return fmt.Sprintf(`Add Proposal Whitelist Proposal:
+  Title:          %s
+  Description:    %s
+  Proposal Types: %v
+  Params:         %v
+`, p.Title, p.Description, p.ProposalTypes, p.Params)
+}
+
+// NewRemoveProposalWhitelistProposal creates a proposal removing proposal types and parameters from the admin proposal whitelist
+func NewRemoveProposalWhitelistProposal(title, description string, proposalTypes []string, params []WhitelistedParam) govtypes.Content {
+	return &RemoveProposalWhitelistProposal{
+		Title:         title,
+		Description:   description,
+		ProposalTypes: proposalTypes,
+		Params:        params,
+	}
+}
+
+func (p *RemoveProposalWhitelistProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveProposalWhitelistProposal) ProposalType() string {
+	return ProposalTypeRemoveProposalWhitelist
+}
+
+func (p *RemoveProposalWhitelistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateProposalWhitelist(p.ProposalTypes, p.Params)
+}
+
+func (p RemoveProposalWhitelistProposal) String() string {
+	return fmt.Sprintf(`Remove Proposal Whitelist Proposal:
+  Title:          %s
+  Description:    %s
+  Proposal Types: %v
+  Params:         %v
+`, p.Title, p.Description, p.ProposalTypes, p.Params)
+}
+
+// NewSetAdminThresholdProposal creates a proposal setting how many admins must approve admin module messages
+// and how long they stay pending
+func NewSetAdminThresholdProposal(title, description string, threshold uint64, expiry time.Duration) govtypes.Content {
+	return &SetAdminThresholdProposal{
+		Title:       title,
+		Description: description,
+		Threshold:   threshold,
+		Expiry:      expiry,
+	}
+}
+
+func (p *SetAdminThresholdProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetAdminThresholdProposal) ProposalType() string { return ProposalTypeSetAdminThreshold }
+
+func (p *SetAdminThresholdProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Expiry <= 0 {
+		return sdkerrors.Wrap(ErrAdminApproval, "admin proposal expiry must be positive")
+	}
+	return nil
+}
+
+func (p SetAdminThresholdProposal) String() string {
+	return fmt.Sprintf(`Set Admin Threshold Proposal:
+  Title:       %s
+  Description: %s
+  Threshold:   %d
+  Expiry:      %s
+`, p.Title, p.Description, p.Threshold, p.Expiry)
+}
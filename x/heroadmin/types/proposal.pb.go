@@ -0,0 +1,1148 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heroadmin/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddProposalWhitelistProposal adds proposal types and parameters to the admin proposal whitelist.
+type AddProposalWhitelistProposal struct {
+	Title         string             `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ProposalTypes []string           `protobuf:"bytes,3,rep,name=proposalTypes,proto3" json:"proposalTypes,omitempty"`
+	Params        []WhitelistedParam `protobuf:"bytes,4,rep,name=params,proto3" json:"params"`
+}
+
+func (m *AddProposalWhitelistProposal) Reset()      { *m = AddProposalWhitelistProposal{} }
+func (*AddProposalWhitelistProposal) ProtoMessage() {}
+func (*AddProposalWhitelistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836414661b7f77e5, []int{0}
+}
+func (m *AddProposalWhitelistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddProposalWhitelistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddProposalWhitelistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddProposalWhitelistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddProposalWhitelistProposal.Merge(m, src)
+}
+func (m *AddProposalWhitelistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddProposalWhitelistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddProposalWhitelistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddProposalWhitelistProposal proto.InternalMessageInfo
+
+func (m *AddProposalWhitelistProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AddProposalWhitelistProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddProposalWhitelistProposal) GetProposalTypes() []string {
+	if m != nil {
+		return m.ProposalTypes
+	}
+	return nil
+}
+
+func (m *AddProposalWhitelistProposal) GetParams() []WhitelistedParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// RemoveProposalWhitelistProposal removes proposal types and parameters from the admin proposal whitelist.
+type RemoveProposalWhitelistProposal struct {
+	Title         string             `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ProposalTypes []string           `protobuf:"bytes,3,rep,name=proposalTypes,proto3" json:"proposalTypes,omitempty"`
+	Params        []WhitelistedParam `protobuf:"bytes,4,rep,name=params,proto3" json:"params"`
+}
+
+func (m *RemoveProposalWhitelistProposal) Reset()      { *m = RemoveProposalWhitelistProposal{} }
+func (*RemoveProposalWhitelistProposal) ProtoMessage() {}
+func (*RemoveProposalWhitelistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836414661b7f77e5, []int{1}
+}
+func (m *RemoveProposalWhitelistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveProposalWhitelistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveProposalWhitelistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveProposalWhitelistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveProposalWhitelistProposal.Merge(m, src)
+}
+func (m *RemoveProposalWhitelistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveProposalWhitelistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveProposalWhitelistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveProposalWhitelistProposal proto.InternalMessageInfo
+
+func (m *RemoveProposalWhitelistProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveProposalWhitelistProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RemoveProposalWhitelistProposal) GetProposalTypes() []string {
+	if m != nil {
+		return m.ProposalTypes
+	}
+	return nil
+}
+
+func (m *RemoveProposalWhitelistProposal) GetParams() []WhitelistedParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// SetAdminThresholdProposal sets how many admins must approve admin module messages through the admin module,
+// and how long admin module messages stay pending before they expire.
+type SetAdminThresholdProposal struct {
+	Title       string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Threshold   uint64        `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Expiry      time.Duration `protobuf:"bytes,4,opt,name=expiry,proto3,stdduration" json:"expiry"`
+}
+
+func (m *SetAdminThresholdProposal) Reset()      { *m = SetAdminThresholdProposal{} }
+func (*SetAdminThresholdProposal) ProtoMessage() {}
+func (*SetAdminThresholdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836414661b7f77e5, []int{2}
+}
+func (m *SetAdminThresholdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAdminThresholdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAdminThresholdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAdminThresholdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAdminThresholdProposal.Merge(m, src)
+}
+func (m *SetAdminThresholdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAdminThresholdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAdminThresholdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAdminThresholdProposal proto.InternalMessageInfo
+
+func (m *SetAdminThresholdProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetAdminThresholdProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetAdminThresholdProposal) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SetAdminThresholdProposal) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AddProposalWhitelistProposal)(nil), "hero.heroadmin.AddProposalWhitelistProposal")
+	proto.RegisterType((*RemoveProposalWhitelistProposal)(nil), "hero.heroadmin.RemoveProposalWhitelistProposal")
+	proto.RegisterType((*SetAdminThresholdProposal)(nil), "hero.heroadmin.SetAdminThresholdProposal")
+}
+
+func init() { proto.RegisterFile("heroadmin/proposal.proto", fileDescriptor_836414661b7f77e5) }
+
+var fileDescriptor_836414661b7f77e5 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0x3d, 0xeb, 0xd3, 0x40,
+	0x1c, 0xc7, 0x73, 0x26, 0x16, 0x7b, 0x45, 0x87, 0xf0, 0x1f, 0xd2, 0x52, 0x92, 0x50, 0x1c, 0xb2,
+	0x78, 0x07, 0x15, 0x1c, 0x14, 0x84, 0x16, 0x5f, 0x40, 0x89, 0x05, 0xc1, 0x45, 0xd2, 0xe6, 0x67,
+	0x72, 0x90, 0xe4, 0xc2, 0xdd, 0xa5, 0xb6, 0xef, 0xc2, 0xb1, 0xa3, 0x2f, 0xc2, 0x57, 0x20, 0x08,
+	0x1d, 0x3b, 0x3a, 0xa9, 0xb4, 0x6f, 0x44, 0x2e, 0x0f, 0xad, 0xc5, 0xd1, 0xe9, 0xbf, 0xdd, 0x7d,
+	0x3f, 0xbf, 0x67, 0xbe, 0xd8, 0x49, 0x41, 0xf0, 0x28, 0xce, 0x59, 0x41, 0x4b, 0xc1, 0x4b, 0x2e,
+	0xa3, 0x8c, 0x94, 0x82, 0x2b, 0x6e, 0x3f, 0xd1, 0x84, 0x5c, 0xf0, 0xe8, 0x2e, 0xe1, 0x09, 0xaf,
+	0x11, 0xd5, 0xaf, 0x26, 0x6a, 0xe4, 0x26, 0x9c, 0x27, 0x19, 0xd0, 0xfa, 0xb7, 0xaa, 0x3e, 0xd2,
+	0xb8, 0x12, 0x91, 0x62, 0xbc, 0x68, 0xf9, 0xe4, 0xdf, 0xfa, 0x1f, 0x3e, 0xa5, 0x4c, 0x41, 0xc6,
+	0xa4, 0x6a, 0x62, 0x26, 0xdf, 0x10, 0x1e, 0xcf, 0xe2, 0x78, 0xd1, 0xf2, 0x77, 0x1d, 0xee, 0x04,
+	0xfb, 0x0e, 0x3f, 0x54, 0x4c, 0x65, 0xe0, 0x20, 0x1f, 0x05, 0xfd, 0xb0, 0xf9, 0xd8, 0x3e, 0x1e,
+	0xc4, 0x20, 0xd7, 0x82, 0x95, 0xba, 0x9f, 0xf3, 0xa0, 0x66, 0x7f, 0x4b, 0xf6, 0x53, 0xfc, 0xb8,
+	0x6b, 0xba, 0xdc, 0x95, 0x20, 0x1d, 0xd3, 0x37, 0x83, 0x7e, 0x78, 0x2b, 0xda, 0xaf, 0x71, 0xaf,
+	0x8c, 0x44, 0x94, 0x4b, 0xc7, 0xf2, 0xcd, 0x60, 0x30, 0xf5, 0xc9, 0xed, 0xe6, 0xe4, 0x32, 0x10,
+	0xc4, 0x0b, 0x1d, 0x38, 0xb7, 0x0e, 0x3f, 0x3d, 0x23, 0x6c, 0xb3, 0x5e, 0x5a, 0xfb, 0x2f, 0x9e,
+	0x31, 0xf9, 0x8e, 0xb0, 0x17, 0x42, 0xce, 0x37, 0x70, 0xbf, 0xf7, 0xf8, 0x8a, 0xf0, 0xf0, 0x2d,
+	0xa8, 0x99, 0xce, 0x58, 0xa6, 0x02, 0x64, 0xca, 0xb3, 0xf8, 0xbf, 0x37, 0x18, 0xe3, 0xbe, 0xea,
+	0x8a, 0x39, 0xa6, 0x8f, 0x02, 0x2b, 0xbc, 0x0a, 0xf6, 0x2b, 0xdc, 0x83, 0x6d, 0xc9, 0xc4, 0xce,
+	0xb1, 0x7c, 0x14, 0x0c, 0xa6, 0x43, 0xd2, 0xb8, 0x8a, 0x74, 0xae, 0x22, 0x6f, 0x5a, 0x57, 0xcd,
+	0x1f, 0xe9, 0x91, 0xf7, 0xbf, 0x3c, 0x14, 0xb6, 0x29, 0xcd, 0xd8, 0xf3, 0xc5, 0xe1, 0xe4, 0xa2,
+	0xe3, 0xc9, 0x45, 0xbf, 0x4f, 0x2e, 0xfa, 0x7c, 0x76, 0x8d, 0xe3, 0xd9, 0x35, 0x7e, 0x9c, 0x5d,
+	0xe3, 0xfd, 0x8b, 0x84, 0xa9, 0xb4, 0x5a, 0x91, 0x35, 0xcf, 0xa9, 0x54, 0x22, 0x2a, 0x12, 0xc8,
+	0xf8, 0x06, 0x9e, 0x6d, 0xa0, 0x50, 0x95, 0x00, 0x49, 0xf5, 0x81, 0xe8, 0x96, 0x5e, 0x8d, 0xaa,
+	0xf4, 0x39, 0x57, 0xbd, 0xba, 0xf9, 0xf3, 0x3f, 0x03, 0x00, 0xb1, 0x63, 0x6d, 0x3f, 0x22, 0x03,
+	0x00, 0x00,
+}
+
+func (m *AddProposalWhitelistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddProposalWhitelistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddProposalWhitelistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProposalTypes) > 0 {
+		for iNdEx := len(m.ProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposalTypes[iNdEx])
+			copy(dAtA[i:], m.ProposalTypes[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.ProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveProposalWhitelistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveProposalWhitelistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveProposalWhitelistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProposalTypes) > 0 {
+		for iNdEx := len(m.ProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposalTypes[iNdEx])
+			copy(dAtA[i:], m.ProposalTypes[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.ProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAdminThresholdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAdminThresholdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAdminThresholdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProposal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Threshold != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddProposalWhitelistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.ProposalTypes) > 0 {
+		for _, s := range m.ProposalTypes {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveProposalWhitelistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.ProposalTypes) > 0 {
+		for _, s := range m.ProposalTypes {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetAdminThresholdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovProposal(uint64(m.Threshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddProposalWhitelistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddProposalWhitelistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddProposalWhitelistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypes = append(m.ProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, WhitelistedParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveProposalWhitelistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveProposalWhitelistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveProposalWhitelistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypes = append(m.ProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, WhitelistedParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAdminThresholdProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAdminThresholdProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAdminThresholdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	tokenfactorytypes "github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// ProposalTypeURL returns the type url the proposal content is packed under.
+func ProposalTypeURL(content govtypes.Content) string {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return ""
+	}
+	return "/" + proto.MessageName(msg)
+}
+
+// DefaultWhitelistedProposalTypes returns the proposal types admins may submit on a new chain.
+// Parameter change proposals are checked against the whitelisted parameters instead. The proposals
+// changing the whitelist and the admin threshold are whitelisted like any other proposal type, so
+// removing them from the whitelist freezes it.
+func DefaultWhitelistedProposalTypes() []WhitelistedProposalType {
+	return []WhitelistedProposalType{
+		{TypeUrl: ProposalTypeURL(&upgradetypes.SoftwareUpgradeProposal{})},
+		{TypeUrl: ProposalTypeURL(&upgradetypes.CancelSoftwareUpgradeProposal{})},
+		{TypeUrl: ProposalTypeURL(&tokenfactorytypes.CancelRoleChangeProposal{})},
+		{TypeUrl: ProposalTypeURL(&tokenfactorytypes.SetRateLimitProposal{})},
+		{TypeUrl: ProposalTypeURL(&tokenfactorytypes.RemoveRateLimitProposal{})},
+		{TypeUrl: ProposalTypeURL(&SetAdminThresholdProposal{})},
+		{TypeUrl: ProposalTypeURL(&AddProposalWhitelistProposal{})},
+		{TypeUrl: ProposalTypeURL(&RemoveProposalWhitelistProposal{})},
+	}
+}
+
+// DefaultWhitelistedParams returns the parameters admins may change on a new chain.
+func DefaultWhitelistedParams() []WhitelistedParam {
+	return []WhitelistedParam{
+		// bank
+		{Subspace: banktypes.ModuleName, Key: "SendEnabled"},
+		// ibc transfer
+		{Subspace: ibctransfertypes.ModuleName, Key: "SendEnabled"},
+		{Subspace: ibctransfertypes.ModuleName, Key: "ReceiveEnabled"},
+		// ica
+		{Subspace: icahosttypes.SubModuleName, Key: "HostEnabled"},
+		{Subspace: icahosttypes.SubModuleName, Key: "AllowMessages"},
+		// tokenfactory
+		{Subspace: tokenfactorytypes.ModuleName, Key: string(tokenfactorytypes.KeyEnforceReserves)},
+		{Subspace: tokenfactorytypes.ModuleName, Key: string(tokenfactorytypes.KeyRoleChangeDelay)},
+		{Subspace: tokenfactorytypes.ModuleName, Key: string(tokenfactorytypes.KeyFeeConversionRate)},
+	}
+}
+
+// ValidateProposalWhitelist checks the proposal types and parameters of a whitelist change.
+func ValidateProposalWhitelist(proposalTypes []string, params []WhitelistedParam) error {
+	if len(proposalTypes) == 0 && len(params) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no proposal types or params given")
+	}
+
+	seen := make(map[string]struct{})
+	for _, typeUrl := range proposalTypes {
+		if !strings.HasPrefix(typeUrl, "/") || len(typeUrl) == 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid proposal type url (%s)", typeUrl)
+		}
+		index := string(WhitelistedProposalTypeKey(typeUrl))
+		if _, ok := seen[index]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated proposal type %s", typeUrl)
+		}
+		seen[index] = struct{}{}
+	}
+
+	seen = make(map[string]struct{})
+	for _, param := range params {
+		if err := param.Validate(); err != nil {
+			return err
+		}
+		index := string(WhitelistedParamKey(param.Subspace, param.Key))
+		if _, ok := seen[index]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated param %s/%s", param.Subspace, param.Key)
+		}
+		seen[index] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks that both the subspace and the key of the parameter are set.
+func (p WhitelistedParam) Validate() error {
+	if strings.TrimSpace(p.Subspace) == "" || strings.TrimSpace(p.Key) == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid param (%s/%s)", p.Subspace, p.Key)
+	}
+	return nil
+}
+
+// ParseWhitelistedParam parses a parameter written as subspace/key.
+func ParseWhitelistedParam(s string) (WhitelistedParam, error) {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return WhitelistedParam{}, fmt.Errorf("invalid param %q, expected subspace/key", s)
+	}
+	param := WhitelistedParam{Subspace: parts[0], Key: parts[1]}
+	return param, param.Validate()
+}
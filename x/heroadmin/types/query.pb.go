@@ -0,0 +1,2839 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heroadmin/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryAllWhitelistedProposalTypeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWhitelistedProposalTypeRequest) Reset() {
+	*m = QueryAllWhitelistedProposalTypeRequest{}
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWhitelistedProposalTypeRequest) ProtoMessage()    {}
+func (*QueryAllWhitelistedProposalTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{0}
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWhitelistedProposalTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWhitelistedProposalTypeRequest.Merge(m, src)
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWhitelistedProposalTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWhitelistedProposalTypeRequest proto.InternalMessageInfo
+
+func (m *QueryAllWhitelistedProposalTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllWhitelistedProposalTypeResponse struct {
+	WhitelistedProposalType []WhitelistedProposalType `protobuf:"bytes,1,rep,name=whitelistedProposalType,proto3" json:"whitelistedProposalType"`
+	Pagination              *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) Reset() {
+	*m = QueryAllWhitelistedProposalTypeResponse{}
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWhitelistedProposalTypeResponse) ProtoMessage()    {}
+func (*QueryAllWhitelistedProposalTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{1}
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWhitelistedProposalTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWhitelistedProposalTypeResponse.Merge(m, src)
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWhitelistedProposalTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWhitelistedProposalTypeResponse proto.InternalMessageInfo
+
+func (m *QueryAllWhitelistedProposalTypeResponse) GetWhitelistedProposalType() []WhitelistedProposalType {
+	if m != nil {
+		return m.WhitelistedProposalType
+	}
+	return nil
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllWhitelistedParamRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWhitelistedParamRequest) Reset()         { *m = QueryAllWhitelistedParamRequest{} }
+func (m *QueryAllWhitelistedParamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWhitelistedParamRequest) ProtoMessage()    {}
+func (*QueryAllWhitelistedParamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{2}
+}
+func (m *QueryAllWhitelistedParamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWhitelistedParamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWhitelistedParamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWhitelistedParamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWhitelistedParamRequest.Merge(m, src)
+}
+func (m *QueryAllWhitelistedParamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWhitelistedParamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWhitelistedParamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWhitelistedParamRequest proto.InternalMessageInfo
+
+func (m *QueryAllWhitelistedParamRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllWhitelistedParamResponse struct {
+	WhitelistedParam []WhitelistedParam  `protobuf:"bytes,1,rep,name=whitelistedParam,proto3" json:"whitelistedParam"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWhitelistedParamResponse) Reset()         { *m = QueryAllWhitelistedParamResponse{} }
+func (m *QueryAllWhitelistedParamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWhitelistedParamResponse) ProtoMessage()    {}
+func (*QueryAllWhitelistedParamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{3}
+}
+func (m *QueryAllWhitelistedParamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWhitelistedParamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWhitelistedParamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWhitelistedParamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWhitelistedParamResponse.Merge(m, src)
+}
+func (m *QueryAllWhitelistedParamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWhitelistedParamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWhitelistedParamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWhitelistedParamResponse proto.InternalMessageInfo
+
+func (m *QueryAllWhitelistedParamResponse) GetWhitelistedParam() []WhitelistedParam {
+	if m != nil {
+		return m.WhitelistedParam
+	}
+	return nil
+}
+
+func (m *QueryAllWhitelistedParamResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAdminThresholdRequest struct {
+}
+
+func (m *QueryAdminThresholdRequest) Reset()         { *m = QueryAdminThresholdRequest{} }
+func (m *QueryAdminThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminThresholdRequest) ProtoMessage()    {}
+func (*QueryAdminThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{4}
+}
+func (m *QueryAdminThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminThresholdRequest.Merge(m, src)
+}
+func (m *QueryAdminThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminThresholdRequest proto.InternalMessageInfo
+
+type QueryAdminThresholdResponse struct {
+	Threshold uint64        `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Admins    uint64        `protobuf:"varint,2,opt,name=admins,proto3" json:"admins,omitempty"`
+	Expiry    time.Duration `protobuf:"bytes,3,opt,name=expiry,proto3,stdduration" json:"expiry"`
+}
+
+func (m *QueryAdminThresholdResponse) Reset()         { *m = QueryAdminThresholdResponse{} }
+func (m *QueryAdminThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminThresholdResponse) ProtoMessage()    {}
+func (*QueryAdminThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{5}
+}
+func (m *QueryAdminThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminThresholdResponse.Merge(m, src)
+}
+func (m *QueryAdminThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminThresholdResponse proto.InternalMessageInfo
+
+func (m *QueryAdminThresholdResponse) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *QueryAdminThresholdResponse) GetAdmins() uint64 {
+	if m != nil {
+		return m.Admins
+	}
+	return 0
+}
+
+func (m *QueryAdminThresholdResponse) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type QueryGetPendingAdminProposalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetPendingAdminProposalRequest) Reset()         { *m = QueryGetPendingAdminProposalRequest{} }
+func (m *QueryGetPendingAdminProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingAdminProposalRequest) ProtoMessage()    {}
+func (*QueryGetPendingAdminProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{6}
+}
+func (m *QueryGetPendingAdminProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingAdminProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingAdminProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingAdminProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingAdminProposalRequest.Merge(m, src)
+}
+func (m *QueryGetPendingAdminProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingAdminProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingAdminProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingAdminProposalRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingAdminProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetPendingAdminProposalResponse struct {
+	PendingAdminProposal PendingAdminProposal `protobuf:"bytes,1,opt,name=pendingAdminProposal,proto3" json:"pendingAdminProposal"`
+}
+
+func (m *QueryGetPendingAdminProposalResponse) Reset()         { *m = QueryGetPendingAdminProposalResponse{} }
+func (m *QueryGetPendingAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingAdminProposalResponse) ProtoMessage()    {}
+func (*QueryGetPendingAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{7}
+}
+func (m *QueryGetPendingAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingAdminProposalResponse.Merge(m, src)
+}
+func (m *QueryGetPendingAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingAdminProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingAdminProposalResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingAdminProposalResponse) GetPendingAdminProposal() PendingAdminProposal {
+	if m != nil {
+		return m.PendingAdminProposal
+	}
+	return PendingAdminProposal{}
+}
+
+type QueryAllPendingAdminProposalRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingAdminProposalRequest) Reset()         { *m = QueryAllPendingAdminProposalRequest{} }
+func (m *QueryAllPendingAdminProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingAdminProposalRequest) ProtoMessage()    {}
+func (*QueryAllPendingAdminProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{8}
+}
+func (m *QueryAllPendingAdminProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingAdminProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingAdminProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingAdminProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingAdminProposalRequest.Merge(m, src)
+}
+func (m *QueryAllPendingAdminProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingAdminProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingAdminProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingAdminProposalRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingAdminProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingAdminProposalResponse struct {
+	PendingAdminProposal []PendingAdminProposal `protobuf:"bytes,1,rep,name=pendingAdminProposal,proto3" json:"pendingAdminProposal"`
+	Pagination           *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingAdminProposalResponse) Reset()         { *m = QueryAllPendingAdminProposalResponse{} }
+func (m *QueryAllPendingAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingAdminProposalResponse) ProtoMessage()    {}
+func (*QueryAllPendingAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{9}
+}
+func (m *QueryAllPendingAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingAdminProposalResponse.Merge(m, src)
+}
+func (m *QueryAllPendingAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingAdminProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingAdminProposalResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingAdminProposalResponse) GetPendingAdminProposal() []PendingAdminProposal {
+	if m != nil {
+		return m.PendingAdminProposal
+	}
+	return nil
+}
+
+func (m *QueryAllPendingAdminProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAdminChangeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAdminChangeRequest) Reset()         { *m = QueryAllAdminChangeRequest{} }
+func (m *QueryAllAdminChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAdminChangeRequest) ProtoMessage()    {}
+func (*QueryAllAdminChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{10}
+}
+func (m *QueryAllAdminChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAdminChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAdminChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAdminChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAdminChangeRequest.Merge(m, src)
+}
+func (m *QueryAllAdminChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAdminChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAdminChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAdminChangeRequest proto.InternalMessageInfo
+
+func (m *QueryAllAdminChangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAdminChangeResponse struct {
+	AdminChange []AdminChange       `protobuf:"bytes,1,rep,name=adminChange,proto3" json:"adminChange"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAdminChangeResponse) Reset()         { *m = QueryAllAdminChangeResponse{} }
+func (m *QueryAllAdminChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAdminChangeResponse) ProtoMessage()    {}
+func (*QueryAllAdminChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4443ba95d021150f, []int{11}
+}
+func (m *QueryAllAdminChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAdminChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAdminChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAdminChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAdminChangeResponse.Merge(m, src)
+}
+func (m *QueryAllAdminChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAdminChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAdminChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAdminChangeResponse proto.InternalMessageInfo
+
+func (m *QueryAllAdminChangeResponse) GetAdminChange() []AdminChange {
+	if m != nil {
+		return m.AdminChange
+	}
+	return nil
+}
+
+func (m *QueryAllAdminChangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAllWhitelistedProposalTypeRequest)(nil), "hero.heroadmin.QueryAllWhitelistedProposalTypeRequest")
+	proto.RegisterType((*QueryAllWhitelistedProposalTypeResponse)(nil), "hero.heroadmin.QueryAllWhitelistedProposalTypeResponse")
+	proto.RegisterType((*QueryAllWhitelistedParamRequest)(nil), "hero.heroadmin.QueryAllWhitelistedParamRequest")
+	proto.RegisterType((*QueryAllWhitelistedParamResponse)(nil), "hero.heroadmin.QueryAllWhitelistedParamResponse")
+	proto.RegisterType((*QueryAdminThresholdRequest)(nil), "hero.heroadmin.QueryAdminThresholdRequest")
+	proto.RegisterType((*QueryAdminThresholdResponse)(nil), "hero.heroadmin.QueryAdminThresholdResponse")
+	proto.RegisterType((*QueryGetPendingAdminProposalRequest)(nil), "hero.heroadmin.QueryGetPendingAdminProposalRequest")
+	proto.RegisterType((*QueryGetPendingAdminProposalResponse)(nil), "hero.heroadmin.QueryGetPendingAdminProposalResponse")
+	proto.RegisterType((*QueryAllPendingAdminProposalRequest)(nil), "hero.heroadmin.QueryAllPendingAdminProposalRequest")
+	proto.RegisterType((*QueryAllPendingAdminProposalResponse)(nil), "hero.heroadmin.QueryAllPendingAdminProposalResponse")
+	proto.RegisterType((*QueryAllAdminChangeRequest)(nil), "hero.heroadmin.QueryAllAdminChangeRequest")
+	proto.RegisterType((*QueryAllAdminChangeResponse)(nil), "hero.heroadmin.QueryAllAdminChangeResponse")
+}
+
+func init() { proto.RegisterFile("heroadmin/query.proto", fileDescriptor_4443ba95d021150f) }
+
+var fileDescriptor_4443ba95d021150f = []byte{
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x05, 0x09, 0x0e, 0x09, 0x31, 0x23, 0x0a, 0x2e, 0xcd, 0xb6, 0x2c, 0x84, 0x02,
+	0x0d, 0xbb, 0xf2, 0x43, 0x3c, 0x78, 0x2a, 0x18, 0xb9, 0xd6, 0x86, 0xc4, 0xc4, 0x83, 0xcd, 0x94,
+	0x1d, 0xb7, 0x9b, 0x4c, 0x77, 0x96, 0x9d, 0x6d, 0x81, 0x18, 0x2f, 0x1e, 0xf4, 0x28, 0xd1, 0x8b,
+	0x77, 0x8f, 0x5e, 0x8d, 0x57, 0x3d, 0x72, 0x93, 0xc4, 0x8b, 0x89, 0x89, 0x1a, 0xf0, 0x0f, 0x31,
+	0x3b, 0x3b, 0x4b, 0xdb, 0x65, 0x5b, 0x4a, 0xd2, 0x4b, 0xd3, 0x9d, 0x79, 0x3f, 0x3e, 0xdf, 0x37,
+	0x6f, 0xde, 0xc0, 0x5b, 0x35, 0xe2, 0x31, 0x6c, 0xd6, 0x6d, 0xc7, 0xd8, 0x6b, 0x10, 0xef, 0x50,
+	0x77, 0x3d, 0xe6, 0x33, 0x34, 0x1e, 0x2c, 0xeb, 0xe7, 0x7b, 0x4a, 0xc6, 0x62, 0xcc, 0xa2, 0xc4,
+	0xc0, 0xae, 0x6d, 0x60, 0xc7, 0x61, 0x3e, 0xf6, 0x6d, 0xe6, 0xf0, 0xd0, 0x5a, 0x59, 0xda, 0x65,
+	0xbc, 0xce, 0xb8, 0x51, 0xc5, 0x9c, 0x84, 0x61, 0x8c, 0xe6, 0x4a, 0x95, 0xf8, 0x78, 0xc5, 0x70,
+	0xb1, 0x65, 0x3b, 0xc2, 0x58, 0xda, 0xaa, 0x32, 0x92, 0xf8, 0xaa, 0x36, 0x9e, 0x1b, 0x66, 0xc3,
+	0x6b, 0xdf, 0xd7, 0x5a, 0x40, 0xae, 0xc7, 0x5c, 0xc6, 0x31, 0xad, 0xec, 0xd7, 0x6c, 0x9f, 0x50,
+	0x9b, 0xfb, 0x51, 0x8c, 0x96, 0x8d, 0xf8, 0xad, 0x60, 0xd7, 0xf5, 0x58, 0x13, 0x53, 0xb9, 0x3f,
+	0x61, 0x31, 0x8b, 0x89, 0xbf, 0x46, 0xf0, 0x2f, 0x5c, 0xd5, 0x5c, 0x38, 0xff, 0x38, 0x60, 0x2b,
+	0x52, 0xfa, 0x24, 0x0a, 0x48, 0xcc, 0x92, 0x4c, 0xb2, 0x73, 0xe8, 0x92, 0x32, 0xd9, 0x6b, 0x10,
+	0xee, 0xa3, 0x47, 0x10, 0xb6, 0xb8, 0xa7, 0x40, 0x0e, 0x2c, 0x8c, 0xad, 0xce, 0xeb, 0xa1, 0x48,
+	0x3d, 0x10, 0xa9, 0x87, 0xb5, 0x92, 0x22, 0xf5, 0x12, 0xb6, 0x22, 0xdf, 0x72, 0x9b, 0xa7, 0xf6,
+	0x0b, 0xc0, 0xfc, 0xa5, 0x29, 0xb9, 0xcb, 0x1c, 0x4e, 0x90, 0x05, 0x27, 0xf7, 0x93, 0x4d, 0xa6,
+	0x40, 0x6e, 0x68, 0x61, 0x6c, 0x35, 0xaf, 0x77, 0x9e, 0x89, 0xde, 0x25, 0xe2, 0xe6, 0xf0, 0xf1,
+	0xef, 0x6c, 0xaa, 0xdc, 0x2d, 0x1a, 0xda, 0xee, 0x10, 0x97, 0x16, 0xe2, 0xf2, 0x97, 0x8a, 0x0b,
+	0x29, 0x3b, 0xd4, 0xd9, 0x30, 0x9b, 0x24, 0x0e, 0x7b, 0xb8, 0x3e, 0xe8, 0x42, 0x7e, 0x05, 0x30,
+	0xd7, 0x3d, 0x97, 0xac, 0x60, 0x19, 0xde, 0xd8, 0x8f, 0xed, 0xc9, 0xd2, 0xe5, 0x7a, 0x95, 0x2e,
+	0xb0, 0x93, 0x35, 0xbb, 0xe0, 0x3f, 0xb8, 0x62, 0x65, 0xa0, 0x12, 0x0a, 0x08, 0xf2, 0xef, 0xd4,
+	0x3c, 0xc2, 0x6b, 0x8c, 0x9a, 0x52, 0xab, 0x76, 0x04, 0xe0, 0x74, 0xe2, 0xb6, 0x94, 0x96, 0x81,
+	0xd7, 0xfd, 0x68, 0x51, 0x94, 0x71, 0xb8, 0xdc, 0x5a, 0x40, 0xb7, 0xe1, 0x88, 0x90, 0xc5, 0x05,
+	0xe0, 0x70, 0x59, 0x7e, 0xa1, 0x07, 0x70, 0x84, 0x1c, 0xb8, 0xb6, 0x77, 0x38, 0x35, 0x24, 0xc0,
+	0xef, 0xe8, 0xe1, 0xdd, 0xd3, 0xa3, 0xbb, 0xa7, 0x3f, 0x94, 0x77, 0x6f, 0x73, 0x34, 0xd0, 0xff,
+	0xe1, 0x4f, 0x16, 0x94, 0xa5, 0x8b, 0x76, 0x0f, 0xce, 0x0a, 0xa2, 0x6d, 0xe2, 0x97, 0x88, 0x63,
+	0xda, 0x8e, 0x25, 0xd8, 0xa2, 0x56, 0x8a, 0x4e, 0x78, 0x1c, 0xa6, 0xed, 0x08, 0x29, 0x6d, 0x9b,
+	0xda, 0x6b, 0x00, 0xe7, 0x7a, 0xfb, 0x49, 0x49, 0xcf, 0xe0, 0x84, 0x9b, 0xb0, 0x2f, 0x9b, 0x64,
+	0x2e, 0x7e, 0x62, 0x49, 0xb1, 0xe4, 0xa9, 0x25, 0xc6, 0xd1, 0xea, 0x92, 0xbf, 0x48, 0x69, 0x2f,
+	0xfe, 0x41, 0x75, 0xe8, 0xf7, 0x48, 0x77, 0xd7, 0x7c, 0x97, 0xea, 0x1e, 0x1a, 0x84, 0xee, 0xc1,
+	0x75, 0xac, 0x19, 0x75, 0x2c, 0xa5, 0x22, 0xc3, 0x56, 0x0d, 0x3b, 0xd6, 0xc0, 0x47, 0xe4, 0xa7,
+	0xf3, 0xce, 0x8f, 0xa5, 0x91, 0xe5, 0xda, 0x82, 0x63, 0xb8, 0xb5, 0x2c, 0xab, 0x34, 0x1d, 0xaf,
+	0x52, 0x9b, 0xa7, 0x2c, 0x4e, 0xbb, 0xd7, 0xc0, 0x6a, 0xb2, 0xfa, 0x66, 0x14, 0x5e, 0x13, 0xb4,
+	0xe8, 0x1b, 0x80, 0x4a, 0x97, 0x01, 0x5c, 0xa4, 0x14, 0x6d, 0xc4, 0x09, 0xfb, 0x7b, 0x79, 0x94,
+	0xfb, 0x57, 0xf6, 0x0b, 0x29, 0xb5, 0x95, 0x57, 0x3f, 0xfe, 0xbd, 0x4f, 0x17, 0xd0, 0xa2, 0x11,
+	0xf8, 0x1a, 0xad, 0x07, 0xb2, 0x6d, 0xa4, 0x55, 0xce, 0x1f, 0x54, 0x3f, 0x78, 0x08, 0x3e, 0x02,
+	0x78, 0x33, 0x3e, 0x08, 0x03, 0x76, 0xa3, 0x1f, 0x86, 0xb6, 0x29, 0xaf, 0xdc, 0xed, 0xdf, 0x41,
+	0xd2, 0x2e, 0x0a, 0xda, 0x59, 0x34, 0xd3, 0x93, 0x56, 0x4c, 0xe0, 0x77, 0x00, 0x8e, 0x77, 0x4e,
+	0x45, 0xb4, 0x94, 0x9c, 0x2f, 0x69, 0xb2, 0x2a, 0x85, 0xbe, 0x6c, 0x25, 0x56, 0x5e, 0x60, 0xcd,
+	0xa0, 0x6c, 0x1c, 0x4b, 0xfc, 0x56, 0x5a, 0x13, 0xf7, 0x0b, 0x80, 0x13, 0x49, 0x37, 0x13, 0xad,
+	0x25, 0xa6, 0xeb, 0x3d, 0x43, 0x95, 0xf5, 0xab, 0x39, 0x49, 0xd8, 0x35, 0x01, 0xbb, 0x8c, 0x0a,
+	0x71, 0x58, 0x39, 0x16, 0x2a, 0x21, 0x74, 0x74, 0xe6, 0xc6, 0x0b, 0xdb, 0x7c, 0x89, 0x3e, 0x03,
+	0x38, 0x99, 0x14, 0xb5, 0x48, 0xbb, 0xb1, 0xf7, 0x9e, 0x9f, 0xca, 0xfa, 0xd5, 0x9c, 0x24, 0xbb,
+	0x2e, 0xd8, 0x17, 0xd0, 0x7c, 0x7f, 0xec, 0xe8, 0x6d, 0xd4, 0x04, 0xe1, 0x85, 0x0e, 0x68, 0x97,
+	0xba, 0x25, 0xbe, 0x38, 0xac, 0x94, 0x42, 0x5f, 0xb6, 0x92, 0x6d, 0x4e, 0xb0, 0xa9, 0x28, 0x93,
+	0xdc, 0x04, 0xbb, 0xe1, 0x94, 0x29, 0x1d, 0x9f, 0xaa, 0xe0, 0xe4, 0x54, 0x05, 0x7f, 0x4f, 0x55,
+	0x70, 0x74, 0xa6, 0xa6, 0x4e, 0xce, 0xd4, 0xd4, 0xcf, 0x33, 0x35, 0xf5, 0x74, 0xc3, 0xb2, 0xfd,
+	0x5a, 0xa3, 0xaa, 0xef, 0xb2, 0xba, 0xc1, 0x7d, 0x2f, 0xb0, 0xa6, 0xac, 0x49, 0x96, 0x9b, 0xc4,
+	0xf1, 0x1b, 0x1e, 0xe1, 0x61, 0xd8, 0x83, 0xb6, 0xc0, 0xc1, 0x6d, 0xe4, 0xd5, 0x11, 0xf1, 0x2a,
+	0xaf, 0xfd, 0x1f, 0x00, 0x4e, 0xaf, 0x51, 0x3f, 0x92, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a list of WhitelistedProposalType items.
+	WhitelistedProposalTypeAll(ctx context.Context, in *QueryAllWhitelistedProposalTypeRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedProposalTypeResponse, error)
+	// Queries a list of WhitelistedParam items.
+	WhitelistedParamAll(ctx context.Context, in *QueryAllWhitelistedParamRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedParamResponse, error)
+	// Queries the number of admins that must approve admin module messages.
+	AdminThreshold(ctx context.Context, in *QueryAdminThresholdRequest, opts ...grpc.CallOption) (*QueryAdminThresholdResponse, error)
+	// Queries a PendingAdminProposal by id.
+	PendingAdminProposal(ctx context.Context, in *QueryGetPendingAdminProposalRequest, opts ...grpc.CallOption) (*QueryGetPendingAdminProposalResponse, error)
+	// Queries a list of PendingAdminProposal items.
+	PendingAdminProposalAll(ctx context.Context, in *QueryAllPendingAdminProposalRequest, opts ...grpc.CallOption) (*QueryAllPendingAdminProposalResponse, error)
+	// Queries a list of AdminChange items.
+	AdminChangeAll(ctx context.Context, in *QueryAllAdminChangeRequest, opts ...grpc.CallOption) (*QueryAllAdminChangeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WhitelistedProposalTypeAll(ctx context.Context, in *QueryAllWhitelistedProposalTypeRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedProposalTypeResponse, error) {
+	out := new(QueryAllWhitelistedProposalTypeResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/WhitelistedProposalTypeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistedParamAll(ctx context.Context, in *QueryAllWhitelistedParamRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedParamResponse, error) {
+	out := new(QueryAllWhitelistedParamResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/WhitelistedParamAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminThreshold(ctx context.Context, in *QueryAdminThresholdRequest, opts ...grpc.CallOption) (*QueryAdminThresholdResponse, error) {
+	out := new(QueryAdminThresholdResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/AdminThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAdminProposal(ctx context.Context, in *QueryGetPendingAdminProposalRequest, opts ...grpc.CallOption) (*QueryGetPendingAdminProposalResponse, error) {
+	out := new(QueryGetPendingAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/PendingAdminProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAdminProposalAll(ctx context.Context, in *QueryAllPendingAdminProposalRequest, opts ...grpc.CallOption) (*QueryAllPendingAdminProposalResponse, error) {
+	out := new(QueryAllPendingAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/PendingAdminProposalAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminChangeAll(ctx context.Context, in *QueryAllAdminChangeRequest, opts ...grpc.CallOption) (*QueryAllAdminChangeResponse, error) {
+	out := new(QueryAllAdminChangeResponse)
+	err := c.cc.Invoke(ctx, "/hero.heroadmin.Query/AdminChangeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a list of WhitelistedProposalType items.
+	WhitelistedProposalTypeAll(context.Context, *QueryAllWhitelistedProposalTypeRequest) (*QueryAllWhitelistedProposalTypeResponse, error)
+	// Queries a list of WhitelistedParam items.
+	WhitelistedParamAll(context.Context, *QueryAllWhitelistedParamRequest) (*QueryAllWhitelistedParamResponse, error)
+	// Queries the number of admins that must approve admin module messages.
+	AdminThreshold(context.Context, *QueryAdminThresholdRequest) (*QueryAdminThresholdResponse, error)
+	// Queries a PendingAdminProposal by id.
+	PendingAdminProposal(context.Context, *QueryGetPendingAdminProposalRequest) (*QueryGetPendingAdminProposalResponse, error)
+	// Queries a list of PendingAdminProposal items.
+	PendingAdminProposalAll(context.Context, *QueryAllPendingAdminProposalRequest) (*QueryAllPendingAdminProposalResponse, error)
+	// Queries a list of AdminChange items.
+	AdminChangeAll(context.Context, *QueryAllAdminChangeRequest) (*QueryAllAdminChangeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WhitelistedProposalTypeAll(ctx context.Context, req *QueryAllWhitelistedProposalTypeRequest) (*QueryAllWhitelistedProposalTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedProposalTypeAll not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistedParamAll(ctx context.Context, req *QueryAllWhitelistedParamRequest) (*QueryAllWhitelistedParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedParamAll not implemented")
+}
+func (*UnimplementedQueryServer) AdminThreshold(ctx context.Context, req *QueryAdminThresholdRequest) (*QueryAdminThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminThreshold not implemented")
+}
+func (*UnimplementedQueryServer) PendingAdminProposal(ctx context.Context, req *QueryGetPendingAdminProposalRequest) (*QueryGetPendingAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAdminProposal not implemented")
+}
+func (*UnimplementedQueryServer) PendingAdminProposalAll(ctx context.Context, req *QueryAllPendingAdminProposalRequest) (*QueryAllPendingAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAdminProposalAll not implemented")
+}
+func (*UnimplementedQueryServer) AdminChangeAll(ctx context.Context, req *QueryAllAdminChangeRequest) (*QueryAllAdminChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminChangeAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WhitelistedProposalTypeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWhitelistedProposalTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedProposalTypeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/WhitelistedProposalTypeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedProposalTypeAll(ctx, req.(*QueryAllWhitelistedProposalTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistedParamAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWhitelistedParamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedParamAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/WhitelistedParamAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedParamAll(ctx, req.(*QueryAllWhitelistedParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/AdminThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminThreshold(ctx, req.(*QueryAdminThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/PendingAdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdminProposal(ctx, req.(*QueryGetPendingAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAdminProposalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdminProposalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/PendingAdminProposalAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdminProposalAll(ctx, req.(*QueryAllPendingAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminChangeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAdminChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminChangeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.heroadmin.Query/AdminChangeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminChangeAll(ctx, req.(*QueryAllAdminChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hero.heroadmin.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WhitelistedProposalTypeAll",
+			Handler:    _Query_WhitelistedProposalTypeAll_Handler,
+		},
+		{
+			MethodName: "WhitelistedParamAll",
+			Handler:    _Query_WhitelistedParamAll_Handler,
+		},
+		{
+			MethodName: "AdminThreshold",
+			Handler:    _Query_AdminThreshold_Handler,
+		},
+		{
+			MethodName: "PendingAdminProposal",
+			Handler:    _Query_PendingAdminProposal_Handler,
+		},
+		{
+			MethodName: "PendingAdminProposalAll",
+			Handler:    _Query_PendingAdminProposalAll_Handler,
+		},
+		{
+			MethodName: "AdminChangeAll",
+			Handler:    _Query_AdminChangeAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heroadmin/query.proto",
+}
+
+func (m *QueryAllWhitelistedProposalTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedProposalTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedProposalTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WhitelistedProposalType) > 0 {
+		for iNdEx := len(m.WhitelistedProposalType) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedProposalType[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedParamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedParamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedParamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedParamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WhitelistedParam) > 0 {
+		for iNdEx := len(m.WhitelistedParam) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedParam[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Admins != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Admins))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingAdminProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingAdminProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingAdminProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingAdminProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingAdminProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingAdminProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingAdminProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingAdminProposal) > 0 {
+		for iNdEx := len(m.PendingAdminProposal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminProposal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAdminChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAdminChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAdminChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAdminChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAdminChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAdminChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminChange) > 0 {
+		for iNdEx := len(m.AdminChange) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminChange[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWhitelistedProposalTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedProposalType) > 0 {
+		for _, e := range m.WhitelistedProposalType {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWhitelistedParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWhitelistedParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedParam) > 0 {
+		for _, e := range m.WhitelistedParam {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAdminThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.Admins != 0 {
+		n += 1 + sovQuery(uint64(m.Admins))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingAdminProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPendingAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingAdminProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingAdminProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingAdminProposal) > 0 {
+		for _, e := range m.PendingAdminProposal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAdminChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAdminChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdminChange) > 0 {
+		for _, e := range m.AdminChange {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllWhitelistedProposalTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedProposalTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedProposalTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWhitelistedProposalTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedProposalTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedProposalTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedProposalType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedProposalType = append(m.WhitelistedProposalType, WhitelistedProposalType{})
+			if err := m.WhitelistedProposalType[len(m.WhitelistedProposalType)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWhitelistedParamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedParamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWhitelistedParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedParam = append(m.WhitelistedParam, WhitelistedParam{})
+			if err := m.WhitelistedParam[len(m.WhitelistedParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			m.Admins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Admins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingAdminProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingAdminProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingAdminProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingAdminProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingAdminProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingAdminProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingAdminProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingAdminProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingAdminProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingAdminProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingAdminProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminProposal = append(m.PendingAdminProposal, PendingAdminProposal{})
+			if err := m.PendingAdminProposal[len(m.PendingAdminProposal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAdminChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAdminChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAdminChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAdminChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAdminChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAdminChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminChange = append(m.AdminChange, AdminChange{})
+			if err := m.AdminChange[len(m.AdminChange)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
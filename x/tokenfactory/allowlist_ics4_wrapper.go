@@ -0,0 +1,64 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ porttypes.ICS4Wrapper = &AllowlistICS4Wrapper{}
+
+// AllowlistICS4Wrapper rejects the transfers of the minting denom that ValidateIBCTransfer refuses
+// before the packet is sent, such as transfers while the token is paused or through channels that
+// are not allowlisted by the owner. Unlike the ante decorator, it also applies to transfers that do
+// not come from a tx, such as messages executed by a module. Other denoms are left to the ante
+// decorator so that the transfers of the consumer rewards to the provider chain in the end blocker
+// are not refused while the token is paused.
+type AllowlistICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewAllowlistICS4Wrapper creates a new AllowlistICS4Wrapper given the keeper and the underlying ICS4Wrapper.
+func NewAllowlistICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) AllowlistICS4Wrapper {
+	return AllowlistICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket returns an error if the packet transfers the minting denom and would be rejected by
+// ValidateIBCTransfer.
+func (w AllowlistICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !w.keeper.IsMintingDenom(ctx, data.Denom) {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	coin := sdk.Coin{Denom: data.Denom, Amount: amount}
+	if reason, err := w.keeper.ValidateIBCTransfer(ctx, data.Sender, data.Receiver, coin, packet.GetSourceChannel()); err != nil {
+		keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
+		return err
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (w AllowlistICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
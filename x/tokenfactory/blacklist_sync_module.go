@@ -0,0 +1,233 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ porttypes.IBCModule = BlacklistSyncIBCModule{}
+
+// BlacklistSyncIBCModule implements the IBC application that synchronizes blacklist updates between
+// Hero chains. Channels must be ordered so that the updates of an address are applied in the order
+// they were sent.
+type BlacklistSyncIBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewBlacklistSyncIBCModule creates a new BlacklistSyncIBCModule given the keeper.
+func NewBlacklistSyncIBCModule(k keeper.Keeper) BlacklistSyncIBCModule {
+	return BlacklistSyncIBCModule{
+		keeper: k,
+	}
+}
+
+// validateChannelParams checks the ordering and port of a blacklist sync channel. Any relayer can open
+// a channel, which only carries updates once the owner adds it to the blacklist sync channels.
+func (im BlacklistSyncIBCModule) validateChannelParams(ctx sdk.Context, order channeltypes.Order, portID string) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	}
+
+	// Require portID is the portID module is bound to
+	boundPort := im.keeper.GetPort(ctx)
+	if boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return err
+	}
+
+	if version != types.Version {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If module can already authenticate the capability then module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// Only claim channel capability passed back by IBC module if we do not already own it
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", err
+		}
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for channels
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface. The counterparty closes the channel when
+// one of its updates timed out, and the channel is no longer used as a blacklist sync channel.
+func (im BlacklistSyncIBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.keeper.CloseBlacklistSyncChannel(ctx, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Updates received through a channel that is
+// not a blacklist sync channel are acknowledged with an error.
+func (im BlacklistSyncIBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var ack channeltypes.Acknowledgement
+
+	var modulePacketData types.BlacklistSyncPacketData
+	if err := modulePacketData.Unmarshal(packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()).Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BlacklistSyncPacketData_BlacklistUpdate:
+		packetAck, err := im.keeper.OnRecvBlacklistUpdatePacket(ctx, packet, *packetData.BlacklistUpdate)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err.Error())
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+	default:
+		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packetData)
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	var modulePacketData types.BlacklistSyncPacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BlacklistSyncPacketData_BlacklistUpdate:
+		return im.keeper.OnAcknowledgementBlacklistUpdatePacket(ctx, modulePacket, *packetData.BlacklistUpdate, ack)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packetData)
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im BlacklistSyncIBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	var modulePacketData types.BlacklistSyncPacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BlacklistSyncPacketData_BlacklistUpdate:
+		return im.keeper.OnTimeoutBlacklistUpdatePacket(ctx, modulePacket, *packetData.BlacklistUpdate)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packetData)
+	}
+}
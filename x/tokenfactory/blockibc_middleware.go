@@ -82,7 +82,8 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 
 // OnRecvPacket intercepts the packet data and checks the the sender and receiver address against
 // the blacklisted addresses held in the tokenfactory keeper. If the address is found in the blacklist, an
-// acknoledgmet error is returned.
+// acknoledgmet error is returned. The minting denom is also refused while the token is paused, and when
+// returning to this chain unless the destination channel is allowlisted.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -97,19 +98,25 @@ func (im IBCMiddleware) OnRecvPacket(
 	}
 
 	mintingDenom := im.keeper.GetMintingDenom(ctx)
-	if data.Denom != mintingDenom.Denom {
+	received := isReceivedMintingDenom(packet, data.Denom, mintingDenom.Denom)
+	if data.Denom != mintingDenom.Denom && !received {
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	_, found := im.keeper.GetBlacklisted(ctx, data.Receiver)
-	if found {
-		ackErr = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "receiver address is blacklisted")
+	if reason, ackErr := im.keeper.ValidateNotPaused(ctx); ackErr != nil {
+		keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
 		return channeltypes.NewErrorAcknowledgement(ackErr.Error())
 	}
 
-	_, found = im.keeper.GetBlacklisted(ctx, data.Sender)
-	if found {
-		ackErr = sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender address is blacklisted")
+	if received {
+		if reason, ackErr := im.keeper.ValidateChannelAllowed(ctx, packet.GetDestChannel()); ackErr != nil {
+			keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
+			return channeltypes.NewErrorAcknowledgement(ackErr.Error())
+		}
+	}
+
+	if reason, ackErr := im.keeper.ValidateNotBlacklisted(ctx, data.Sender, data.Receiver); ackErr != nil {
+		keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
 		return channeltypes.NewErrorAcknowledgement(ackErr.Error())
 	}
 	return im.app.OnRecvPacket(ctx, packet, relayer)
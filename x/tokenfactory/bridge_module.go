@@ -0,0 +1,237 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ porttypes.IBCModule = BridgeIBCModule{}
+
+// BridgeIBCModule implements the IBC application that moves the minting denom between Hero chains
+// by burning it on the sending chain and minting it on the receiving chain. Channels are unordered
+// so that a transfer that times out does not close the channel.
+type BridgeIBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewBridgeIBCModule creates a new BridgeIBCModule given the keeper.
+func NewBridgeIBCModule(k keeper.Keeper) BridgeIBCModule {
+	return BridgeIBCModule{
+		keeper: k,
+	}
+}
+
+// validateChannelParams checks the ordering and port of a bridge channel
+func (im BridgeIBCModule) validateChannelParams(ctx sdk.Context, order channeltypes.Order, portID string) error {
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	// Require portID is the portID module is bound to
+	boundPort := im.keeper.GetBridgePort(ctx)
+	if boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return err
+	}
+
+	if version != types.BridgeVersion {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "got %s, expected %s", version, types.BridgeVersion)
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.BridgeVersion {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.BridgeVersion)
+	}
+
+	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If module can already authenticate the capability then module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// Only claim channel capability passed back by IBC module if we do not already own it
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", err
+		}
+	}
+
+	return types.BridgeVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.BridgeVersion {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.BridgeVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for channels
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im BridgeIBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Transfers received through a channel that is
+// not a bridge route, or that can not be minted, are acknowledged with an error and leave no
+// state behind so that the sending chain can refund them.
+func (im BridgeIBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var ack channeltypes.Acknowledgement
+
+	var modulePacketData types.BridgePacketData
+	if err := modulePacketData.Unmarshal(packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()).Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BridgePacketData_BridgeTransfer:
+		cacheCtx, writeCache := ctx.CacheContext()
+		packetAck, err := im.keeper.OnRecvBridgeTransferPacket(cacheCtx, packet, *packetData.BridgeTransfer)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err.Error())
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+
+			// the mint is only committed along with a success acknowledgement
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	default:
+		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packetData)
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im BridgeIBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	var modulePacketData types.BridgePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BridgePacketData_BridgeTransfer:
+		return im.keeper.OnAcknowledgementBridgeTransferPacket(ctx, modulePacket, *packetData.BridgeTransfer, ack)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packetData)
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im BridgeIBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	var modulePacketData types.BridgePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packetData := modulePacketData.Packet.(type) {
+	case *types.BridgePacketData_BridgeTransfer:
+		return im.keeper.OnTimeoutBridgeTransferPacket(ctx, modulePacket, *packetData.BridgeTransfer)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packetData)
+	}
+}
@@ -37,6 +37,39 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListMinterController())
 	cmd.AddCommand(CmdShowMinterController())
 	cmd.AddCommand(CmdShowMintingDenom())
+	cmd.AddCommand(CmdListRedemption())
+	cmd.AddCommand(CmdShowRedemption())
+	cmd.AddCommand(CmdListRedemptionByStatus())
+	cmd.AddCommand(CmdListRedemptionByHolder())
+	cmd.AddCommand(CmdShowAttester())
+	cmd.AddCommand(CmdShowLatestReserveAttestation())
+	cmd.AddCommand(CmdListReserveAttestation())
+	cmd.AddCommand(CmdShowSupplyCap())
+	cmd.AddCommand(CmdShowQuorum())
+	cmd.AddCommand(CmdListPendingOperation())
+	cmd.AddCommand(CmdShowPendingOperation())
+	cmd.AddCommand(CmdListRoleChange())
+	cmd.AddCommand(CmdShowRoleChange())
+	cmd.AddCommand(CmdListGuardian())
+	cmd.AddCommand(CmdShowGuardian())
+	cmd.AddCommand(CmdAddressRoles())
+	cmd.AddCommand(CmdMinterControllerByMinter())
+	cmd.AddCommand(CmdListMinterStats())
+	cmd.AddCommand(CmdShowMinterStats())
+	cmd.AddCommand(CmdShowMintingTotals())
+	cmd.AddCommand(CmdCheckTransfer())
+	cmd.AddCommand(CmdCheckMint())
+	cmd.AddCommand(CmdListAuthorizationState())
+	cmd.AddCommand(CmdShowAuthorizationState())
+	cmd.AddCommand(CmdListRateLimit())
+	cmd.AddCommand(CmdShowRateLimit())
+	cmd.AddCommand(CmdListAllowedChannel())
+	cmd.AddCommand(CmdShowAllowedChannel())
+	cmd.AddCommand(CmdListBlacklistSyncChannel())
+	cmd.AddCommand(CmdShowBlacklistSyncChannel())
+	cmd.AddCommand(CmdListBridgeRoute())
+	cmd.AddCommand(CmdShowBridgeRoute())
+	cmd.AddCommand(CmdBridgeMinter())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
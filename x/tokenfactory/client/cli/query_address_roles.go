@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func CmdAddressRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [address]",
+		Short: "shows every role, allowance, controller and blacklist status of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argAddress := args[0]
+
+			params := &types.QueryAddressRolesRequest{
+				Address: argAddress,
+			}
+
+			res, err := queryClient.AddressRoles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
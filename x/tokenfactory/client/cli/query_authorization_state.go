@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func CmdListAuthorizationState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-authorization-state",
+		Short: "list all used and cancelled transfer authorization nonces",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllAuthorizationStateRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AuthorizationStateAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAuthorizationState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-authorization-state [authorizer] [nonce]",
+		Short: "shows whether a transfer authorization nonce is used or cancelled",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAuthorizationStateRequest{
+				Authorizer: args[0],
+				Nonce:      args[1],
+			}
+
+			res, err := queryClient.AuthorizationState(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
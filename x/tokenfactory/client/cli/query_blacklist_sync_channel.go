@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func CmdListBlacklistSyncChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-blacklist-sync-channel",
+		Short: "list all blacklist sync channels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllBlacklistSyncChannelRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BlacklistSyncChannelAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowBlacklistSyncChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-blacklist-sync-channel [channelId]",
+		Short: "shows a blacklist sync channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argChannelId := args[0]
+
+			params := &types.QueryGetBlacklistSyncChannelRequest{
+				ChannelId: argChannelId,
+			}
+
+			res, err := queryClient.BlacklistSyncChannel(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+const (
+	flagPath    = "path"
+	flagChannel = "channel"
+)
+
+func CmdCheckTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-transfer [from] [to] [amount]",
+		Short: "checks whether a transfer would be accepted and why it would be rejected",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPath, err := cmd.Flags().GetString(flagPath)
+			if err != nil {
+				return err
+			}
+
+			argChannel, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			var path types.TransferPath
+			switch argPath {
+			case "bank":
+				path = types.TransferPathBank
+			case "ibc":
+				path = types.TransferPathIbc
+			default:
+				return fmt.Errorf("invalid transfer path %s, expected bank or ibc", argPath)
+			}
+
+			params := &types.QueryCheckTransferRequest{
+				From:      args[0],
+				To:        args[1],
+				Amount:    args[2],
+				Path:      path,
+				ChannelId: argChannel,
+			}
+
+			res, err := queryClient.CheckTransfer(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPath, "bank", "transfer path, bank or ibc")
+	cmd.Flags().String(flagChannel, "", "source channel of an ibc transfer")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCheckMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-mint [minter] [to] [amount]",
+		Short: "checks whether a mint would be accepted and why it would be rejected",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCheckMintRequest{
+				Minter: args[0],
+				To:     args[1],
+				Amount: args[2],
+			}
+
+			res, err := queryClient.CheckMint(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -72,3 +72,33 @@ func CmdShowMinterController() *cobra.Command {
 
 	return cmd
 }
+
+func CmdMinterControllerByMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-controller-by-minter [minter-address]",
+		Short: "shows the minter-controllers of a minter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argMinterAddress := args[0]
+
+			params := &types.QueryMinterControllerByMinterRequest{
+				MinterAddress: argMinterAddress,
+			}
+
+			res, err := queryClient.MinterControllerByMinter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,7 +29,8 @@ func networkWithMinterControllerObjects(t *testing.T, n int) (*network.Network,
 
 	for i := 0; i < n; i++ {
 		minterController := types.MinterController{
-			Minter: strconv.Itoa(i),
+			Minter:     strconv.Itoa(i),
+			Controller: strconv.Itoa(i),
 		}
 		nullify.Fill(&minterController)
 		state.MinterControllerList = append(state.MinterControllerList, minterController)
@@ -48,23 +49,23 @@ func TestShowMinterController(t *testing.T) {
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 	}
 	for _, tc := range []struct {
-		desc            string
-		idMinterAddress string
+		desc                string
+		idControllerAddress string
 
 		args []string
 		err  error
 		obj  types.MinterController
 	}{
 		{
-			desc:            "found",
-			idMinterAddress: objs[0].Minter,
+			desc:                "found",
+			idControllerAddress: objs[0].Controller,
 
 			args: common,
 			obj:  objs[0],
 		},
 		{
-			desc:            "not found",
-			idMinterAddress: strconv.Itoa(100000),
+			desc:                "not found",
+			idControllerAddress: strconv.Itoa(100000),
 
 			args: common,
 			err:  status.Error(codes.NotFound, "not found"),
@@ -72,7 +73,7 @@ func TestShowMinterController(t *testing.T) {
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			args := []string{
-				tc.idMinterAddress,
+				tc.idControllerAddress,
 			}
 			args = append(args, tc.args...)
 			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowMinterController(), args)
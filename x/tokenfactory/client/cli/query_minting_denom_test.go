@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"google.golang.org/grpc/status"
@@ -21,12 +22,23 @@ func networkWithMintingDenomObjects(t *testing.T) (*network.Network, types.Minti
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	mintingDenom := &types.MintingDenom{}
+	mintingDenom := &types.MintingDenom{Denom: "uusdc"}
 	nullify.Fill(&mintingDenom)
 	state.MintingDenom = mintingDenom
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
+
+	// the minting denom requires its bank metadata
+	bankState := banktypes.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankState))
+	bankState.DenomMetadata = append(bankState.DenomMetadata, banktypes.Metadata{
+		Base:       mintingDenom.Denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: mintingDenom.Denom}},
+	})
+	buf, err = cfg.Codec.MarshalJSON(&bankState)
+	require.NoError(t, err)
+	cfg.GenesisState[banktypes.ModuleName] = buf
 	return network.New(t, cfg), *state.MintingDenom
 }
 
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func CmdListRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-rate-limit",
+		Short: "list all channel rate limits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRateLimitRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimitAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rate-limit [channel-id] [inflow|outflow]",
+		Short: "shows a channel rate limit and its usage in the current window",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDirection, err := types.ParseRateLimitDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRateLimitRequest{
+				ChannelId: args[0],
+				Direction: argDirection,
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
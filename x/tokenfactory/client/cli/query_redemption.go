@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func CmdListRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-redemption",
+		Short: "list all redemption",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRedemptionRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RedemptionAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-redemption [id]",
+		Short: "shows a redemption",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRedemptionRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.Redemption(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRedemptionByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-redemption-by-status [pending|fulfilled|rejected]",
+		Short: "list all redemption with a given status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argStatus, err := parseRedemptionStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionsByStatusRequest{
+				Status:     argStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RedemptionsByStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRedemptionByHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-redemption-by-holder [holder]",
+		Short: "list all redemption requested by a given holder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionsByHolderRequest{
+				Holder:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RedemptionsByHolder(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseRedemptionStatus(arg string) (types.RedemptionStatus, error) {
+	switch arg {
+	case "pending":
+		return types.RedemptionPending, nil
+	case "fulfilled":
+		return types.RedemptionFulfilled, nil
+	case "rejected":
+		return types.RedemptionRejected, nil
+	}
+
+	if status, ok := types.RedemptionStatus_value[arg]; ok {
+		return types.RedemptionStatus(status), nil
+	}
+
+	return 0, fmt.Errorf("unknown redemption status %q", arg)
+}
@@ -13,6 +13,10 @@ import (
 
 var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+	// DefaultRelativeBlacklistUpdateTimeoutTimestamp leaves relayers a day to deliver blacklist updates,
+	// as a timeout closes the blacklist sync channel
+	DefaultRelativeBlacklistUpdateTimeoutTimestamp = uint64((time.Duration(24) * time.Hour).Nanoseconds())
 )
 
 const (
@@ -44,6 +48,31 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnpause())
 	cmd.AddCommand(CmdConfigureMinterController())
 	cmd.AddCommand(CmdRemoveMinterController())
+	cmd.AddCommand(CmdRequestRedemption())
+	cmd.AddCommand(CmdFulfillRedemption())
+	cmd.AddCommand(CmdRejectRedemption())
+	cmd.AddCommand(CmdUpdateAttester())
+	cmd.AddCommand(CmdSubmitReserveAttestation())
+	cmd.AddCommand(CmdUpdateSupplyCap())
+	cmd.AddCommand(CmdUpdateQuorum())
+	cmd.AddCommand(CmdSubmitOperation())
+	cmd.AddCommand(CmdApproveOperation())
+	cmd.AddCommand(CmdCancelRoleChange())
+	cmd.AddCommand(CmdAddGuardian())
+	cmd.AddCommand(CmdRemoveGuardian())
+	cmd.AddCommand(CmdSignTransferAuthorization())
+	cmd.AddCommand(CmdTransferWithAuthorization())
+	cmd.AddCommand(CmdCancelAuthorization())
+	cmd.AddCommand(CmdSetRateLimit())
+	cmd.AddCommand(CmdRemoveRateLimit())
+	cmd.AddCommand(CmdAllowChannel())
+	cmd.AddCommand(CmdDisallowChannel())
+	cmd.AddCommand(CmdAddBlacklistSyncChannel())
+	cmd.AddCommand(CmdRemoveBlacklistSyncChannel())
+	cmd.AddCommand(CmdBroadcastBlacklistUpdate())
+	cmd.AddCommand(CmdAddBridgeRoute())
+	cmd.AddCommand(CmdRemoveBridgeRoute())
+	cmd.AddCommand(CmdBridgeTransfer())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdBridgeTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-transfer [channel-id] [receiver] [amount]",
+		Short: "Burn tokens and mint them to a receiver on the counterparty chain of a bridge route",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelId := args[0]
+			argReceiver := args[1]
+			argAmount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the timeout flag is relative to the current time
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp += uint64(time.Now().UnixNano())
+
+			msg := types.NewMsgBridgeTransfer(
+				clientCtx.GetFromAddress().String(),
+				argChannelId,
+				argReceiver,
+				argAmount,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
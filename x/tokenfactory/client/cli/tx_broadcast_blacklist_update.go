@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdBroadcastBlacklistUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-blacklist-update [address]",
+		Short: "Send whether an address is blacklisted to every blacklist sync channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddress := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the timeout flag is relative to the current time
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp += uint64(time.Now().UnixNano())
+
+			msg := types.NewMsgBroadcastBlacklistUpdate(
+				clientCtx.GetFromAddress().String(),
+				argAddress,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativeBlacklistUpdateTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now, at least 1 hour. Default is 24 hours.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
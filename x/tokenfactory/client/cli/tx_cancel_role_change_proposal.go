@@ -0,0 +1,62 @@
+package cli
+
+import (
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// NewCmdSubmitCancelRoleChangeProposal implements a command handler for submitting a proposal
+// cancelling a queued role change through the admin module.
+func NewCmdSubmitCancelRoleChangeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-role-change [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a queued tokenfactory role change",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelRoleChangeProposal(title, description, argId)
+
+			msg, err := adminmoduletypes.NewMsgSubmitProposal(content, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// NewCmdSubmitSetRateLimitProposal implements a command handler for submitting a proposal
+// setting the rate limit of a channel through the admin module.
+func NewCmdSubmitSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [inflow|outflow] [max-amount] [max-supply-fraction] [window]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Set the tokenfactory rate limit of an IBC channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argDirection, argMaxAmount, argMaxSupplyFraction, argWindow, err := parseRateLimitArgs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetRateLimitProposal(title, description, args[0], argDirection, argMaxAmount, argMaxSupplyFraction, argWindow)
+
+			msg, err := adminmoduletypes.NewMsgSubmitProposal(content, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a proposal
+// removing the rate limit of a channel through the admin module.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [inflow|outflow]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the tokenfactory rate limit of an IBC channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argDirection, err := types.ParseRateLimitDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveRateLimitProposal(title, description, args[0], argDirection)
+
+			msg, err := adminmoduletypes.NewMsgSubmitProposal(content, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [inflow|outflow] [max-amount] [max-supply-fraction] [window]",
+		Short: "Broadcast message set-rate-limit",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelId := args[0]
+			argDirection, argMaxAmount, argMaxSupplyFraction, argWindow, err := parseRateLimitArgs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRateLimit(
+				clientCtx.GetFromAddress().String(),
+				argChannelId,
+				argDirection,
+				argMaxAmount,
+				argMaxSupplyFraction,
+				argWindow,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRateLimitArgs parses the direction, max amount, max supply fraction and window arguments
+func parseRateLimitArgs(args []string) (types.RateLimitDirection, sdk.Int, sdk.Dec, time.Duration, error) {
+	direction, err := types.ParseRateLimitDirection(args[0])
+	if err != nil {
+		return direction, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	maxAmount, ok := sdk.NewIntFromString(args[1])
+	if !ok {
+		return direction, sdk.Int{}, sdk.Dec{}, 0, fmt.Errorf("invalid max amount %s", args[1])
+	}
+
+	maxSupplyFraction, err := sdk.NewDecFromStr(args[2])
+	if err != nil {
+		return direction, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	window, err := time.ParseDuration(args[3])
+	if err != nil {
+		return direction, sdk.Int{}, sdk.Dec{}, 0, err
+	}
+
+	return direction, maxAmount, maxSupplyFraction, window, nil
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSubmitOperation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-operation [msg-file]",
+		Short: "Broadcast message submit-operation",
+		Long: `Broadcast message submit-operation, wrapping the JSON encoded message in the given file
+into an operation that is executed once the quorum has approved it. For example:
+
+{
+  "@type": "/hero.tokenfactory.MsgUpdatePauser",
+  "from": "<owner address>",
+  "address": "<new pauser address>"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var argMsg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &argMsg); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitOperation(
+				clientCtx.GetFromAddress().String(),
+				argMsg,
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
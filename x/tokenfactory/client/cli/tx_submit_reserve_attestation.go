@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSubmitReserveAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-reserve-attestation [reserves] [timestamp] [auditor] [document-hash]",
+		Short: "Broadcast message submit-reserve-attestation",
+		Long:  "Submit a proof-of-reserves attestation. The timestamp must be formatted as RFC3339 and the document hash hex encoded.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReserves, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			argTimestamp, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+			argAuditor := args[2]
+			argDocumentHash := args[3]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitReserveAttestation(
+				clientCtx.GetFromAddress().String(),
+				argReserves,
+				argTimestamp,
+				argAuditor,
+				argDocumentHash,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagValidFor = "valid-for"
+
+func CmdSignTransferAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-transfer-authorization [to] [amount] [nonce]",
+		Short: "Sign a transfer authorization that any account can relay with transfer-with-authorization",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTo := args[0]
+			argAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argNonce := args[2]
+
+			validFor, err := cmd.Flags().GetDuration(flagValidFor)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now().UTC()
+			authorization := types.TransferAuthorization{
+				From:        clientCtx.GetFromAddress().String(),
+				To:          argTo,
+				Amount:      argAmount,
+				ValidAfter:  now,
+				ValidBefore: now.Add(validFor),
+				Nonce:       argNonce,
+			}
+			if err := authorization.Validate(); err != nil {
+				return err
+			}
+
+			signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), authorization.GetSignBytes(clientCtx.ChainID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&types.MsgTransferWithAuthorization{
+				Authorization: authorization,
+				Signature:     signature,
+			})
+		},
+	}
+
+	cmd.Flags().Duration(flagValidFor, time.Hour, "how long the authorization can be relayed for")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferWithAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-with-authorization [signed-authorization-file]",
+		Short: "Broadcast a transfer authorization signed with sign-transfer-authorization, paying its fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var signed types.MsgTransferWithAuthorization
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &signed); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferWithAuthorization(
+				clientCtx.GetFromAddress().String(),
+				signed.Authorization,
+				signed.Signature,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
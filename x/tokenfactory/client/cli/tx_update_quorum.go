@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagMintThreshold = "mint-threshold"
+
+func CmdUpdateQuorum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-quorum [threshold] [expiry] [members...]",
+		Short: "Broadcast message update-quorum",
+		Long:  "Broadcast message update-quorum, the quorum is removed when no members are given",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argThreshold, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argExpiry, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			argMembers := args[2:]
+
+			var mintThreshold *sdk.Coin
+			mintThresholdStr, err := cmd.Flags().GetString(flagMintThreshold)
+			if err != nil {
+				return err
+			}
+			if mintThresholdStr != "" {
+				coin, err := sdk.ParseCoinNormalized(mintThresholdStr)
+				if err != nil {
+					return err
+				}
+				mintThreshold = &coin
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateQuorum(
+				clientCtx.GetFromAddress().String(),
+				argMembers,
+				argThreshold,
+				argExpiry,
+				mintThreshold,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMintThreshold, "", "Mints above this amount require quorum approval")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
@@ -45,8 +46,179 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.MintingDenom != nil {
 		k.SetMintingDenom(ctx, *genState.MintingDenom)
 	}
+	// Set all the redemption
+	for _, elem := range genState.RedemptionList {
+		k.SetRedemption(ctx, elem)
+	}
+
+	// Set redemption count
+	k.SetRedemptionCount(ctx, genState.RedemptionCount)
+	// Set if defined
+	if genState.Attester != nil {
+		k.SetAttester(ctx, *genState.Attester)
+	}
+	// Set all the reserveAttestation
+	for _, elem := range genState.ReserveAttestationList {
+		k.SetReserveAttestation(ctx, elem)
+	}
+
+	// Set reserveAttestation count
+	k.SetReserveAttestationCount(ctx, genState.ReserveAttestationCount)
+	// Set if defined
+	if genState.SupplyCap != nil {
+		k.SetSupplyCap(ctx, *genState.SupplyCap)
+	}
+	// Set if defined
+	if genState.Quorum != nil {
+		k.SetQuorum(ctx, *genState.Quorum)
+	}
+	// Set all the pendingOperation
+	for _, elem := range genState.PendingOperationList {
+		k.SetPendingOperation(ctx, elem)
+	}
+
+	// Set pendingOperation count
+	k.SetPendingOperationCount(ctx, genState.PendingOperationCount)
+	// Set all the roleChange
+	for _, elem := range genState.RoleChangeList {
+		k.SetRoleChange(ctx, elem)
+	}
+
+	// Set roleChange count
+	k.SetRoleChangeCount(ctx, genState.RoleChangeCount)
+	// Set all the guardian
+	for _, elem := range genState.GuardianList {
+		k.SetGuardian(ctx, elem)
+	}
+	// Set all the minterStats
+	for _, elem := range genState.MinterStatsList {
+		k.SetMinterStats(ctx, elem)
+	}
+	// Set if defined
+	if genState.MintingTotals != nil {
+		k.SetMintingTotals(ctx, *genState.MintingTotals)
+	}
+	// Set all the authorizationState
+	for _, elem := range genState.AuthorizationStateList {
+		k.SetAuthorizationState(ctx, elem)
+	}
+	// Set all the rateLimit
+	for _, elem := range genState.RateLimitList {
+		k.SetRateLimit(ctx, elem)
+	}
+	// Set all the allowedChannel
+	for _, elem := range genState.AllowedChannelList {
+		k.SetAllowedChannel(ctx, elem)
+	}
+	// Set all the blacklistSyncChannel
+	for _, elem := range genState.BlacklistSyncChannelList {
+		k.SetBlacklistSyncChannel(ctx, elem)
+	}
+	// Set all the bridgeRoute
+	for _, elem := range genState.BridgeRouteList {
+		k.SetBridgeRoute(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
+
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+	k.SetBridgePort(ctx, genState.BridgePortId)
+	if !k.IsBound(ctx, genState.BridgePortId) {
+		if err := k.BindPort(ctx, genState.BridgePortId); err != nil {
+			panic("could not claim bridge port capability: " + err.Error())
+		}
+	}
 	k.SetParams(ctx, genState.Params)
+
+	if err := ctx.EventManager().EmitTypedEvents(genesisEvents(genState)...); err != nil {
+		panic(err)
+	}
+}
+
+// genesisEvents returns the events describing the state loaded from genesis so that
+// indexers can rebuild the state from events alone. Genesis events have no actor.
+func genesisEvents(genState types.GenesisState) []proto.Message {
+	var events []proto.Message
+
+	roles := []struct {
+		role    types.Role
+		address string
+	}{
+		{types.RoleOwner, genState.GetOwner().GetAddress()},
+		{types.RoleMasterMinter, genState.GetMasterMinter().GetAddress()},
+		{types.RolePauser, genState.GetPauser().GetAddress()},
+		{types.RoleBlacklister, genState.GetBlacklister().GetAddress()},
+		{types.RoleAttester, genState.GetAttester().GetAddress()},
+	}
+	for _, role := range roles {
+		if role.address != "" {
+			events = append(events, &types.EventRoleChanged{Role: role.role, Current: role.address})
+		}
+	}
+	for _, elem := range genState.RoleChangeList {
+		events = append(events, &types.EventRoleChangeQueued{RoleChange: elem})
+	}
+	if genState.Paused != nil && genState.Paused.Paused {
+		events = append(events, &types.EventPaused{})
+	}
+	for _, elem := range genState.BlacklistedList {
+		events = append(events, &types.EventBlacklisted{Address: elem.Address})
+	}
+	for _, elem := range genState.GuardianList {
+		events = append(events, &types.EventGuardianAdded{Address: elem.Address})
+	}
+	for _, elem := range genState.MinterControllerList {
+		events = append(events, &types.EventMinterControllerConfigured{Controller: elem.Controller, Minter: elem.Minter})
+	}
+	for _, elem := range genState.MintersList {
+		events = append(events, &types.EventAllowanceChanged{Minter: elem.Address, Allowance: elem.Allowance})
+	}
+	if genState.SupplyCap != nil {
+		events = append(events, &types.EventSupplyCapChanged{Current: genState.SupplyCap.Amount})
+	}
+	if genState.Quorum != nil {
+		events = append(events, &types.EventQuorumChanged{Current: genState.Quorum})
+	}
+	for _, elem := range genState.RateLimitList {
+		elem := elem
+		events = append(events, &types.EventRateLimitChanged{ChannelId: elem.ChannelId, Direction: elem.Direction, Current: &elem})
+	}
+	for _, elem := range genState.AllowedChannelList {
+		events = append(events, &types.EventChannelAllowed{ChannelId: elem.ChannelId})
+	}
+	for _, elem := range genState.BlacklistSyncChannelList {
+		events = append(events, &types.EventBlacklistSyncChannelAdded{ChannelId: elem.ChannelId})
+	}
+	for _, elem := range genState.BridgeRouteList {
+		events = append(events, &types.EventBridgeRouteAdded{ChannelId: elem.ChannelId})
+	}
+	for _, elem := range genState.PendingOperationList {
+		events = append(events, &types.EventOperationSubmitted{Operation: elem})
+	}
+	for _, elem := range genState.RedemptionList {
+		switch elem.Status {
+		case types.RedemptionFulfilled:
+			events = append(events, &types.EventRedemptionFulfilled{Redemption: elem})
+		case types.RedemptionRejected:
+			events = append(events, &types.EventRedemptionRejected{Redemption: elem})
+		default:
+			events = append(events, &types.EventRedemptionRequested{Redemption: elem})
+		}
+	}
+	for _, elem := range genState.ReserveAttestationList {
+		events = append(events, &types.EventReserveAttestationSubmitted{Attestation: elem})
+	}
+
+	return events
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -84,6 +256,43 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Get all mintingDenom
 	mintingDenom := k.GetMintingDenom(ctx)
 	genesis.MintingDenom = &mintingDenom
+	genesis.RedemptionList = k.GetAllRedemption(ctx)
+	genesis.RedemptionCount = k.GetRedemptionCount(ctx)
+	// Get all attester
+	attester, found := k.GetAttester(ctx)
+	if found {
+		genesis.Attester = &attester
+	}
+	genesis.ReserveAttestationList = k.GetAllReserveAttestation(ctx)
+	genesis.ReserveAttestationCount = k.GetReserveAttestationCount(ctx)
+	// Get all supplyCap
+	supplyCap, found := k.GetSupplyCap(ctx)
+	if found {
+		genesis.SupplyCap = &supplyCap
+	}
+	// Get all quorum
+	quorum, found := k.GetQuorum(ctx)
+	if found {
+		genesis.Quorum = &quorum
+	}
+	genesis.PendingOperationList = k.GetAllPendingOperation(ctx)
+	genesis.PendingOperationCount = k.GetPendingOperationCount(ctx)
+	genesis.RoleChangeList = k.GetAllRoleChange(ctx)
+	genesis.RoleChangeCount = k.GetRoleChangeCount(ctx)
+	genesis.GuardianList = k.GetAllGuardian(ctx)
+	genesis.MinterStatsList = k.GetAllMinterStats(ctx)
+	// Get all mintingTotals
+	mintingTotals, found := k.GetMintingTotals(ctx)
+	if found {
+		genesis.MintingTotals = &mintingTotals
+	}
+	genesis.AuthorizationStateList = k.GetAllAuthorizationState(ctx)
+	genesis.RateLimitList = k.GetAllRateLimit(ctx)
+	genesis.AllowedChannelList = k.GetAllAllowedChannel(ctx)
+	genesis.BlacklistSyncChannelList = k.GetAllBlacklistSyncChannel(ctx)
+	genesis.PortId = k.GetPort(ctx)
+	genesis.BridgeRouteList = k.GetAllBridgeRoute(ctx)
+	genesis.BridgePortId = k.GetBridgePort(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,7 +2,10 @@ package tokenfactory_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
 	"github.com/strangelove-ventures/hero/testutil/nullify"
 	"github.com/strangelove-ventures/hero/x/tokenfactory"
@@ -57,10 +60,136 @@ func TestGenesis(t *testing.T) {
 		MintingDenom: &types.MintingDenom{
 			Denom: "65",
 		},
+		RedemptionList: []types.Redemption{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		RedemptionCount: 2,
+		Attester: &types.Attester{
+			Address: "61",
+		},
+		ReserveAttestationList: []types.ReserveAttestation{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		ReserveAttestationCount: 2,
+		SupplyCap: &types.SupplyCap{
+			Amount: sdk.NewInt64Coin("uusdc", 1000000),
+		},
+		Quorum: &types.Quorum{
+			Members:   []string{"74", "75"},
+			Threshold: 2,
+		},
+		PendingOperationList: []types.PendingOperation{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		PendingOperationCount: 2,
+		RoleChangeList: []types.RoleChange{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		RoleChangeCount: 2,
+		GuardianList: []types.Guardian{
+			{
+				Address: "0",
+			},
+			{
+				Address: "1",
+			},
+		},
+		MinterStatsList: []types.MinterStats{
+			{
+				Address: "0",
+			},
+			{
+				Address: "1",
+			},
+		},
+		MintingTotals: &types.MintingTotals{
+			MintCount: 42,
+		},
+		AuthorizationStateList: []types.AuthorizationState{
+			{
+				Authorizer: "0",
+				Nonce:      "0",
+			},
+			{
+				Authorizer: "0",
+				Nonce:      "1",
+			},
+		},
+		RateLimitList: []types.RateLimit{
+			{
+				ChannelId:         "channel-0",
+				Direction:         types.RateLimitInflow,
+				MaxAmount:         sdk.NewInt(100),
+				MaxSupplyFraction: sdk.ZeroDec(),
+				Window:            time.Hour,
+				Flow:              sdk.ZeroInt(),
+			},
+			{
+				ChannelId:         "channel-0",
+				Direction:         types.RateLimitOutflow,
+				MaxAmount:         sdk.NewInt(100),
+				MaxSupplyFraction: sdk.ZeroDec(),
+				Window:            time.Hour,
+				Flow:              sdk.ZeroInt(),
+			},
+		},
+		AllowedChannelList: []types.AllowedChannel{
+			{
+				ChannelId: "channel-0",
+			},
+			{
+				ChannelId: "channel-1",
+			},
+		},
+		BlacklistSyncChannelList: []types.BlacklistSyncChannel{
+			{
+				ChannelId: "channel-0",
+			},
+			{
+				ChannelId: "channel-1",
+			},
+		},
+		PortId: types.PortID,
+		BridgeRouteList: []types.BridgeRoute{
+			{
+				ChannelId: "channel-0",
+				Sent:      sdk.NewInt(10),
+				Received:  sdk.NewInt(5),
+				InFlight:  sdk.ZeroInt(),
+			},
+			{
+				ChannelId: "channel-1",
+				Sent:      sdk.ZeroInt(),
+				Received:  sdk.ZeroInt(),
+				InFlight:  sdk.NewInt(3),
+			},
+		},
+		BridgePortId: types.BridgePortID,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
-	k, ctx := keepertest.TokenfactoryKeeper(t)
+	k, bankKeeper, ctx := keepertest.TokenfactoryKeeperWithBank(t)
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "65", DenomUnits: []*banktypes.DenomUnit{{Denom: "65"}}})
 	tokenfactory.InitGenesis(ctx, *k, genesisState)
 	got := tokenfactory.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
@@ -77,5 +206,26 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Owner, got.Owner)
 	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerList)
 	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
+	require.ElementsMatch(t, genesisState.RedemptionList, got.RedemptionList)
+	require.Equal(t, genesisState.RedemptionCount, got.RedemptionCount)
+	require.Equal(t, genesisState.Attester, got.Attester)
+	require.ElementsMatch(t, genesisState.ReserveAttestationList, got.ReserveAttestationList)
+	require.Equal(t, genesisState.ReserveAttestationCount, got.ReserveAttestationCount)
+	require.Equal(t, genesisState.SupplyCap, got.SupplyCap)
+	require.Equal(t, genesisState.Quorum, got.Quorum)
+	require.ElementsMatch(t, genesisState.PendingOperationList, got.PendingOperationList)
+	require.Equal(t, genesisState.PendingOperationCount, got.PendingOperationCount)
+	require.ElementsMatch(t, genesisState.RoleChangeList, got.RoleChangeList)
+	require.Equal(t, genesisState.RoleChangeCount, got.RoleChangeCount)
+	require.ElementsMatch(t, genesisState.GuardianList, got.GuardianList)
+	require.ElementsMatch(t, genesisState.MinterStatsList, got.MinterStatsList)
+	require.Equal(t, genesisState.MintingTotals, got.MintingTotals)
+	require.ElementsMatch(t, genesisState.AuthorizationStateList, got.AuthorizationStateList)
+	require.ElementsMatch(t, genesisState.RateLimitList, got.RateLimitList)
+	require.ElementsMatch(t, genesisState.AllowedChannelList, got.AllowedChannelList)
+	require.ElementsMatch(t, genesisState.BlacklistSyncChannelList, got.BlacklistSyncChannelList)
+	require.Equal(t, genesisState.PortId, got.PortId)
+	require.ElementsMatch(t, genesisState.BridgeRouteList, got.BridgeRouteList)
+	require.Equal(t, genesisState.BridgePortId, got.BridgePortId)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
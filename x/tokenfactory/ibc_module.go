@@ -0,0 +1,133 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the IBC route of the module. The IBC router routes the callbacks of every port
+// owned by the module to the same route, so IBCModule dispatches them to the blacklist sync or
+// the bridge application depending on the port of the channel.
+type IBCModule struct {
+	keeper        keeper.Keeper
+	blacklistSync BlacklistSyncIBCModule
+	bridge        BridgeIBCModule
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper:        k,
+		blacklistSync: NewBlacklistSyncIBCModule(k),
+		bridge:        NewBridgeIBCModule(k),
+	}
+}
+
+// route returns the application bound to portID. Ports that are not bound to the bridge are
+// routed to the blacklist sync application, which rejects the ports it is not bound to.
+func (im IBCModule) route(ctx sdk.Context, portID string) porttypes.IBCModule {
+	if portID == im.keeper.GetBridgePort(ctx) {
+		return im.bridge
+	}
+	return im.blacklistSync
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.route(ctx, portID).OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.route(ctx, portID).OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.route(ctx, portID).OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.route(ctx, portID).OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.route(ctx, portID).OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.route(ctx, portID).OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return im.route(ctx, packet.DestinationPort).OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.route(ctx, packet.SourcePort).OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.route(ctx, packet.SourcePort).OnTimeoutPacket(ctx, packet, relayer)
+}
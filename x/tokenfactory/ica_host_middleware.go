@@ -0,0 +1,127 @@
+package tokenfactory
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+)
+
+var _ porttypes.IBCModule = &ICAHostMiddleware{}
+
+// ICAHostMiddleware applies the pause and blacklist rules of the minting denom to the messages that
+// interchain accounts execute on this chain. Those messages never go through the ante handler.
+type ICAHostMiddleware struct {
+	app    porttypes.IBCModule
+	cdc    codec.BinaryCodec
+	keeper keeper.Keeper
+}
+
+// NewICAHostMiddleware creates a new ICAHostMiddleware given the codec used to decode the executed
+// messages, the keeper and the underlying ICA host application.
+func NewICAHostMiddleware(app porttypes.IBCModule, cdc codec.BinaryCodec, k keeper.Keeper) ICAHostMiddleware {
+	return ICAHostMiddleware{
+		app:    app,
+		cdc:    cdc,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (version string, err error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im ICAHostMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket decodes the messages of an ICA transaction and returns an error acknowledgement if
+// any of them moves the minting denom while paused or from or to a blacklisted address. Packets
+// that can not be decoded are left to the ICA host application to reject.
+func (im ICAHostMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil || data.Type != icatypes.EXECUTE_TX {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(im.cdc, data.Data)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	for _, msg := range msgs {
+		if reason, err := im.keeper.ValidateMsg(ctx, msg); err != nil {
+			keeper.IncrRejectedTransfer(keeper.RejectionSourceICA, reason)
+			return channeltypes.NewErrorAcknowledgement(err.Error())
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im ICAHostMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im ICAHostMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNAllowedChannel(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AllowedChannel {
+	items := make([]types.AllowedChannel, n)
+	for i := range items {
+		items[i].ChannelId = strconv.Itoa(i)
+
+		keeper.SetAllowedChannel(ctx, items[i])
+	}
+	return items
+}
+
+func TestAllowedChannelGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAllowedChannel(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetAllowedChannel(ctx,
+			item.ChannelId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestAllowedChannelRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAllowedChannel(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveAllowedChannel(ctx,
+			item.ChannelId,
+		)
+		_, found := keeper.GetAllowedChannel(ctx,
+			item.ChannelId,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestAllowedChannelGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAllowedChannel(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAllowedChannel(ctx)),
+	)
+}
+
+func TestAllowedChannelMsgServer(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := srv.AllowChannel(wctx, types.NewMsgAllowChannel(sample.AccAddress(), "channel-0"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.AllowChannel(wctx, types.NewMsgAllowChannel(owner, "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventChannelAllowed{ChannelId: "channel-0", Actor: owner}, lastEvent(t, ctx))
+
+	reason, err := k.ValidateChannelAllowed(ctx, "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, types.CheckReasonOk, reason)
+
+	_, err = srv.DisallowChannel(wctx, types.NewMsgDisallowChannel(owner, "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventChannelDisallowed{ChannelId: "channel-0", Actor: owner}, lastEvent(t, ctx))
+
+	reason, err = k.ValidateChannelAllowed(ctx, "channel-0")
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+	require.Equal(t, types.CheckReasonChannelNotAllowed, reason)
+
+	_, err = srv.DisallowChannel(wctx, types.NewMsgDisallowChannel(owner, "channel-0"))
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAttester set attester in the store
+func (k Keeper) SetAttester(ctx sdk.Context, attester types.Attester) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&attester)
+	store.Set(types.KeyPrefix(types.AttesterKey), b)
+}
+
+// GetAttester returns attester
+func (k Keeper) GetAttester(ctx sdk.Context) (val types.Attester, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.AttesterKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAttester removes attester from the store
+func (k Keeper) RemoveAttester(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(types.AttesterKey))
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createTestAttester(keeper *keeper.Keeper, ctx sdk.Context) types.Attester {
+	item := types.Attester{}
+	keeper.SetAttester(ctx, item)
+	return item
+}
+
+func TestAttesterGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	item := createTestAttester(keeper, ctx)
+	rst, found := keeper.GetAttester(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetAuthorizationState set a specific authorizationState in the store from its index
+func (k Keeper) SetAuthorizationState(ctx sdk.Context, authorizationState types.AuthorizationState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationStateKeyPrefix))
+	b := k.cdc.MustMarshal(&authorizationState)
+	store.Set(types.AuthorizationStateKey(
+		authorizationState.Authorizer,
+		authorizationState.Nonce,
+	), b)
+}
+
+// GetAuthorizationState returns an authorizationState from its index
+func (k Keeper) GetAuthorizationState(
+	ctx sdk.Context,
+	authorizer string,
+	nonce string,
+
+) (val types.AuthorizationState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationStateKeyPrefix))
+
+	b := store.Get(types.AuthorizationStateKey(
+		authorizer,
+		nonce,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAuthorizationState returns all authorizationState
+func (k Keeper) GetAllAuthorizationState(ctx sdk.Context) (list []types.AuthorizationState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationStateKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuthorizationState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNAuthorizationState(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.AuthorizationState {
+	items := make([]types.AuthorizationState, n)
+	for i := range items {
+		items[i].Authorizer = strconv.Itoa(i)
+		items[i].Nonce = strconv.Itoa(i)
+
+		keeper.SetAuthorizationState(ctx, items[i])
+	}
+	return items
+}
+
+func TestAuthorizationStateGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAuthorizationState(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetAuthorizationState(ctx,
+			item.Authorizer,
+			item.Nonce,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestAuthorizationStateGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNAuthorizationState(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAuthorizationState(ctx)),
+	)
+}
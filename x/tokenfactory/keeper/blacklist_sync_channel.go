@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetBlacklistSyncChannel set a specific blacklistSyncChannel in the store from its index
+func (k Keeper) SetBlacklistSyncChannel(ctx sdk.Context, blacklistSyncChannel types.BlacklistSyncChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistSyncChannelKeyPrefix))
+	b := k.cdc.MustMarshal(&blacklistSyncChannel)
+	store.Set(types.BlacklistSyncChannelKey(
+		blacklistSyncChannel.ChannelId,
+	), b)
+}
+
+// GetBlacklistSyncChannel returns an blacklistSyncChannel from its index
+func (k Keeper) GetBlacklistSyncChannel(
+	ctx sdk.Context,
+	channelId string,
+
+) (val types.BlacklistSyncChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistSyncChannelKeyPrefix))
+
+	b := store.Get(types.BlacklistSyncChannelKey(
+		channelId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBlacklistSyncChannel removes a blacklistSyncChannel from the store
+func (k Keeper) RemoveBlacklistSyncChannel(
+	ctx sdk.Context,
+	channelId string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistSyncChannelKeyPrefix))
+	store.Delete(types.BlacklistSyncChannelKey(
+		channelId,
+	))
+}
+
+// GetAllBlacklistSyncChannel returns all blacklistSyncChannel
+func (k Keeper) GetAllBlacklistSyncChannel(ctx sdk.Context) (list []types.BlacklistSyncChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistSyncChannelKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlacklistSyncChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
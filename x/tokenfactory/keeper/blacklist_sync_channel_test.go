@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNBlacklistSyncChannel(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.BlacklistSyncChannel {
+	items := make([]types.BlacklistSyncChannel, n)
+	for i := range items {
+		items[i].ChannelId = strconv.Itoa(i)
+
+		keeper.SetBlacklistSyncChannel(ctx, items[i])
+	}
+	return items
+}
+
+func TestBlacklistSyncChannelGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBlacklistSyncChannel(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetBlacklistSyncChannel(ctx,
+			item.ChannelId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestBlacklistSyncChannelRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBlacklistSyncChannel(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveBlacklistSyncChannel(ctx,
+			item.ChannelId,
+		)
+		_, found := keeper.GetBlacklistSyncChannel(ctx,
+			item.ChannelId,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestBlacklistSyncChannelGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBlacklistSyncChannel(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllBlacklistSyncChannel(ctx)),
+	)
+}
+
+func TestBlacklistSyncChannelMsgServer(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := srv.AddBlacklistSyncChannel(wctx, types.NewMsgAddBlacklistSyncChannel(sample.AccAddress(), "channel-0"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	k.SetBlacklistSyncChannel(ctx, types.BlacklistSyncChannel{ChannelId: "channel-0"})
+	_, err = srv.RemoveBlacklistSyncChannel(wctx, types.NewMsgRemoveBlacklistSyncChannel(owner, "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlacklistSyncChannelRemoved{ChannelId: "channel-0", Actor: owner}, lastEvent(t, ctx))
+
+	_, found := k.GetBlacklistSyncChannel(ctx, "channel-0")
+	require.False(t, found)
+
+	_, err = srv.RemoveBlacklistSyncChannel(wctx, types.NewMsgRemoveBlacklistSyncChannel(owner, "channel-0"))
+	require.ErrorIs(t, err, types.ErrNotSyncChannel)
+}
+
+func TestBroadcastBlacklistUpdate(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister := sample.AccAddress()
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	timeout := uint64(ctx.BlockTime().Add(types.MinBlacklistUpdateTimeout).UnixNano())
+
+	_, err := srv.BroadcastBlacklistUpdate(wctx, types.NewMsgBroadcastBlacklistUpdate(sample.AccAddress(), sample.AccAddress(), timeout))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.BroadcastBlacklistUpdate(wctx, types.NewMsgBroadcastBlacklistUpdate(blacklister, sample.AccAddress(), timeout-1))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	_, err = srv.BroadcastBlacklistUpdate(wctx, types.NewMsgBroadcastBlacklistUpdate(blacklister, sample.AccAddress(), timeout))
+	require.ErrorIs(t, err, types.ErrNotSyncChannel)
+}
+
+func TestOnRecvBlacklistUpdatePacket(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	address := sample.AccAddress()
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+	}
+
+	_, err := k.OnRecvBlacklistUpdatePacket(ctx, packet, types.BlacklistUpdatePacketData{Address: address, Blacklisted: true})
+	require.ErrorIs(t, err, types.ErrNotSyncChannel)
+
+	k.SetBlacklistSyncChannel(ctx, types.BlacklistSyncChannel{ChannelId: "channel-0"})
+
+	_, err = k.OnRecvBlacklistUpdatePacket(ctx, packet, types.BlacklistUpdatePacketData{Address: "invalid", Blacklisted: true})
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	_, err = k.OnRecvBlacklistUpdatePacket(ctx, packet, types.BlacklistUpdatePacketData{Address: address, Blacklisted: true})
+	require.NoError(t, err)
+	_, found := k.GetBlacklisted(ctx, address)
+	require.True(t, found)
+	require.Equal(t, &types.EventBlacklistUpdateReceived{
+		Address:               address,
+		Blacklisted:           true,
+		ChannelId:             "channel-0",
+		CounterpartyPortId:    types.PortID,
+		CounterpartyChannelId: "channel-7",
+		Sequence:              1,
+	}, lastEvent(t, ctx))
+
+	_, err = k.OnRecvBlacklistUpdatePacket(ctx, packet, types.BlacklistUpdatePacketData{Address: address})
+	require.NoError(t, err)
+	_, found = k.GetBlacklisted(ctx, address)
+	require.False(t, found)
+}
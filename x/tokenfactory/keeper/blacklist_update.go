@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitBlacklistUpdatePacket sends a blacklist update packet through the source channel and
+// returns its sequence
+func (k Keeper) TransmitBlacklistUpdatePacket(
+	ctx sdk.Context,
+	packetData types.BlacklistUpdatePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(cosmoserrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.sendPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvBlacklistUpdatePacket applies a blacklist update received through a blacklist sync channel
+func (k Keeper) OnRecvBlacklistUpdatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlacklistUpdatePacketData) (packetAck types.BlacklistUpdatePacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	// updates are only accepted from the counterparties the owner authorized
+	if _, found := k.GetBlacklistSyncChannel(ctx, packet.DestinationChannel); !found {
+		return packetAck, sdkerrors.Wrapf(types.ErrNotSyncChannel, "channel %s is not a blacklist sync channel", packet.DestinationChannel)
+	}
+
+	if data.Blacklisted {
+		k.SetBlacklisted(ctx, types.Blacklisted{Address: data.Address})
+	} else {
+		k.RemoveBlacklisted(ctx, data.Address)
+	}
+
+	k.Logger(ctx).Info(
+		"applied blacklist update",
+		"address", data.Address,
+		"blacklisted", data.Blacklisted,
+		"channel", packet.DestinationChannel,
+		"counterparty_port", packet.SourcePort,
+		"counterparty_channel", packet.SourceChannel,
+		"sequence", packet.Sequence,
+	)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBlacklistUpdateReceived{
+		Address:               data.Address,
+		Blacklisted:           data.Blacklisted,
+		ChannelId:             packet.DestinationChannel,
+		CounterpartyPortId:    packet.SourcePort,
+		CounterpartyChannelId: packet.SourceChannel,
+		Sequence:              packet.Sequence,
+	})
+
+	return packetAck, err
+}
+
+// OnAcknowledgementBlacklistUpdatePacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain
+func (k Keeper) OnAcknowledgementBlacklistUpdatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlacklistUpdatePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.blacklistUpdateFailed(ctx, packet, data, dispatchedAck.Error)
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.BlacklistUpdatePacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// the counterparty module may send back an invalid acknowledgement
+			return sdkerrors.Wrap(cosmoserrors.ErrJSONUnmarshal, "cannot unmarshal packet acknowledgement")
+		}
+		return nil
+	default:
+		// the counterparty module may send back an invalid acknowledgement
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidType, "invalid acknowledgement format: %T", dispatchedAck)
+	}
+}
+
+// OnTimeoutBlacklistUpdatePacket responds to the case where a packet has not been received. The
+// timeout closes the ordered channel permanently, so it is no longer used as a blacklist sync channel
+// and the owners of both chains must open and approve a replacement channel.
+func (k Keeper) OnTimeoutBlacklistUpdatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlacklistUpdatePacketData) error {
+	if err := k.blacklistUpdateFailed(ctx, packet, data, "packet timed out"); err != nil {
+		return err
+	}
+	return k.CloseBlacklistSyncChannel(ctx, packet.SourceChannel)
+}
+
+// CloseBlacklistSyncChannel removes a closed channel from the blacklist sync channels, so that updates
+// are broadcast through the remaining channels until the owner adds a replacement channel.
+func (k Keeper) CloseBlacklistSyncChannel(ctx sdk.Context, channelID string) error {
+	if _, found := k.GetBlacklistSyncChannel(ctx, channelID); !found {
+		return nil
+	}
+
+	k.RemoveBlacklistSyncChannel(ctx, channelID)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklistSyncChannelRemoved{
+		ChannelId: channelID,
+		Actor:     types.ModuleName,
+	})
+}
+
+// blacklistUpdateFailed reports a blacklist update that was not applied by the counterparty chain
+func (k Keeper) blacklistUpdateFailed(ctx sdk.Context, packet channeltypes.Packet, data types.BlacklistUpdatePacketData, reason string) error {
+	k.Logger(ctx).Error(
+		"blacklist update was not applied by the counterparty chain",
+		"address", data.Address,
+		"blacklisted", data.Blacklisted,
+		"channel", packet.SourceChannel,
+		"sequence", packet.Sequence,
+		"error", reason,
+	)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklistUpdateFailed{
+		Address:     data.Address,
+		Blacklisted: data.Blacklisted,
+		ChannelId:   packet.SourceChannel,
+		Sequence:    packet.Sequence,
+		Error:       reason,
+	})
+}
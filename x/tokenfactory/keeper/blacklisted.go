@@ -1,13 +1,40 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 )
 
+// GetBlacklistedCount get the number of blacklisted addresses
+func (k Keeper) GetBlacklistedCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.BlacklistedCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetBlacklistedCount set the number of blacklisted addresses
+func (k Keeper) SetBlacklistedCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.BlacklistedCountKey), bz)
+}
+
 // SetBlacklisted set a specific blacklisted in the store from its index
 func (k Keeper) SetBlacklisted(ctx sdk.Context, blacklisted types.Blacklisted) {
+	if _, found := k.GetBlacklisted(ctx, blacklisted.Address); !found {
+		k.SetBlacklistedCount(ctx, k.GetBlacklistedCount(ctx)+1)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	b := k.cdc.MustMarshal(&blacklisted)
 	store.Set(types.BlacklistedKey(
@@ -40,6 +67,10 @@ func (k Keeper) RemoveBlacklisted(
 	address string,
 
 ) {
+	if _, found := k.GetBlacklisted(ctx, address); found {
+		k.SetBlacklistedCount(ctx, k.GetBlacklistedCount(ctx)-1)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlacklistedKeyPrefix))
 	store.Delete(types.BlacklistedKey(
 		address,
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetBridgeRoute set a specific bridgeRoute in the store from its index
+func (k Keeper) SetBridgeRoute(ctx sdk.Context, bridgeRoute types.BridgeRoute) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgeRouteKeyPrefix))
+	b := k.cdc.MustMarshal(&bridgeRoute)
+	store.Set(types.BridgeRouteKey(
+		bridgeRoute.ChannelId,
+	), b)
+}
+
+// GetBridgeRoute returns an bridgeRoute from its index
+func (k Keeper) GetBridgeRoute(
+	ctx sdk.Context,
+	channelId string,
+
+) (val types.BridgeRoute, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgeRouteKeyPrefix))
+
+	b := store.Get(types.BridgeRouteKey(
+		channelId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBridgeRoute removes a bridgeRoute from the store
+func (k Keeper) RemoveBridgeRoute(
+	ctx sdk.Context,
+	channelId string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgeRouteKeyPrefix))
+	store.Delete(types.BridgeRouteKey(
+		channelId,
+	))
+}
+
+// GetAllBridgeRoute returns all bridgeRoute
+func (k Keeper) GetAllBridgeRoute(ctx sdk.Context) (list []types.BridgeRoute) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgeRouteKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BridgeRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
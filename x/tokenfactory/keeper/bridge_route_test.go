@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNBridgeRoute(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.BridgeRoute {
+	items := make([]types.BridgeRoute, n)
+	for i := range items {
+		items[i].ChannelId = strconv.Itoa(i)
+		items[i].Sent = sdk.NewInt(int64(i))
+		items[i].Received = sdk.NewInt(int64(2 * i))
+		items[i].InFlight = sdk.ZeroInt()
+
+		keeper.SetBridgeRoute(ctx, items[i])
+	}
+	return items
+}
+
+func TestBridgeRouteGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBridgeRoute(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetBridgeRoute(ctx,
+			item.ChannelId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestBridgeRouteRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBridgeRoute(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveBridgeRoute(ctx,
+			item.ChannelId,
+		)
+		_, found := keeper.GetBridgeRoute(ctx,
+			item.ChannelId,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestBridgeRouteGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNBridgeRoute(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllBridgeRoute(ctx)),
+	)
+}
+
+func TestBridgeRouteMsgServer(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+
+	_, err := srv.AddBridgeRoute(wctx, types.NewMsgAddBridgeRoute(sample.AccAddress(), "channel-0"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.AddBridgeRoute(wctx, types.NewMsgAddBridgeRoute(owner, "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBridgeRouteAdded{ChannelId: "channel-0", Actor: owner}, lastEvent(t, ctx))
+
+	route, found := k.GetBridgeRoute(ctx, "channel-0")
+	require.True(t, found)
+	require.True(t, route.Sent.IsZero())
+	require.True(t, route.Received.IsZero())
+	require.True(t, route.InFlight.IsZero())
+
+	// routes with transfers in flight are kept so that they can be refunded
+	route.InFlight = sdk.NewInt(10)
+	k.SetBridgeRoute(ctx, route)
+	_, err = srv.RemoveBridgeRoute(wctx, types.NewMsgRemoveBridgeRoute(owner, "channel-0"))
+	require.ErrorIs(t, err, types.ErrBridgeRouteInFlight)
+
+	// adding an existing route keeps its accounting
+	_, err = srv.AddBridgeRoute(wctx, types.NewMsgAddBridgeRoute(owner, "channel-0"))
+	require.NoError(t, err)
+	route, _ = k.GetBridgeRoute(ctx, "channel-0")
+	require.Equal(t, sdk.NewInt(10), route.InFlight)
+
+	route.InFlight = sdk.ZeroInt()
+	k.SetBridgeRoute(ctx, route)
+	_, err = srv.RemoveBridgeRoute(wctx, types.NewMsgRemoveBridgeRoute(owner, "channel-0"))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBridgeRouteRemoved{ChannelId: "channel-0", Actor: owner}, lastEvent(t, ctx))
+
+	_, found = k.GetBridgeRoute(ctx, "channel-0")
+	require.False(t, found)
+
+	_, err = srv.RemoveBridgeRoute(wctx, types.NewMsgRemoveBridgeRoute(owner, "channel-0"))
+	require.ErrorIs(t, err, types.ErrNotBridgeRoute)
+}
+
+func TestOnRecvBridgeTransferPacket(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+
+	data := types.BridgeTransferPacketData{
+		Sender:   sample.AccAddress(),
+		Receiver: sample.AccAddress(),
+		Amount:   sdk.NewInt(100),
+	}
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         types.BridgePortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.BridgePortID,
+		DestinationChannel: "channel-0",
+	}
+
+	_, err := k.OnRecvBridgeTransferPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrNotBridgeRoute)
+
+	k.SetBridgeRoute(ctx, types.BridgeRoute{ChannelId: "channel-0", Sent: sdk.ZeroInt(), Received: sdk.ZeroInt(), InFlight: sdk.ZeroInt()})
+
+	_, err = k.OnRecvBridgeTransferPacket(ctx, packet, types.BridgeTransferPacketData{Sender: data.Sender, Receiver: "invalid", Amount: data.Amount})
+	require.ErrorIs(t, err, types.ErrInvalidBridgePacket)
+
+	_, err = k.OnRecvBridgeTransferPacket(ctx, packet, types.BridgeTransferPacketData{Sender: data.Sender, Receiver: data.Receiver, Amount: sdk.ZeroInt()})
+	require.ErrorIs(t, err, types.ErrInvalidBridgePacket)
+
+	// the remaining mint rules, shared with the msg server, are covered by the bridge tests in tests/ibc
+	k.SetBlacklisted(ctx, types.Blacklisted{Address: data.Sender})
+	_, err = k.OnRecvBridgeTransferPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrBlacklistedSender)
+}
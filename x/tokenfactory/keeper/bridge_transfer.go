@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitBridgeTransferPacket sends a bridge transfer packet through the source channel and
+// returns its sequence
+func (k Keeper) TransmitBridgeTransferPacket(
+	ctx sdk.Context,
+	packetData types.BridgeTransferPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(cosmoserrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.sendPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvBridgeTransferPacket mints the minting denom burned on the counterparty chain to the
+// receiver. The mint is checked by the same rules as the mints of the msg server, against the
+// allowance of the bridge minter, except that it is authorized by the route instead of the quorum.
+// The caller must discard the state changes when an error is returned.
+func (k Keeper) OnRecvBridgeTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BridgeTransferPacketData) (packetAck types.BridgeTransferPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	// tokens are only minted for the counterparties the owner authorized
+	route, found := k.GetBridgeRoute(ctx, packet.DestinationChannel)
+	if !found {
+		return packetAck, sdkerrors.Wrapf(types.ErrNotBridgeRoute, "channel %s is not a bridge route", packet.DestinationChannel)
+	}
+
+	if _, err := k.ValidateNotBlacklisted(ctx, data.Sender, ""); err != nil {
+		return packetAck, err
+	}
+
+	amount := sdk.NewCoin(k.GetMintingDenom(ctx).Denom, data.Amount)
+
+	minterAddress := types.BridgeMinterAddress().String()
+	minter, found := k.GetMinters(ctx, minterAddress)
+	if !found {
+		return packetAck, sdkerrors.Wrapf(types.ErrBridgeMinterNotSet, "bridge minter %s has no allowance configured", minterAddress)
+	}
+
+	if _, err := k.validateMinterMint(ctx, &types.MsgMint{From: minterAddress, Address: data.Receiver, Amount: amount}); err != nil {
+		return packetAck, err
+	}
+
+	previous := minter.Allowance
+	minter.Allowance = minter.Allowance.Sub(amount)
+	k.SetMinters(ctx, minter)
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return packetAck, sdkerrors.Wrap(types.ErrMint, err.Error())
+	}
+
+	receiver, _ := sdk.AccAddressFromBech32(data.Receiver)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return packetAck, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	route.Received = route.Received.Add(data.Amount)
+	k.SetBridgeRoute(ctx, route)
+
+	k.RecordMint(ctx, minterAddress, amount)
+
+	k.Logger(ctx).Info(
+		"minted bridge transfer",
+		"sender", data.Sender,
+		"receiver", data.Receiver,
+		"amount", amount,
+		"channel", packet.DestinationChannel,
+		"sequence", packet.Sequence,
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllowanceChanged{
+		Minter:    minterAddress,
+		Previous:  &previous,
+		Allowance: minter.Allowance,
+		Actor:     minterAddress,
+	}); err != nil {
+		return packetAck, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBridgeTransferReceived{
+		Sender:                data.Sender,
+		Receiver:              data.Receiver,
+		Amount:                amount,
+		ChannelId:             packet.DestinationChannel,
+		CounterpartyChannelId: packet.SourceChannel,
+		Sequence:              packet.Sequence,
+	})
+
+	return packetAck, err
+}
+
+// OnAcknowledgementBridgeTransferPacket settles a bridge transfer minted on the counterparty
+// chain, or refunds the sender when the counterparty chain refused to mint it
+func (k Keeper) OnAcknowledgementBridgeTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BridgeTransferPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundBridgeTransfer(ctx, packet, data, dispatchedAck.Error)
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.BridgeTransferPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// the counterparty module may send back an invalid acknowledgement
+			return sdkerrors.Wrap(cosmoserrors.ErrJSONUnmarshal, "cannot unmarshal packet acknowledgement")
+		}
+
+		if route, found := k.GetBridgeRoute(ctx, packet.SourceChannel); found {
+			route = releaseInFlight(route, data.Amount)
+			route.Sent = route.Sent.Add(data.Amount)
+			k.SetBridgeRoute(ctx, route)
+		}
+		return nil
+	default:
+		// the counterparty module may send back an invalid acknowledgement
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidType, "invalid acknowledgement format: %T", dispatchedAck)
+	}
+}
+
+// OnTimeoutBridgeTransferPacket refunds the sender of a bridge transfer that was not received
+func (k Keeper) OnTimeoutBridgeTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BridgeTransferPacketData) error {
+	return k.refundBridgeTransfer(ctx, packet, data, "packet timed out")
+}
+
+// refundBridgeTransfer mints the burned tokens of a bridge transfer back to the sender. The refund
+// restores supply that was burned on this chain, so it is not bounded by the bridge minter
+// allowance or the supply cap, and it is not refused while paused or to a blacklisted sender.
+// Like the burn of the transfer, the refund is recorded in the stats of the bridge minter.
+func (k Keeper) refundBridgeTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.BridgeTransferPacketData, reason string) error {
+	amount := sdk.NewCoin(k.GetMintingDenom(ctx).Denom, data.Amount)
+	coins := sdk.NewCoins(amount)
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrap(types.ErrMint, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+		return sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	if route, found := k.GetBridgeRoute(ctx, packet.SourceChannel); found {
+		k.SetBridgeRoute(ctx, releaseInFlight(route, data.Amount))
+	}
+
+	k.RecordMint(ctx, types.BridgeMinterAddress().String(), amount)
+
+	k.Logger(ctx).Error(
+		"refunded bridge transfer that was not minted by the counterparty chain",
+		"sender", data.Sender,
+		"amount", amount,
+		"channel", packet.SourceChannel,
+		"sequence", packet.Sequence,
+		"error", reason,
+	)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBridgeTransferRefunded{
+		Sender:    data.Sender,
+		Amount:    amount,
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Error:     reason,
+	})
+}
+
+// releaseInFlight removes a settled or refunded transfer from the amount in flight through the route.
+// Routes can not be removed while transfers are in flight, so the amount always covers the transfer
+// unless the route was imported with a lower amount in flight, in which case it is floored at zero.
+func releaseInFlight(route types.BridgeRoute, amount sdk.Int) types.BridgeRoute {
+	if amount.GT(route.InFlight) {
+		route.InFlight = sdk.ZeroInt()
+	} else {
+		route.InFlight = route.InFlight.Sub(amount)
+	}
+	return route
+}
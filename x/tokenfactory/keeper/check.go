@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// The Validate functions below hold the transfer and mint rules shared by the ante decorators,
+// the IBC and ICA host middlewares, the msg server and the CheckTransfer and CheckMint queries. Each returns
+// the reason a transfer or mint is rejected along with the error, or CheckReasonOk and nil.
+
+// ValidateNotPaused returns an error while token transfers are paused.
+func (k Keeper) ValidateNotPaused(ctx sdk.Context) (types.CheckReason, error) {
+	if k.GetPaused(ctx).Paused {
+		return types.CheckReasonPaused, sdkerrors.Wrapf(types.ErrPaused, "can not perform token transfers")
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// ValidateNotBlacklisted returns an error if the sender or the receiver is blacklisted.
+// Empty addresses are skipped.
+func (k Keeper) ValidateNotBlacklisted(ctx sdk.Context, sender string, receiver string) (types.CheckReason, error) {
+	if _, found := k.GetBlacklisted(ctx, sender); sender != "" && found {
+		return types.CheckReasonSenderBlacklisted, sdkerrors.Wrapf(types.ErrBlacklistedSender, "an address (%s) is blacklisted and can not send or receive tokens", sender)
+	}
+
+	if _, found := k.GetBlacklisted(ctx, receiver); receiver != "" && found {
+		return types.CheckReasonReceiverBlacklisted, sdkerrors.Wrapf(types.ErrBlacklistedRecipient, "an address (%s) is blacklisted and can not send or receive tokens", receiver)
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// ValidateChannelAllowed returns an error if the minting denom may not be sent or received
+// through the channel.
+func (k Keeper) ValidateChannelAllowed(ctx sdk.Context, channelId string) (types.CheckReason, error) {
+	if _, found := k.GetAllowedChannel(ctx, channelId); !found {
+		return types.CheckReasonChannelNotAllowed, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "channel %s is not allowed", channelId)
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// ValidateTransfer returns an error if a transfer of amount from one address to another would be
+// rejected. Bank sends are only restricted for the minting denom, while IBC transfers are
+// restricted for every denom.
+func (k Keeper) ValidateTransfer(ctx sdk.Context, from string, to string, amount sdk.Coin, path types.TransferPath) (types.CheckReason, error) {
+	if reason, err := k.ValidateNotPaused(ctx); err != nil {
+		return reason, err
+	}
+
+	if path == types.TransferPathBank && !k.IsMintingDenom(ctx, amount.Denom) {
+		return types.CheckReasonOk, nil
+	}
+
+	return k.ValidateNotBlacklisted(ctx, from, to)
+}
+
+// ValidateIBCTransfer returns an error if an IBC transfer of amount through the source channel would
+// be rejected. On top of the rules of ValidateTransfer, the minting denom may only be sent through
+// allowlisted channels and within the outflow rate limit of the channel.
+func (k Keeper) ValidateIBCTransfer(ctx sdk.Context, from string, to string, amount sdk.Coin, channelId string) (types.CheckReason, error) {
+	if reason, err := k.ValidateTransfer(ctx, from, to, amount, types.TransferPathIbc); err != nil {
+		return reason, err
+	}
+
+	if !k.IsMintingDenom(ctx, amount.Denom) {
+		return types.CheckReasonOk, nil
+	}
+
+	if reason, err := k.ValidateChannelAllowed(ctx, channelId); err != nil {
+		return reason, err
+	}
+
+	return k.ValidateRateLimit(ctx, channelId, types.RateLimitOutflow, amount.Amount)
+}
+
+// ValidateMsg returns an error if msg is a bank send or an IBC transfer that would be rejected by
+// ValidateTransfer or ValidateIBCTransfer. Messages executed through authz are checked, and other
+// messages are accepted.
+func (k Keeper) ValidateMsg(ctx sdk.Context, msg sdk.Msg) (types.CheckReason, error) {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return k.validateCoinsTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	case *banktypes.MsgMultiSend:
+		for _, input := range msg.Inputs {
+			if reason, err := k.validateCoinsTransfer(ctx, input.Address, "", input.Coins); err != nil {
+				return reason, err
+			}
+		}
+		for _, output := range msg.Outputs {
+			if reason, err := k.validateCoinsTransfer(ctx, "", output.Address, output.Coins); err != nil {
+				return reason, err
+			}
+		}
+	case *transfertypes.MsgTransfer:
+		return k.ValidateIBCTransfer(ctx, msg.Sender, msg.Receiver, msg.Token, msg.SourceChannel)
+	case *authz.MsgExec:
+		// messages that can not be unpacked are rejected by authz
+		msgs, _ := msg.GetMessages()
+		for _, m := range msgs {
+			if reason, err := k.ValidateMsg(ctx, m); err != nil {
+				return reason, err
+			}
+		}
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// validateCoinsTransfer returns an error if a bank send of any of the coins would be rejected.
+func (k Keeper) validateCoinsTransfer(ctx sdk.Context, from string, to string, coins sdk.Coins) (types.CheckReason, error) {
+	for _, coin := range coins {
+		if reason, err := k.ValidateTransfer(ctx, from, to, coin, types.TransferPathBank); err != nil {
+			return reason, err
+		}
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// ValidateMint returns an error if the mint would be rejected by the msg server.
+func (k Keeper) ValidateMint(ctx sdk.Context, msg *types.MsgMint) (types.CheckReason, error) {
+	if _, found := k.GetMinters(ctx, msg.From); !found {
+		return types.CheckReasonNotMinter, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return types.CheckReasonQuorumRequired, err
+	}
+
+	return k.validateMinterMint(ctx, msg)
+}
+
+// validateMinterMint returns an error if the minter of msg, which must exist, may not mint the amount
+// to the receiver. Bridge transfers are authorized by their route instead of the quorum and share
+// the rest of the mint rules through it.
+func (k Keeper) validateMinterMint(ctx sdk.Context, msg *types.MsgMint) (types.CheckReason, error) {
+	minter, _ := k.GetMinters(ctx, msg.From)
+
+	_, found := k.GetBlacklisted(ctx, msg.From)
+	if found {
+		return types.CheckReasonSenderBlacklisted, sdkerrors.Wrapf(types.ErrBlacklistedSender, "minter address is blacklisted")
+	}
+
+	_, found = k.GetBlacklisted(ctx, msg.Address)
+	if found {
+		return types.CheckReasonReceiverBlacklisted, sdkerrors.Wrapf(types.ErrBlacklistedRecipient, "receiver address is blacklisted")
+	}
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if msg.Amount.Denom != mintingDenom.Denom {
+		return types.CheckReasonInvalidDenom, sdkerrors.Wrapf(types.ErrWrongDenom, "minting denom is incorrect")
+	}
+
+	if minter.Allowance.IsLT(msg.Amount) {
+		return types.CheckReasonAllowanceExceeded, sdkerrors.Wrapf(types.ErrAllowanceExceeded, "minting amount is greater than the allowance")
+	}
+
+	paused := k.GetPaused(ctx)
+
+	if paused.Paused {
+		return types.CheckReasonPaused, sdkerrors.Wrapf(types.ErrPaused, "minting is paused")
+	}
+
+	if err := k.ValidateSupplyCap(ctx, msg.Amount); err != nil {
+		return types.CheckReasonSupplyCapExceeded, err
+	}
+
+	if err := k.ValidateReserves(ctx, msg.Amount); err != nil {
+		return types.CheckReasonReservesExceeded, err
+	}
+
+	return types.CheckReasonOk, nil
+}
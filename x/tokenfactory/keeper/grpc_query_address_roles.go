@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AddressRoles(c context.Context, req *types.QueryAddressRolesRequest) (*types.QueryAddressRolesResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAddressRolesResponse{
+		Controllers: []string{},
+	}
+
+	if owner, found := k.GetOwner(ctx); found {
+		res.Owner = owner.Address == req.Address
+	}
+	if masterMinter, found := k.GetMasterMinter(ctx); found {
+		res.MasterMinter = masterMinter.Address == req.Address
+	}
+	if pauser, found := k.GetPauser(ctx); found {
+		res.Pauser = pauser.Address == req.Address
+	}
+	if blacklister, found := k.GetBlacklister(ctx); found {
+		res.Blacklister = blacklister.Address == req.Address
+	}
+	if attester, found := k.GetAttester(ctx); found {
+		res.Attester = attester.Address == req.Address
+	}
+	if quorum, found := k.GetQuorum(ctx); found {
+		res.QuorumMember = quorum.IsMember(req.Address)
+	}
+	_, res.Guardian = k.GetGuardian(ctx, req.Address)
+	_, res.Blacklisted = k.GetBlacklisted(ctx, req.Address)
+
+	if minter, found := k.GetMinters(ctx, req.Address); found {
+		res.Minter = true
+		res.Allowance = &minter.Allowance
+	}
+
+	for _, minterController := range k.GetMinterControllersByMinter(ctx, req.Address) {
+		res.Controllers = append(res.Controllers, minterController.Controller)
+	}
+
+	if minterController, found := k.GetMinterController(ctx, req.Address); found {
+		res.ControlledMinter = minterController.Minter
+	}
+
+	return res, nil
+}
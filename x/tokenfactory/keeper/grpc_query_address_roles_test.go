@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestAddressRolesQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	address := sample.AccAddress()
+	controller := sample.AccAddress()
+	allowance := sdk.NewInt64Coin("uusdc", 100)
+
+	keeper.SetOwner(ctx, types.Owner{Address: address})
+	keeper.SetPauser(ctx, types.Pauser{Address: sample.AccAddress()})
+	keeper.SetGuardian(ctx, types.Guardian{Address: address})
+	keeper.SetMinters(ctx, types.Minters{Address: address, Allowance: allowance})
+	keeper.SetMinterController(ctx, types.MinterController{Minter: address, Controller: controller})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryAddressRolesRequest
+		response *types.QueryAddressRolesResponse
+		err      error
+	}{
+		{
+			desc:    "Minter",
+			request: &types.QueryAddressRolesRequest{Address: address},
+			response: &types.QueryAddressRolesResponse{
+				Owner:       true,
+				Guardian:    true,
+				Minter:      true,
+				Allowance:   &allowance,
+				Controllers: []string{controller},
+			},
+		},
+		{
+			desc:    "Controller",
+			request: &types.QueryAddressRolesRequest{Address: controller},
+			response: &types.QueryAddressRolesResponse{
+				Controllers:      []string{},
+				ControlledMinter: address,
+			},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AddressRoles(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
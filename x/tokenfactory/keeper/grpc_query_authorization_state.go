@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AuthorizationStateAll(c context.Context, req *types.QueryAllAuthorizationStateRequest) (*types.QueryAllAuthorizationStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var authorizationStates []types.AuthorizationState
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	authorizationStateStore := prefix.NewStore(store, types.KeyPrefix(types.AuthorizationStateKeyPrefix))
+
+	pageRes, err := query.Paginate(authorizationStateStore, req.Pagination, func(key []byte, value []byte) error {
+		var authorizationState types.AuthorizationState
+		if err := k.cdc.Unmarshal(value, &authorizationState); err != nil {
+			return err
+		}
+
+		authorizationStates = append(authorizationStates, authorizationState)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAuthorizationStateResponse{AuthorizationState: authorizationStates, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AuthorizationState(c context.Context, req *types.QueryGetAuthorizationStateRequest) (*types.QueryGetAuthorizationStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAuthorizationState(
+		ctx,
+		req.Authorizer,
+		req.Nonce,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAuthorizationStateResponse{AuthorizationState: val}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BlacklistSyncChannelAll(c context.Context, req *types.QueryAllBlacklistSyncChannelRequest) (*types.QueryAllBlacklistSyncChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var blacklistSyncChannels []types.BlacklistSyncChannel
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	blacklistSyncChannelStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistSyncChannelKeyPrefix))
+
+	pageRes, err := query.Paginate(blacklistSyncChannelStore, req.Pagination, func(key []byte, value []byte) error {
+		var blacklistSyncChannel types.BlacklistSyncChannel
+		if err := k.cdc.Unmarshal(value, &blacklistSyncChannel); err != nil {
+			return err
+		}
+
+		blacklistSyncChannels = append(blacklistSyncChannels, blacklistSyncChannel)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBlacklistSyncChannelResponse{BlacklistSyncChannel: blacklistSyncChannels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BlacklistSyncChannel(c context.Context, req *types.QueryGetBlacklistSyncChannelRequest) (*types.QueryGetBlacklistSyncChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetBlacklistSyncChannel(
+		ctx,
+		req.ChannelId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetBlacklistSyncChannelResponse{BlacklistSyncChannel: val}, nil
+}
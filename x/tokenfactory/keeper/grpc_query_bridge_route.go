@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BridgeRouteAll(c context.Context, req *types.QueryAllBridgeRouteRequest) (*types.QueryAllBridgeRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bridgeRoutes []types.BridgeRoute
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	bridgeRouteStore := prefix.NewStore(store, types.KeyPrefix(types.BridgeRouteKeyPrefix))
+
+	pageRes, err := query.Paginate(bridgeRouteStore, req.Pagination, func(key []byte, value []byte) error {
+		var bridgeRoute types.BridgeRoute
+		if err := k.cdc.Unmarshal(value, &bridgeRoute); err != nil {
+			return err
+		}
+
+		bridgeRoutes = append(bridgeRoutes, bridgeRoute)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBridgeRouteResponse{BridgeRoute: bridgeRoutes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BridgeRoute(c context.Context, req *types.QueryGetBridgeRouteRequest) (*types.QueryGetBridgeRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetBridgeRoute(
+		ctx,
+		req.ChannelId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetBridgeRouteResponse{BridgeRoute: val}, nil
+}
+
+func (k Keeper) BridgeMinter(c context.Context, req *types.QueryBridgeMinterRequest) (*types.QueryBridgeMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryBridgeMinterResponse{Address: types.BridgeMinterAddress().String()}, nil
+}
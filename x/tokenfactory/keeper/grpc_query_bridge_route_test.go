@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestBridgeMinterQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	response, err := keeper.BridgeMinter(wctx, &types.QueryBridgeMinterRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeMinterAddress().String(), response.Address)
+
+	_, err = keeper.BridgeMinter(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
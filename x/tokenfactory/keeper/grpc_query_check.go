@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CheckTransfer(c context.Context, req *types.QueryCheckTransferRequest) (*types.QueryCheckTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var reason types.CheckReason
+	if req.Path == types.TransferPathIbc {
+		reason, err = k.ValidateIBCTransfer(ctx, req.From, req.To, amount, req.ChannelId)
+	} else {
+		reason, err = k.ValidateTransfer(ctx, req.From, req.To, amount, req.Path)
+	}
+
+	return &types.QueryCheckTransferResponse{
+		Allowed: err == nil,
+		Reason:  reason,
+		Message: errorMessage(err),
+	}, nil
+}
+
+func (k Keeper) CheckMint(c context.Context, req *types.QueryCheckMintRequest) (*types.QueryCheckMintResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reason, err := k.ValidateMint(ctx, &types.MsgMint{
+		From:    req.Minter,
+		Address: req.To,
+		Amount:  amount,
+	})
+
+	return &types.QueryCheckMintResponse{
+		Allowed: err == nil,
+		Reason:  reason,
+		Message: errorMessage(err),
+	}, nil
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestCheckTransferQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	from := sample.AccAddress()
+	blacklisted := sample.AccAddress()
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Address: blacklisted})
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryCheckTransferRequest
+		paused  bool
+		reason  types.CheckReason
+		err     error
+	}{
+		{
+			desc:    "Allowed",
+			request: &types.QueryCheckTransferRequest{From: from, To: sample.AccAddress(), Amount: "10uusdc", Path: types.TransferPathIbc},
+			reason:  types.CheckReasonOk,
+		},
+		{
+			desc:    "ReceiverBlacklisted",
+			request: &types.QueryCheckTransferRequest{From: from, To: blacklisted, Amount: "10uusdc", Path: types.TransferPathIbc},
+			reason:  types.CheckReasonReceiverBlacklisted,
+		},
+		{
+			desc:    "SenderBlacklisted",
+			request: &types.QueryCheckTransferRequest{From: blacklisted, To: from, Amount: "10uatom", Path: types.TransferPathIbc},
+			reason:  types.CheckReasonSenderBlacklisted,
+		},
+		{
+			desc:    "Paused",
+			request: &types.QueryCheckTransferRequest{From: from, To: sample.AccAddress(), Amount: "10uatom"},
+			paused:  true,
+			reason:  types.CheckReasonPaused,
+		},
+		{
+			desc:    "InvalidAmount",
+			request: &types.QueryCheckTransferRequest{From: from, To: from, Amount: "uusdc"},
+			err:     status.Error(codes.InvalidArgument, "invalid decimal coin expression: uusdc"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper.SetPaused(ctx, types.Paused{Paused: tc.paused})
+			response, err := keeper.CheckTransfer(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.reason, response.Reason)
+				require.Equal(t, tc.reason == types.CheckReasonOk, response.Allowed)
+			}
+		})
+	}
+}
+
+func TestCheckMintQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	minter := sample.AccAddress()
+	blacklisted := sample.AccAddress()
+	keeper.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin("uusdc", 100)})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Address: blacklisted})
+	keeper.SetQuorum(ctx, types.Quorum{
+		Members:       []string{sample.AccAddress()},
+		Threshold:     1,
+		MintThreshold: &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(50)},
+	})
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryCheckMintRequest
+		reason  types.CheckReason
+	}{
+		{
+			desc:    "NotMinter",
+			request: &types.QueryCheckMintRequest{Minter: sample.AccAddress(), To: minter, Amount: "10uusdc"},
+			reason:  types.CheckReasonNotMinter,
+		},
+		{
+			desc:    "QuorumRequired",
+			request: &types.QueryCheckMintRequest{Minter: minter, To: minter, Amount: "60uusdc"},
+			reason:  types.CheckReasonQuorumRequired,
+		},
+		{
+			desc:    "ReceiverBlacklisted",
+			request: &types.QueryCheckMintRequest{Minter: minter, To: blacklisted, Amount: "10uusdc"},
+			reason:  types.CheckReasonReceiverBlacklisted,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.CheckMint(wctx, tc.request)
+			require.NoError(t, err)
+			require.False(t, response.Allowed)
+			require.Equal(t, tc.reason, response.Reason)
+			require.NotEmpty(t, response.Message)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GuardianAll(c context.Context, req *types.QueryAllGuardianRequest) (*types.QueryAllGuardianResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var guardians []types.Guardian
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	guardianStore := prefix.NewStore(store, types.KeyPrefix(types.GuardianKeyPrefix))
+
+	pageRes, err := query.Paginate(guardianStore, req.Pagination, func(key []byte, value []byte) error {
+		var guardian types.Guardian
+		if err := k.cdc.Unmarshal(value, &guardian); err != nil {
+			return err
+		}
+
+		guardians = append(guardians, guardian)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGuardianResponse{Guardian: guardians, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Guardian(c context.Context, req *types.QueryGetGuardianRequest) (*types.QueryGetGuardianResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetGuardian(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetGuardianResponse{Guardian: val}, nil
+}
@@ -56,3 +56,17 @@ func (k Keeper) MinterController(c context.Context, req *types.QueryGetMinterCon
 
 	return &types.QueryGetMinterControllerResponse{MinterController: val}, nil
 }
+
+func (k Keeper) MinterControllerByMinter(c context.Context, req *types.QueryMinterControllerByMinterRequest) (*types.QueryMinterControllerByMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	minterControllers := k.GetMinterControllersByMinter(ctx, req.MinterAddress)
+	if len(minterControllers) == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMinterControllerByMinterResponse{MinterController: minterControllers}, nil
+}
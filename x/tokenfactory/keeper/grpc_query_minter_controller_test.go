@@ -31,14 +31,14 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetMinterControllerRequest{
-				ControllerAddress: msgs[0].Minter,
+				ControllerAddress: msgs[0].Controller,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[0]},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetMinterControllerRequest{
-				ControllerAddress: msgs[1].Minter,
+				ControllerAddress: msgs[1].Controller,
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[1]},
 		},
@@ -124,3 +124,42 @@ func TestMinterControllerQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestMinterControllerByMinterQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	item := types.MinterController{Minter: "minter", Controller: "controller"}
+	keeper.SetMinterController(ctx, item)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryMinterControllerByMinterRequest
+		response *types.QueryMinterControllerByMinterResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryMinterControllerByMinterRequest{MinterAddress: item.Minter},
+			response: &types.QueryMinterControllerByMinterResponse{MinterController: []types.MinterController{item}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryMinterControllerByMinterRequest{MinterAddress: item.Controller},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MinterControllerByMinter(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MinterStatsAll(c context.Context, req *types.QueryAllMinterStatsRequest) (*types.QueryAllMinterStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var minterStatsList []types.MinterStats
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	minterStatsStore := prefix.NewStore(store, types.KeyPrefix(types.MinterStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(minterStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var minterStats types.MinterStats
+		if err := k.cdc.Unmarshal(value, &minterStats); err != nil {
+			return err
+		}
+
+		minterStatsList = append(minterStatsList, minterStats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMinterStatsResponse{MinterStats: minterStatsList, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MinterStats(c context.Context, req *types.QueryGetMinterStatsRequest) (*types.QueryGetMinterStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetMinterStats(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMinterStatsResponse{MinterStats: val}, nil
+}
+
+func (k Keeper) MintingTotals(c context.Context, req *types.QueryGetMintingTotalsRequest) (*types.QueryGetMintingTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetMintingTotals(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMintingTotalsResponse{MintingTotals: val}, nil
+}
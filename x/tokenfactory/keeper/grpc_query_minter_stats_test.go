@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestMinterStatsQuery(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := keeper.MintingTotals(wctx, &types.QueryGetMintingTotalsRequest{})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	keeper.RecordMint(ctx, "0", sdk.NewInt64Coin("uusdc", 100))
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMinterStatsRequest
+		response *types.QueryGetMinterStatsResponse
+		err      error
+	}{
+		{
+			desc:    "Found",
+			request: &types.QueryGetMinterStatsRequest{Address: "0"},
+			response: &types.QueryGetMinterStatsResponse{MinterStats: types.MinterStats{
+				Address:   "0",
+				Minted:    sdk.NewInt64Coin("uusdc", 100),
+				Burned:    sdk.NewInt64Coin("uusdc", 0),
+				MintCount: 1,
+			}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetMinterStatsRequest{Address: "1"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.MinterStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+
+	totals, err := keeper.MintingTotals(wctx, &types.QueryGetMintingTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), totals.MintingTotals.MintCount)
+}
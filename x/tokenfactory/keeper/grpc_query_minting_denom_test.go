@@ -14,9 +14,9 @@ import (
 )
 
 func TestMintingDenomQuery(t *testing.T) {
-	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	keeper, bankKeeper, ctx := keepertest.TokenfactoryKeeperWithBank(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	item := createTestMintingDenom(keeper, ctx)
+	item := createTestMintingDenom(keeper, bankKeeper, ctx)
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetMintingDenomRequest
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingOperationAll(c context.Context, req *types.QueryAllPendingOperationRequest) (*types.QueryAllPendingOperationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingOperations []types.PendingOperation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingOperationStore := prefix.NewStore(store, types.KeyPrefix(types.PendingOperationKey))
+
+	pageRes, err := query.Paginate(pendingOperationStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingOperation types.PendingOperation
+		if err := k.cdc.Unmarshal(value, &pendingOperation); err != nil {
+			return err
+		}
+
+		pendingOperations = append(pendingOperations, pendingOperation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingOperationResponse{PendingOperation: pendingOperations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingOperation(c context.Context, req *types.QueryGetPendingOperationRequest) (*types.QueryGetPendingOperationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingOperation, found := k.GetPendingOperation(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingOperationResponse{PendingOperation: pendingOperation}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RateLimitAll(c context.Context, req *types.QueryAllRateLimitRequest) (*types.QueryAllRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.RateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	rateLimitStore := prefix.NewStore(store, types.KeyPrefix(types.RateLimitKeyPrefix))
+
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(key []byte, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRateLimitResponse{RateLimit: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RateLimit(c context.Context, req *types.QueryGetRateLimitRequest) (*types.QueryGetRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, quota, found := k.RateLimitUsage(
+		ctx,
+		req.ChannelId,
+		req.Direction,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	denom := k.GetMintingDenom(ctx).Denom
+
+	remaining := sdk.ZeroInt()
+	if val.Flow.LT(quota) {
+		remaining = quota.Sub(val.Flow)
+	}
+
+	return &types.QueryGetRateLimitResponse{
+		RateLimit: val,
+		Quota:     sdk.NewCoin(denom, quota),
+		Flow:      sdk.NewCoin(denom, val.Flow),
+		Remaining: sdk.NewCoin(denom, remaining),
+	}, nil
+}
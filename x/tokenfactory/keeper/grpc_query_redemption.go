@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RedemptionAll(c context.Context, req *types.QueryAllRedemptionRequest) (*types.QueryAllRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var redemptions []types.Redemption
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	redemptionStore := prefix.NewStore(store, types.KeyPrefix(types.RedemptionKey))
+
+	pageRes, err := query.Paginate(redemptionStore, req.Pagination, func(key []byte, value []byte) error {
+		var redemption types.Redemption
+		if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+			return err
+		}
+
+		redemptions = append(redemptions, redemption)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRedemptionResponse{Redemption: redemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Redemption(c context.Context, req *types.QueryGetRedemptionRequest) (*types.QueryGetRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	redemption, found := k.GetRedemption(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRedemptionResponse{Redemption: redemption}, nil
+}
+
+func (k Keeper) RedemptionsByStatus(c context.Context, req *types.QueryRedemptionsByStatusRequest) (*types.QueryRedemptionsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	redemptions, pageRes, err := k.filterRedemptions(sdk.UnwrapSDKContext(c), req.Pagination, func(redemption types.Redemption) bool {
+		return redemption.Status == req.Status
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionsByStatusResponse{Redemption: redemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RedemptionsByHolder(c context.Context, req *types.QueryRedemptionsByHolderRequest) (*types.QueryRedemptionsByHolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	redemptions, pageRes, err := k.filterRedemptions(sdk.UnwrapSDKContext(c), req.Pagination, func(redemption types.Redemption) bool {
+		return redemption.Holder == req.Holder
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionsByHolderResponse{Redemption: redemptions, Pagination: pageRes}, nil
+}
+
+// filterRedemptions paginates over the redemptions that satisfy the given predicate
+func (k Keeper) filterRedemptions(ctx sdk.Context, pageReq *query.PageRequest, match func(types.Redemption) bool) ([]types.Redemption, *query.PageResponse, error) {
+	var redemptions []types.Redemption
+
+	store := ctx.KVStore(k.storeKey)
+	redemptionStore := prefix.NewStore(store, types.KeyPrefix(types.RedemptionKey))
+
+	pageRes, err := query.FilteredPaginate(redemptionStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var redemption types.Redemption
+		if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+			return false, err
+		}
+
+		if !match(redemption) {
+			return false, nil
+		}
+
+		if accumulate {
+			redemptions = append(redemptions, redemption)
+		}
+		return true, nil
+	})
+
+	return redemptions, pageRes, err
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestRedemptionQueryByStatus(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRedemption(keeper, ctx, 9)
+
+	for _, s := range []types.RedemptionStatus{types.RedemptionPending, types.RedemptionFulfilled, types.RedemptionRejected} {
+		var expected []types.Redemption
+		for _, msg := range msgs {
+			if msg.Status == s {
+				expected = append(expected, msg)
+			}
+		}
+
+		resp, err := keeper.RedemptionsByStatus(wctx, &types.QueryRedemptionsByStatusRequest{
+			Status:     s,
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(expected), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(expected),
+			nullify.Fill(resp.Redemption),
+		)
+	}
+
+	_, err := keeper.RedemptionsByStatus(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestRedemptionQueryByHolder(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRedemption(keeper, ctx, 5)
+
+	resp, err := keeper.RedemptionsByHolder(wctx, &types.QueryRedemptionsByHolderRequest{
+		Holder: msgs[3].Holder,
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill([]types.Redemption{msgs[3]}),
+		nullify.Fill(resp.Redemption),
+	)
+
+	_, err = keeper.RedemptionsByHolder(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
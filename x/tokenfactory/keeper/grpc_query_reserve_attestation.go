@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ReserveAttestationAll(c context.Context, req *types.QueryAllReserveAttestationRequest) (*types.QueryAllReserveAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var reserveAttestations []types.ReserveAttestation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	reserveAttestationStore := prefix.NewStore(store, types.KeyPrefix(types.ReserveAttestationKey))
+
+	pageRes, err := query.Paginate(reserveAttestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var reserveAttestation types.ReserveAttestation
+		if err := k.cdc.Unmarshal(value, &reserveAttestation); err != nil {
+			return err
+		}
+
+		reserveAttestations = append(reserveAttestations, reserveAttestation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReserveAttestationResponse{ReserveAttestation: reserveAttestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) LatestReserveAttestation(c context.Context, req *types.QueryLatestReserveAttestationRequest) (*types.QueryLatestReserveAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetLatestReserveAttestation(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryLatestReserveAttestationResponse{ReserveAttestation: val}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RoleChangeAll(c context.Context, req *types.QueryAllRoleChangeRequest) (*types.QueryAllRoleChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var roleChanges []types.RoleChange
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	roleChangeStore := prefix.NewStore(store, types.KeyPrefix(types.RoleChangeKey))
+
+	pageRes, err := query.Paginate(roleChangeStore, req.Pagination, func(key []byte, value []byte) error {
+		var roleChange types.RoleChange
+		if err := k.cdc.Unmarshal(value, &roleChange); err != nil {
+			return err
+		}
+
+		roleChanges = append(roleChanges, roleChange)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRoleChangeResponse{RoleChange: roleChanges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RoleChange(c context.Context, req *types.QueryGetRoleChangeRequest) (*types.QueryGetRoleChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	roleChange, found := k.GetRoleChange(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRoleChangeResponse{RoleChange: roleChange}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SupplyCap(c context.Context, req *types.QueryGetSupplyCapRequest) (*types.QueryGetSupplyCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetSupplyCap(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, val.Amount.Denom)
+
+	headroom := sdk.NewCoin(val.Amount.Denom, sdk.ZeroInt())
+	if supply.IsLT(val.Amount) {
+		headroom = val.Amount.Sub(supply)
+	}
+
+	return &types.QueryGetSupplyCapResponse{
+		SupplyCap: val,
+		Supply:    supply,
+		Headroom:  headroom,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetGuardian set a specific guardian in the store from its index
+func (k Keeper) SetGuardian(ctx sdk.Context, guardian types.Guardian) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianKeyPrefix))
+	b := k.cdc.MustMarshal(&guardian)
+	store.Set(types.GuardianKey(
+		guardian.Address,
+	), b)
+}
+
+// GetGuardian returns a guardian from its index
+func (k Keeper) GetGuardian(
+	ctx sdk.Context,
+	address string,
+
+) (val types.Guardian, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianKeyPrefix))
+
+	b := store.Get(types.GuardianKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGuardian removes a guardian from the store
+func (k Keeper) RemoveGuardian(
+	ctx sdk.Context,
+	address string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianKeyPrefix))
+	store.Delete(types.GuardianKey(
+		address,
+	))
+}
+
+// GetAllGuardian returns all guardian
+func (k Keeper) GetAllGuardian(ctx sdk.Context) (list []types.Guardian) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Guardian
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNGuardian(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Guardian {
+	items := make([]types.Guardian, n)
+	for i := range items {
+		items[i].Address = strconv.Itoa(i)
+
+		keeper.SetGuardian(ctx, items[i])
+	}
+	return items
+}
+
+func TestGuardianGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNGuardian(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetGuardian(ctx,
+			item.Address,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestGuardianRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNGuardian(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveGuardian(ctx,
+			item.Address,
+		)
+		_, found := keeper.GetGuardian(ctx,
+			item.Address,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestGuardianGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNGuardian(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllGuardian(ctx)),
+	)
+}
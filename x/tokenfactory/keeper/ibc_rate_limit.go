@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateRateLimit sets the quotas of the rate limit of a channel and direction. The flow of an
+// existing rate limit is kept so that updating the quotas does not reset the current window.
+func (k Keeper) UpdateRateLimit(
+	ctx sdk.Context,
+	channelId string,
+	direction types.RateLimitDirection,
+	maxAmount sdk.Int,
+	maxSupplyFraction sdk.Dec,
+	window time.Duration,
+	actor string,
+) error {
+	rateLimit := types.RateLimit{
+		ChannelId:         channelId,
+		Direction:         direction,
+		MaxAmount:         maxAmount,
+		MaxSupplyFraction: maxSupplyFraction,
+		Window:            window,
+		Flow:              sdk.ZeroInt(),
+		WindowStart:       ctx.BlockTime(),
+	}
+
+	var previous *types.RateLimit
+	if existing, found := k.GetRateLimit(ctx, channelId, direction); found {
+		previous = &existing
+		rateLimit.Flow = existing.Flow
+		rateLimit.WindowStart = existing.WindowStart
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateLimitChanged{
+		ChannelId: channelId,
+		Direction: direction,
+		Previous:  previous,
+		Current:   &rateLimit,
+		Actor:     actor,
+	})
+}
+
+// DeleteRateLimit removes the rate limit of a channel and direction.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelId string, direction types.RateLimitDirection, actor string) error {
+	existing, found := k.GetRateLimit(ctx, channelId, direction)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "no %s rate limit on %s", direction, channelId)
+	}
+
+	k.RemoveRateLimit(ctx, channelId, direction)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateLimitChanged{
+		ChannelId: channelId,
+		Direction: direction,
+		Previous:  &existing,
+		Actor:     actor,
+	})
+}
+
+// currentWindow returns the rate limit with its flow reset if its window has elapsed.
+func currentWindow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if rateLimit.Flow.IsNil() || !ctx.BlockTime().Before(rateLimit.WindowStart.Add(rateLimit.Window)) {
+		rateLimit.Flow = sdk.ZeroInt()
+		rateLimit.WindowStart = ctx.BlockTime()
+	}
+	return rateLimit
+}
+
+// RateLimitQuota returns the amount of the minting denom that can move through the channel during
+// a window, the lower of the absolute quota and the share of the current supply.
+func (k Keeper) RateLimitQuota(ctx sdk.Context, rateLimit types.RateLimit) sdk.Int {
+	var quota sdk.Int
+	if rateLimit.MaxAmount.IsPositive() {
+		quota = rateLimit.MaxAmount
+	}
+
+	if rateLimit.MaxSupplyFraction.IsPositive() {
+		supply := k.bankKeeper.GetSupply(ctx, k.GetMintingDenom(ctx).Denom).Amount
+		supplyQuota := rateLimit.MaxSupplyFraction.MulInt(supply).TruncateInt()
+		if quota.IsNil() || supplyQuota.LT(quota) {
+			quota = supplyQuota
+		}
+	}
+
+	if quota.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return quota
+}
+
+// RateLimitUsage returns the rate limit of a channel and direction rolled to the current window
+// along with the quota of the window.
+func (k Keeper) RateLimitUsage(ctx sdk.Context, channelId string, direction types.RateLimitDirection) (rateLimit types.RateLimit, quota sdk.Int, found bool) {
+	rateLimit, found = k.GetRateLimit(ctx, channelId, direction)
+	if !found {
+		return rateLimit, sdk.ZeroInt(), false
+	}
+
+	rateLimit = currentWindow(ctx, rateLimit)
+	return rateLimit, k.RateLimitQuota(ctx, rateLimit), true
+}
+
+// ValidateRateLimit returns an error if amount would exceed the quota left in the current window of
+// the channel and direction. Channels without a rate limit are not restricted.
+func (k Keeper) ValidateRateLimit(ctx sdk.Context, channelId string, direction types.RateLimitDirection, amount sdk.Int) (types.CheckReason, error) {
+	rateLimit, quota, found := k.RateLimitUsage(ctx, channelId, direction)
+	if !found {
+		return types.CheckReasonOk, nil
+	}
+
+	if rateLimit.Flow.Add(amount).GT(quota) {
+		return types.CheckReasonRateLimitExceeded, sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s of %s on %s would exceed the quota of %s, %s already used",
+			direction, amount, channelId, quota, rateLimit.Flow,
+		)
+	}
+
+	return types.CheckReasonOk, nil
+}
+
+// ConsumeRateLimit adds amount to the flow of the channel and direction, returning an error if it
+// would exceed the quota of the current window. Channels without a rate limit are not restricted.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, channelId string, direction types.RateLimitDirection, amount sdk.Int) (types.CheckReason, error) {
+	if reason, err := k.ValidateRateLimit(ctx, channelId, direction, amount); err != nil {
+		return reason, err
+	}
+
+	rateLimit, _, found := k.RateLimitUsage(ctx, channelId, direction)
+	if !found {
+		return types.CheckReasonOk, nil
+	}
+
+	rateLimit.Flow = rateLimit.Flow.Add(amount)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return types.CheckReasonOk, nil
+}
+
+// RefundRateLimit removes amount from the flow of the channel and direction once a transfer that
+// was counted during the window starting at windowStart is refunded. Refunds of transfers counted
+// in a window that has since elapsed are dropped, since that flow is no longer part of the current
+// window, and the flow never goes below zero.
+func (k Keeper) RefundRateLimit(ctx sdk.Context, channelId string, direction types.RateLimitDirection, windowStart time.Time, amount sdk.Int) {
+	rateLimit, found := k.GetRateLimit(ctx, channelId, direction)
+	if !found {
+		return
+	}
+
+	rateLimit = currentWindow(ctx, rateLimit)
+	if !rateLimit.WindowStart.Equal(windowStart) {
+		return
+	}
+
+	if amount.GT(rateLimit.Flow) {
+		rateLimit.Flow = sdk.ZeroInt()
+	} else {
+		rateLimit.Flow = rateLimit.Flow.Sub(amount)
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+}
+
+// SetRateLimitPacketWindow records the start of the window that counted a sent packet against the
+// outflow of its source channel so that a refund of the packet is only credited to that window.
+func (k Keeper) SetRateLimitPacketWindow(ctx sdk.Context, channelId string, sequence uint64, windowStart time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitPacketKeyPrefix))
+	store.Set(types.RateLimitPacketKey(channelId, sequence), sdk.FormatTimeBytes(windowStart))
+}
+
+// GetRateLimitPacketWindow returns the start of the window that counted a sent packet.
+func (k Keeper) GetRateLimitPacketWindow(ctx sdk.Context, channelId string, sequence uint64) (windowStart time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitPacketKeyPrefix))
+
+	b := store.Get(types.RateLimitPacketKey(channelId, sequence))
+	if b == nil {
+		return windowStart, false
+	}
+
+	windowStart, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return windowStart, true
+}
+
+// RemoveRateLimitPacketWindow removes the window of a sent packet once it is acknowledged or timed out.
+func (k Keeper) RemoveRateLimitPacketWindow(ctx sdk.Context, channelId string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitPacketKeyPrefix))
+	store.Delete(types.RateLimitPacketKey(channelId, sequence))
+}
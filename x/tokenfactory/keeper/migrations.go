@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by setting the params, which version 1 did not have, to
+// their defaults and backfilling the minter to controller index and the number of blacklisted addresses.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	m.keeper.RebuildMinterControllerIndex(ctx)
+	m.keeper.SetBlacklistedCount(ctx, uint64(len(m.keeper.GetAllBlacklisted(ctx))))
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3 by binding the blacklist sync port.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetPort(ctx, types.PortID)
+	if !m.keeper.IsBound(ctx, types.PortID) {
+		return m.keeper.BindPort(ctx, types.PortID)
+	}
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4 by binding the bridge port.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.SetBridgePort(ctx, types.BridgePortID)
+	if !m.keeper.IsBound(ctx, types.BridgePortID) {
+		return m.keeper.BindPort(ctx, types.BridgePortID)
+	}
+	return nil
+}
@@ -8,12 +8,19 @@ import (
 )
 
 // SetMinterController set a specific minterController in the store from its index
+// and keeps the minter to controller index in sync
 func (k Keeper) SetMinterController(ctx sdk.Context, minterController types.MinterController) {
+	if existing, found := k.GetMinterController(ctx, minterController.Controller); found {
+		k.removeMinterControllerIndex(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	b := k.cdc.MustMarshal(&minterController)
 	store.Set(types.MinterControllerKey(
 		minterController.Controller,
 	), b)
+
+	k.setMinterControllerIndex(ctx, minterController)
 }
 
 // GetMinterController returns a minterController from its index
@@ -41,12 +48,56 @@ func (k Keeper) DeleteMinterController(
 	controller string,
 
 ) {
+	if existing, found := k.GetMinterController(ctx, controller); found {
+		k.removeMinterControllerIndex(ctx, existing)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
 	store.Delete(types.MinterControllerKey(
 		controller,
 	))
 }
 
+// GetMinterControllersByMinter returns all minterController of a minter using the minter to controller index
+func (k Keeper) GetMinterControllersByMinter(ctx sdk.Context, minter string) (list []types.MinterController) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MintersKey(minter))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.MinterController{
+			Minter:     minter,
+			Controller: string(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+// RebuildMinterControllerIndex populates the minter to controller index from the stored minterController
+func (k Keeper) RebuildMinterControllerIndex(ctx sdk.Context) {
+	for _, minterController := range k.GetAllMinterControllers(ctx) {
+		k.setMinterControllerIndex(ctx, minterController)
+	}
+}
+
+func (k Keeper) setMinterControllerIndex(ctx sdk.Context, minterController types.MinterController) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	store.Set(types.MinterControllerByMinterKey(
+		minterController.Minter,
+		minterController.Controller,
+	), []byte(minterController.Controller))
+}
+
+func (k Keeper) removeMinterControllerIndex(ctx sdk.Context, minterController types.MinterController) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerByMinterKeyPrefix))
+	store.Delete(types.MinterControllerByMinterKey(
+		minterController.Minter,
+		minterController.Controller,
+	))
+}
+
 // GetAllMinterController returns all minterController
 func (k Keeper) GetAllMinterControllers(ctx sdk.Context) (list []types.MinterController) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterControllerKeyPrefix))
@@ -19,6 +19,7 @@ var _ = strconv.IntSize
 func createNMinterController(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MinterController {
 	items := make([]types.MinterController, n)
 	for i := range items {
+		items[i].Controller = strconv.Itoa(i)
 		items[i].Minter = strconv.Itoa(i)
 
 		keeper.SetMinterController(ctx, items[i])
@@ -31,7 +32,7 @@ func TestMinterControllerGet(t *testing.T) {
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
 		rst, found := keeper.GetMinterController(ctx,
-			item.Minter,
+			item.Controller,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -45,10 +46,10 @@ func TestMinterControllerRemove(t *testing.T) {
 	items := createNMinterController(keeper, ctx, 10)
 	for _, item := range items {
 		keeper.DeleteMinterController(ctx,
-			item.Minter,
+			item.Controller,
 		)
 		_, found := keeper.GetMinterController(ctx,
-			item.Minter,
+			item.Controller,
 		)
 		require.False(t, found)
 	}
@@ -62,3 +63,23 @@ func TestMinterControllerGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllMinterControllers(ctx)),
 	)
 }
+
+func TestMinterControllerByMinterIndex(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+
+	keeper.SetMinterController(ctx, types.MinterController{Minter: "minter", Controller: "0"})
+	keeper.SetMinterController(ctx, types.MinterController{Minter: "minter", Controller: "1"})
+	keeper.SetMinterController(ctx, types.MinterController{Minter: "other", Controller: "2"})
+	require.Len(t, keeper.GetMinterControllersByMinter(ctx, "minter"), 2)
+
+	// reassigning a controller moves it to the new minter
+	keeper.SetMinterController(ctx, types.MinterController{Minter: "other", Controller: "1"})
+	require.Equal(t,
+		[]types.MinterController{{Minter: "minter", Controller: "0"}},
+		keeper.GetMinterControllersByMinter(ctx, "minter"),
+	)
+	require.Len(t, keeper.GetMinterControllersByMinter(ctx, "other"), 2)
+
+	keeper.DeleteMinterController(ctx, "0")
+	require.Empty(t, keeper.GetMinterControllersByMinter(ctx, "minter"))
+}
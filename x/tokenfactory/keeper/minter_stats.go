@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetMinterStats set a specific minterStats in the store from its index
+func (k Keeper) SetMinterStats(ctx sdk.Context, minterStats types.MinterStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&minterStats)
+	store.Set(types.MinterStatsKey(
+		minterStats.Address,
+	), b)
+}
+
+// GetMinterStats returns a minterStats from its index
+func (k Keeper) GetMinterStats(
+	ctx sdk.Context,
+	address string,
+
+) (val types.MinterStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterStatsKeyPrefix))
+
+	b := store.Get(types.MinterStatsKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMinterStats returns all minterStats
+func (k Keeper) GetAllMinterStats(ctx sdk.Context) (list []types.MinterStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MinterStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MinterStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetMintingTotals set mintingTotals in the store
+func (k Keeper) SetMintingTotals(ctx sdk.Context, mintingTotals types.MintingTotals) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&mintingTotals)
+	store.Set(types.KeyPrefix(types.MintingTotalsKey), b)
+}
+
+// GetMintingTotals returns mintingTotals
+func (k Keeper) GetMintingTotals(ctx sdk.Context) (val types.MintingTotals, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.MintingTotalsKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RecordMint adds a mint to the counters of the minter and to the totals and reports it to telemetry
+func (k Keeper) RecordMint(ctx sdk.Context, minter string, amount sdk.Coin) {
+	minterStats := k.getOrInitMinterStats(ctx, minter, amount.Denom)
+	minterStats.Minted = minterStats.Minted.Add(amount)
+	minterStats.MintCount++
+	minterStats.LastMintHeight = ctx.BlockHeight()
+	k.SetMinterStats(ctx, minterStats)
+
+	mintingTotals := k.getOrInitMintingTotals(ctx, amount.Denom)
+	mintingTotals.Minted = mintingTotals.Minted.Add(amount)
+	mintingTotals.MintCount++
+	mintingTotals.LastMintHeight = ctx.BlockHeight()
+	k.SetMintingTotals(ctx, mintingTotals)
+
+	incrSupplyChange(MetricKeyMint, minter, amount)
+}
+
+// RecordBurn adds a burn to the counters of the minter and to the totals and reports it to telemetry
+func (k Keeper) RecordBurn(ctx sdk.Context, minter string, amount sdk.Coin) {
+	minterStats := k.getOrInitMinterStats(ctx, minter, amount.Denom)
+	minterStats.Burned = minterStats.Burned.Add(amount)
+	k.SetMinterStats(ctx, minterStats)
+
+	mintingTotals := k.getOrInitMintingTotals(ctx, amount.Denom)
+	mintingTotals.Burned = mintingTotals.Burned.Add(amount)
+	k.SetMintingTotals(ctx, mintingTotals)
+
+	incrSupplyChange(MetricKeyBurn, minter, amount)
+}
+
+func (k Keeper) getOrInitMinterStats(ctx sdk.Context, minter string, denom string) types.MinterStats {
+	minterStats, found := k.GetMinterStats(ctx, minter)
+	if !found {
+		minterStats = types.MinterStats{
+			Address: minter,
+			Minted:  sdk.NewCoin(denom, sdk.ZeroInt()),
+			Burned:  sdk.NewCoin(denom, sdk.ZeroInt()),
+		}
+	}
+	return minterStats
+}
+
+func (k Keeper) getOrInitMintingTotals(ctx sdk.Context, denom string) types.MintingTotals {
+	mintingTotals, found := k.GetMintingTotals(ctx)
+	if !found {
+		mintingTotals = types.MintingTotals{
+			Minted: sdk.NewCoin(denom, sdk.ZeroInt()),
+			Burned: sdk.NewCoin(denom, sdk.ZeroInt()),
+		}
+	}
+	return mintingTotals
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestMinterStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := []types.MinterStats{{Address: "0"}, {Address: "1"}}
+	for _, item := range items {
+		keeper.SetMinterStats(ctx, item)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMinterStats(ctx)),
+	)
+}
+
+func TestRecordMintAndBurn(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	keeper.RecordMint(ctx, "0", sdk.NewInt64Coin("uusdc", 100))
+	keeper.RecordMint(ctx.WithBlockHeight(9), "0", sdk.NewInt64Coin("uusdc", 50))
+	keeper.RecordMint(ctx, "1", sdk.NewInt64Coin("uusdc", 10))
+	keeper.RecordBurn(ctx, "0", sdk.NewInt64Coin("uusdc", 30))
+
+	minterStats, found := keeper.GetMinterStats(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, types.MinterStats{
+		Address:        "0",
+		Minted:         sdk.NewInt64Coin("uusdc", 150),
+		Burned:         sdk.NewInt64Coin("uusdc", 30),
+		MintCount:      2,
+		LastMintHeight: 9,
+	}, minterStats)
+
+	mintingTotals, found := keeper.GetMintingTotals(ctx)
+	require.True(t, found)
+	require.Equal(t, types.MintingTotals{
+		Minted:         sdk.NewInt64Coin("uusdc", 160),
+		Burned:         sdk.NewInt64Coin("uusdc", 30),
+		MintCount:      3,
+		LastMintHeight: 7,
+	}, mintingTotals)
+}
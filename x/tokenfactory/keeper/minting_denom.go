@@ -33,6 +33,20 @@ func (k Keeper) GetMintingDenom(ctx sdk.Context) (val types.MintingDenom) {
 	return val
 }
 
+// IsMintingDenom returns true if denom is the minting denom, and false while the minting denom is not set
+func (k Keeper) IsMintingDenom(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
+
+	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
+	if b == nil {
+		return false
+	}
+
+	var val types.MintingDenom
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Denom == denom
+}
+
 // RemoveMintingDenom removes mintingDenom from the store
 func (k Keeper) RemoveMintingDenom(ctx sdk.Context) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MintingDenomKey))
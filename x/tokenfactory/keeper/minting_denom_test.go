@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
@@ -12,15 +14,16 @@ import (
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 )
 
-func createTestMintingDenom(keeper *keeper.Keeper, ctx sdk.Context) types.MintingDenom {
-	item := types.MintingDenom{}
+func createTestMintingDenom(keeper *keeper.Keeper, bankKeeper bankkeeper.Keeper, ctx sdk.Context) types.MintingDenom {
+	item := types.MintingDenom{Denom: "uusdc"}
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: item.Denom, DenomUnits: []*banktypes.DenomUnit{{Denom: item.Denom}}})
 	keeper.SetMintingDenom(ctx, item)
 	return item
 }
 
 func TestMintingDenomGet(t *testing.T) {
-	keeper, ctx := keepertest.TokenfactoryKeeper(t)
-	item := createTestMintingDenom(keeper, ctx)
+	keeper, bankKeeper, ctx := keepertest.TokenfactoryKeeperWithBank(t)
+	item := createTestMintingDenom(keeper, bankKeeper, ctx)
 	rst := keeper.GetMintingDenom(ctx)
 	require.Equal(t,
 		nullify.Fill(&item),
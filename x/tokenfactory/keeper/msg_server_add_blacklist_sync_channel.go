@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (k msgServer) AddBlacklistSyncChannel(goCtx context.Context, msg *types.MsgAddBlacklistSyncChannel) (*types.MsgAddBlacklistSyncChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// the owner approves channels once they are open, after checking their connection and
+	// counterparty, so that a channel opened by a third party is never trusted on its identifier alone
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), msg.ChannelId)
+	if !found || channel.State != channeltypes.OPEN {
+		return nil, sdkerrors.Wrapf(types.ErrSyncChannelNotOpen, "channel %s is not open on port %s", msg.ChannelId, k.GetPort(ctx))
+	}
+
+	blacklistSyncChannel := types.BlacklistSyncChannel{
+		ChannelId: msg.ChannelId,
+	}
+
+	k.SetBlacklistSyncChannel(ctx, blacklistSyncChannel)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBlacklistSyncChannelAdded{
+		ChannelId: msg.ChannelId,
+		Actor:     msg.From,
+	})
+
+	return &types.MsgAddBlacklistSyncChannelResponse{}, err
+}
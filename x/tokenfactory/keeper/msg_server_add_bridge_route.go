@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AddBridgeRoute(goCtx context.Context, msg *types.MsgAddBridgeRoute) (*types.MsgAddBridgeRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// re-adding a route keeps the supply that already moved through it
+	if _, found := k.GetBridgeRoute(ctx, msg.ChannelId); !found {
+		k.SetBridgeRoute(ctx, types.BridgeRoute{
+			ChannelId: msg.ChannelId,
+			Sent:      sdk.ZeroInt(),
+			Received:  sdk.ZeroInt(),
+			InFlight:  sdk.ZeroInt(),
+		})
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeRouteAdded{
+		ChannelId: msg.ChannelId,
+		Actor:     msg.From,
+	})
+
+	return &types.MsgAddBridgeRouteResponse{}, err
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AddGuardian(goCtx context.Context, msg *types.MsgAddGuardian) (*types.MsgAddGuardianResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	guardian := types.Guardian{
+		Address: msg.Address,
+	}
+
+	k.SetGuardian(ctx, guardian)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianAdded{
+		Address: msg.Address,
+		Actor:   msg.From,
+	})
+
+	return &types.MsgAddGuardianResponse{}, err
+}
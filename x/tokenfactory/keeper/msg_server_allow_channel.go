@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AllowChannel(goCtx context.Context, msg *types.MsgAllowChannel) (*types.MsgAllowChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	allowedChannel := types.AllowedChannel{
+		ChannelId: msg.ChannelId,
+	}
+
+	k.SetAllowedChannel(ctx, allowedChannel)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventChannelAllowed{
+		ChannelId: msg.ChannelId,
+		Actor:     msg.From,
+	})
+
+	return &types.MsgAllowChannelResponse{}, err
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ApproveOperation(goCtx context.Context, msg *types.MsgApproveOperation) (*types.MsgApproveOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quorum, found := k.GetQuorum(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "quorum is not set")
+	}
+
+	if !quorum.IsMember(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a quorum member")
+	}
+
+	pendingOperation, found := k.GetPendingOperation(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "pending operation with id %d doesn't exist", msg.Id)
+	}
+
+	if ctx.BlockTime().After(pendingOperation.ExpiresAt) {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "pending operation with id %d has expired", msg.Id)
+	}
+
+	if pendingOperation.HasApproval(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "you have already approved this operation")
+	}
+
+	pendingOperation.Approvals = append(pendingOperation.Approvals, msg.From)
+
+	k.SetPendingOperation(ctx, pendingOperation)
+
+	approvals := quorum.CountApprovals(pendingOperation.Approvals)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOperationApproved{
+		Id:        pendingOperation.Id,
+		Approver:  msg.From,
+		Approvals: approvals,
+	}); err != nil {
+		return nil, err
+	}
+
+	executed := approvals >= quorum.Threshold
+	if executed {
+		if err := k.executeOperation(ctx, pendingOperation); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgApproveOperationResponse{Executed: executed}, nil
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestCancelAuthorization(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	holder := sample.AccAddress()
+
+	_, err := srv.CancelAuthorization(wctx, &types.MsgCancelAuthorization{From: holder, Nonce: "1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventAuthorizationCancelled{Authorizer: holder, Nonce: "1"}, lastEvent(t, ctx))
+
+	state, found := k.GetAuthorizationState(ctx, holder, "1")
+	require.True(t, found)
+	require.Equal(t, types.AuthorizationCancelled, state.Status)
+
+	_, err = srv.CancelAuthorization(wctx, &types.MsgCancelAuthorization{From: holder, Nonce: "1"})
+	require.ErrorIs(t, err, types.ErrAuthorization)
+
+	_, err = srv.CancelAuthorization(wctx, &types.MsgCancelAuthorization{From: sample.AccAddress(), Nonce: "1"})
+	require.NoError(t, err)
+}
@@ -14,7 +14,7 @@ func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*t
 
 	blacklister, found := k.GetBlacklister(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "blacklister is not set")
 	}
 
 	if blacklister.Address != msg.From {
@@ -29,7 +29,10 @@ func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*t
 
 	k.SetBlacklisted(ctx, blacklisted)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBlacklisted{
+		Address: msg.Address,
+		Actor:   msg.From,
+	})
 
 	return &types.MsgBlacklistResponse{}, err
 }
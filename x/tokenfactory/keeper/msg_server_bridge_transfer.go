@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+func (k msgServer) BridgeTransfer(goCtx context.Context, msg *types.MsgBridgeTransfer) (*types.MsgBridgeTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if msg.Amount.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "bridged denom is incorrect")
+	}
+
+	route, found := k.GetBridgeRoute(ctx, msg.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotBridgeRoute, "channel %s is not a bridge route", msg.ChannelId)
+	}
+
+	if _, err := k.ValidateNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.ValidateNotBlacklisted(ctx, msg.From, msg.Receiver); err != nil {
+		return nil, err
+	}
+
+	sender, _ := sdk.AccAddressFromBech32(msg.From)
+
+	amount := sdk.NewCoins(msg.Amount)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	route.InFlight = route.InFlight.Add(msg.Amount.Amount)
+	k.SetBridgeRoute(ctx, route)
+
+	// bridge burns are recorded for the bridge minter so that the stats and the telemetry labels are
+	// not keyed by every holder that bridges tokens
+	k.RecordBurn(ctx, types.BridgeMinterAddress().String(), msg.Amount)
+
+	packetData := types.BridgeTransferPacketData{
+		Sender:   msg.From,
+		Receiver: msg.Receiver,
+		Amount:   msg.Amount.Amount,
+	}
+
+	sequence, err := k.TransmitBridgeTransferPacket(ctx, packetData, k.GetBridgePort(ctx), msg.ChannelId, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBridgeTransferSent{
+		Sender:    msg.From,
+		Receiver:  msg.Receiver,
+		Amount:    msg.Amount,
+		ChannelId: msg.ChannelId,
+		Sequence:  sequence,
+	})
+
+	return &types.MsgBridgeTransferResponse{Sequence: sequence}, err
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+func (k msgServer) BroadcastBlacklistUpdate(goCtx context.Context, msg *types.MsgBroadcastBlacklistUpdate) (*types.MsgBroadcastBlacklistUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blacklister, found := k.GetBlacklister(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "blacklister is not set")
+	}
+
+	if blacklister.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	}
+
+	minTimeout := ctx.BlockTime().Add(types.MinBlacklistUpdateTimeout)
+	if msg.TimeoutTimestamp < uint64(minTimeout.UnixNano()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "timeout must be at least %s after the block time", types.MinBlacklistUpdateTimeout)
+	}
+
+	channels := k.GetAllBlacklistSyncChannel(ctx)
+	if len(channels) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNotSyncChannel, "no blacklist sync channel is set")
+	}
+
+	// the update carries the current state of the address on this chain
+	_, blacklisted := k.GetBlacklisted(ctx, msg.Address)
+	packetData := types.BlacklistUpdatePacketData{
+		Address:     msg.Address,
+		Blacklisted: blacklisted,
+	}
+
+	port := k.GetPort(ctx)
+	sequences := make([]uint64, 0, len(channels))
+	for _, channel := range channels {
+		sequence, err := k.TransmitBlacklistUpdatePacket(ctx, packetData, port, channel.ChannelId, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventBlacklistUpdateSent{
+			Address:     msg.Address,
+			Blacklisted: blacklisted,
+			ChannelId:   channel.ChannelId,
+			Sequence:    sequence,
+			Actor:       msg.From,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBroadcastBlacklistUpdateResponse{Sequences: sequences}, nil
+}
@@ -19,32 +19,41 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 
 	_, found = k.GetBlacklisted(ctx, msg.From)
 	if found {
-		return nil, sdkerrors.Wrapf(types.ErrBurn, "minter address is blacklisted")
+		return nil, sdkerrors.Wrapf(types.ErrBlacklistedSender, "minter address is blacklisted")
 	}
 
 	mintingDenom := k.GetMintingDenom(ctx)
 
 	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "burning denom is incorrect")
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "burning denom is incorrect")
 	}
 
 	paused := k.GetPaused(ctx)
 
 	if paused.Paused {
-		return nil, sdkerrors.Wrapf(types.ErrBurn, "burning is paused")
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "burning is paused")
 	}
 
 	minterAddress, _ := sdk.AccAddressFromBech32(msg.From)
 
 	amount := sdk.NewCoins(msg.Amount)
 
-	k.bankKeeper.SendCoinsFromAccountToModule(ctx, minterAddress, types.ModuleName, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, minterAddress, types.ModuleName, amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
 
 	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	k.RecordBurn(ctx, msg.From, msg.Amount)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBurned{
+		Minter:      msg.From,
+		Amount:      msg.Amount,
+		Balance:     k.bankKeeper.GetBalance(ctx, minterAddress, msg.Amount.Denom),
+		TotalSupply: k.bankKeeper.GetSupply(ctx, msg.Amount.Denom),
+	})
 
 	return &types.MsgBurnResponse{}, err
 }
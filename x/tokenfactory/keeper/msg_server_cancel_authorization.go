@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelAuthorization(goCtx context.Context, msg *types.MsgCancelAuthorization) (*types.MsgCancelAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if state, found := k.GetAuthorizationState(ctx, msg.From, msg.Nonce); found {
+		return nil, sdkerrors.Wrapf(types.ErrAuthorization, "nonce %s is already %s", msg.Nonce, state.Status)
+	}
+
+	k.SetAuthorizationState(ctx, types.AuthorizationState{
+		Authorizer: msg.From,
+		Nonce:      msg.Nonce,
+		Status:     types.AuthorizationCancelled,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAuthorizationCancelled{
+		Authorizer: msg.From,
+		Nonce:      msg.Nonce,
+	})
+
+	return &types.MsgCancelAuthorizationResponse{}, err
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelRoleChange(goCtx context.Context, msg *types.MsgCancelRoleChange) (*types.MsgCancelRoleChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	roleChange, found := k.GetRoleChange(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleChange, "role change with id %d doesn't exist", msg.Id)
+	}
+
+	holder, found := k.GetRoleHolder(ctx, roleChange.Role)
+	if !found || holder != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the outgoing role holder")
+	}
+
+	err := k.CancelQueuedRoleChange(ctx, msg.Id, msg.From)
+
+	return &types.MsgCancelRoleChangeResponse{}, err
+}
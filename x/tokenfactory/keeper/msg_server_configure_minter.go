@@ -15,24 +15,34 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 	mintingDenom := k.GetMintingDenom(ctx)
 
 	if msg.Allowance.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "minting denom is incorrect")
 	}
 
 	minterController, found := k.GetMinterController(ctx, msg.From)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+		return nil, sdkerrors.Wrapf(types.ErrMinterControllerNotFound, "you are not a minter controller")
 	}
 
 	if msg.From != minterController.Controller {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
+	var previous *sdk.Coin
+	if minter, found := k.GetMinters(ctx, msg.Address); found {
+		previous = &minter.Allowance
+	}
+
 	k.SetMinters(ctx, types.Minters{
 		Address:   msg.Address,
 		Allowance: msg.Allowance,
 	})
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAllowanceChanged{
+		Minter:    msg.Address,
+		Previous:  previous,
+		Allowance: msg.Allowance,
+		Actor:     msg.From,
+	})
 
 	return &types.MsgConfigureMinterResponse{}, err
 }
@@ -14,13 +14,19 @@ func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.M
 
 	masterMinter, found := k.GetMasterMinter(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "master minter is not set")
 	}
 
 	if masterMinter.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	previous, _ := k.GetMinterController(ctx, msg.Controller)
+
 	controller := types.MinterController{
 		Minter:     msg.Minter,
 		Controller: msg.Controller,
@@ -28,5 +34,12 @@ func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.M
 
 	k.SetMinterController(ctx, controller)
 
-	return &types.MsgConfigureMinterControllerResponse{}, nil
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerConfigured{
+		Controller:     msg.Controller,
+		Minter:         msg.Minter,
+		PreviousMinter: previous.Minter,
+		Actor:          msg.From,
+	})
+
+	return &types.MsgConfigureMinterControllerResponse{}, err
 }
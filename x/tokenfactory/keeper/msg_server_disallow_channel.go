@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DisallowChannel(goCtx context.Context, msg *types.MsgDisallowChannel) (*types.MsgDisallowChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetAllowedChannel(ctx, msg.ChannelId); !found {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "channel %s is not allowed", msg.ChannelId)
+	}
+
+	k.RemoveAllowedChannel(ctx, msg.ChannelId)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventChannelDisallowed{
+		ChannelId: msg.ChannelId,
+		Actor:     msg.From,
+	})
+
+	return &types.MsgDisallowChannelResponse{}, err
+}
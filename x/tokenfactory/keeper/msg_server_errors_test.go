@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestTypedErrors(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	from := sample.AccAddress()
+
+	_, err := srv.UpdatePauser(wctx, &types.MsgUpdatePauser{From: from, Address: from})
+	require.ErrorIs(t, err, types.ErrRoleNotSet)
+
+	_, err = srv.Pause(wctx, &types.MsgPause{From: from})
+	require.ErrorIs(t, err, types.ErrRoleNotSet)
+
+	blacklisted := sample.AccAddress()
+	k.SetBlacklisted(ctx, types.Blacklisted{Address: blacklisted})
+	k.SetMinters(ctx, types.Minters{Address: from, Allowance: sdk.NewInt64Coin("uusdc", 100)})
+
+	_, err = srv.Mint(wctx, &types.MsgMint{From: from, Address: blacklisted, Amount: sdk.NewInt64Coin("uusdc", 10)})
+	require.ErrorIs(t, err, types.ErrBlacklistedRecipient)
+
+	_, err = k.ValidateNotBlacklisted(ctx, blacklisted, from)
+	require.ErrorIs(t, err, types.ErrBlacklistedSender)
+
+	_, err = srv.RemoveMinter(wctx, &types.MsgRemoveMinter{From: from, Address: from})
+	require.ErrorIs(t, err, types.ErrMinterControllerNotFound)
+
+	k.SetMasterMinter(ctx, types.MasterMinter{Address: from})
+	_, err = srv.RemoveMinterController(wctx, &types.MsgRemoveMinterController{From: from, Controller: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrMinterControllerNotFound)
+
+	k.SetPaused(ctx, types.Paused{Paused: false})
+	_, err = srv.FulfillRedemption(wctx, &types.MsgFulfillRedemption{From: from, Id: 1})
+	require.ErrorIs(t, err, types.ErrRedemptionNotFound)
+
+	k.SetPaused(ctx, types.Paused{Paused: true})
+	_, err = k.ValidateNotPaused(ctx)
+	require.ErrorIs(t, err, types.ErrPaused)
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// lastEvent returns the last typed event emitted on the context
+func lastEvent(t *testing.T, ctx sdk.Context) proto.Message {
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	return event
+}
+
+func TestMinterControllerEvents(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	masterMinter := sample.AccAddress()
+	controller := sample.AccAddress()
+	minter := sample.AccAddress()
+	next := sample.AccAddress()
+	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter})
+
+	_, err := srv.ConfigureMinterController(wctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: controller, Minter: minter})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerConfigured{Controller: controller, Minter: minter, Actor: masterMinter}, lastEvent(t, ctx))
+
+	_, err = srv.ConfigureMinterController(wctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: controller, Minter: next})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerConfigured{Controller: controller, Minter: next, PreviousMinter: minter, Actor: masterMinter}, lastEvent(t, ctx))
+
+	_, err = srv.RemoveMinterController(wctx, &types.MsgRemoveMinterController{From: masterMinter, Controller: controller})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterControllerRemoved{Controller: controller, Minter: next, Actor: masterMinter}, lastEvent(t, ctx))
+}
+
+func TestRoleChangedEvent(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	pauser := sample.AccAddress()
+	next := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+	k.SetPauser(ctx, types.Pauser{Address: pauser})
+
+	_, err := srv.UpdatePauser(wctx, &types.MsgUpdatePauser{From: owner, Address: next})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleChanged{Role: types.RolePauser, Previous: pauser, Current: next, Actor: owner}, lastEvent(t, ctx))
+
+	_, err = srv.UpdateAttester(wctx, &types.MsgUpdateAttester{From: owner, Address: next})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventRoleChanged{Role: types.RoleAttester, Current: next, Actor: owner}, lastEvent(t, ctx))
+}
+
+func TestBlacklistEvents(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blacklister := sample.AccAddress()
+	address := sample.AccAddress()
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	_, err := srv.Blacklist(wctx, &types.MsgBlacklist{From: blacklister, Address: address})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlacklisted{Address: address, Actor: blacklister}, lastEvent(t, ctx))
+
+	_, err = srv.Unblacklist(wctx, &types.MsgUnblacklist{From: blacklister, Address: address})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnblacklisted{Address: address, Actor: blacklister}, lastEvent(t, ctx))
+}
+
+func TestPauseEvents(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pauser := sample.AccAddress()
+	k.SetPauser(ctx, types.Pauser{Address: pauser})
+
+	_, err := srv.Pause(wctx, &types.MsgPause{From: pauser})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventPaused{Actor: pauser}, lastEvent(t, ctx))
+
+	_, err = srv.Unpause(wctx, &types.MsgUnpause{From: pauser})
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnpaused{Actor: pauser}, lastEvent(t, ctx))
+}
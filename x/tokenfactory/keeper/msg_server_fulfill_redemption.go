@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) FulfillRedemption(goCtx context.Context, msg *types.MsgFulfillRedemption) (*types.MsgFulfillRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.resolvableRedemption(ctx, msg.From, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(redemption.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	k.RecordBurn(ctx, msg.From, redemption.Amount)
+
+	redemption.Status = types.RedemptionFulfilled
+
+	k.SetRedemption(ctx, redemption)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionFulfilled{
+		Redemption: redemption,
+		Actor:      msg.From,
+	})
+
+	return &types.MsgFulfillRedemptionResponse{}, err
+}
+
+// resolvableRedemption returns the pending redemption with the given id if the
+// signer is allowed to fulfill or reject it.
+func (k msgServer) resolvableRedemption(ctx sdk.Context, from string, id uint64) (types.Redemption, error) {
+	_, found := k.GetMinters(ctx, from)
+	if !found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+	}
+
+	_, found = k.GetBlacklisted(ctx, from)
+	if found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrBlacklistedSender, "minter address is blacklisted")
+	}
+
+	paused := k.GetPaused(ctx)
+
+	if paused.Paused {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrPaused, "redemption is paused")
+	}
+
+	redemption, found := k.GetRedemption(ctx, id)
+	if !found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrRedemptionNotFound, "redemption with a given id (%d) doesn't exist", id)
+	}
+
+	if redemption.Status != types.RedemptionPending {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrRedemption, "redemption is already %s", redemption.Status)
+	}
+
+	return redemption, nil
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestGuardianPause(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	pauser := sample.AccAddress()
+	guardian := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+	k.SetPauser(ctx, types.Pauser{Address: pauser})
+
+	_, err := srv.Pause(wctx, &types.MsgPause{From: guardian})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.AddGuardian(wctx, &types.MsgAddGuardian{From: guardian, Address: guardian})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.AddGuardian(wctx, &types.MsgAddGuardian{From: owner, Address: guardian})
+	require.NoError(t, err)
+
+	_, err = srv.Pause(wctx, &types.MsgPause{From: guardian})
+	require.NoError(t, err)
+
+	require.True(t, k.GetPaused(ctx).Paused)
+
+	_, err = srv.Unpause(wctx, &types.MsgUnpause{From: guardian})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.Unpause(wctx, &types.MsgUnpause{From: pauser})
+	require.NoError(t, err)
+
+	_, err = srv.RemoveGuardian(wctx, &types.MsgRemoveGuardian{From: owner, Address: guardian})
+	require.NoError(t, err)
+
+	_, err = srv.Pause(wctx, &types.MsgPause{From: guardian})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = srv.RemoveGuardian(wctx, &types.MsgRemoveGuardian{From: owner, Address: guardian})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
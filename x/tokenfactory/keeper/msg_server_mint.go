@@ -13,37 +13,13 @@ import (
 func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	minter, found := k.GetMinters(ctx, msg.From)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
+	if _, err := k.ValidateMint(ctx, msg); err != nil {
+		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, msg.From)
-	if found {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
-	}
-
-	_, found = k.GetBlacklisted(ctx, msg.Address)
-	if found {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
-	}
-
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
-	}
-
-	if minter.Allowance.IsLT(msg.Amount) {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
-	}
-
-	paused := k.GetPaused(ctx)
-
-	if paused.Paused {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
-	}
+	minter, _ := k.GetMinters(ctx, msg.From)
 
+	previous := minter.Allowance
 	minter.Allowance = minter.Allowance.Sub(msg.Amount)
 
 	k.SetMinters(ctx, minter)
@@ -60,7 +36,25 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
 	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	k.RecordMint(ctx, msg.From, msg.Amount)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllowanceChanged{
+		Minter:    msg.From,
+		Previous:  &previous,
+		Allowance: minter.Allowance,
+		Actor:     msg.From,
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinted{
+		Minter:             msg.From,
+		Recipient:          msg.Address,
+		Amount:             msg.Amount,
+		RemainingAllowance: minter.Allowance,
+		RecipientBalance:   k.bankKeeper.GetBalance(ctx, receiver, msg.Amount.Denom),
+		TotalSupply:        k.bankKeeper.GetSupply(ctx, msg.Amount.Denom),
+	})
 
 	return &types.MsgMintResponse{}, err
 }
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func TestOperationApproval(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+	quorum := createTestQuorum(k, ctx)
+
+	updatePauser := &types.MsgUpdatePauser{From: owner, Address: sample.AccAddress()}
+
+	_, err := srv.UpdatePauser(wctx, updatePauser)
+	require.ErrorIs(t, err, types.ErrQuorum)
+
+	submit, err := types.NewMsgSubmitOperation(sample.AccAddress(), updatePauser)
+	require.NoError(t, err)
+	_, err = srv.SubmitOperation(wctx, submit)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	submit.From = quorum.Members[0]
+	res, err := srv.SubmitOperation(wctx, submit)
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+
+	_, found := k.GetPauser(ctx)
+	require.False(t, found)
+
+	_, err = srv.ApproveOperation(wctx, &types.MsgApproveOperation{From: quorum.Members[0], Id: res.Id})
+	require.ErrorIs(t, err, types.ErrQuorum)
+
+	approved, err := srv.ApproveOperation(wctx, &types.MsgApproveOperation{From: quorum.Members[1], Id: res.Id})
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+
+	pauser, found := k.GetPauser(ctx)
+	require.True(t, found)
+	require.Equal(t, updatePauser.Address, pauser.Address)
+
+	_, found = k.GetPendingOperation(ctx, res.Id)
+	require.False(t, found)
+}
+
+func TestOperationExpiry(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+	quorum := createTestQuorum(k, ctx)
+
+	submit, err := types.NewMsgSubmitOperation(quorum.Members[0], &types.MsgUpdatePauser{From: owner, Address: sample.AccAddress()})
+	require.NoError(t, err)
+	res, err := srv.SubmitOperation(sdk.WrapSDKContext(ctx), submit)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(quorum.Expiry + time.Second))
+	_, err = srv.ApproveOperation(sdk.WrapSDKContext(ctx), &types.MsgApproveOperation{From: quorum.Members[1], Id: res.Id})
+	require.ErrorIs(t, err, types.ErrQuorum)
+
+	require.NoError(t, k.RemoveExpiredPendingOperations(ctx))
+	_, found := k.GetPendingOperation(ctx, res.Id)
+	require.False(t, found)
+}
+
+func TestSubmitOperationNotRequired(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	quorum := createTestQuorum(k, ctx)
+
+	submit, err := types.NewMsgSubmitOperation(quorum.Members[0], &types.MsgPause{From: sample.AccAddress()})
+	require.NoError(t, err)
+	_, err = srv.SubmitOperation(sdk.WrapSDKContext(ctx), submit)
+	require.ErrorIs(t, err, types.ErrQuorum)
+}
+
+func TestSubmitOperationSigner(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	k.SetOwner(ctx, types.Owner{Address: sample.AccAddress()})
+	quorum := createTestQuorum(k, ctx)
+	threshold := sdk.NewInt64Coin("uusdc", 1000)
+	quorum.MintThreshold = &threshold
+	k.SetQuorum(ctx, quorum)
+
+	// owner operations must be signed by the owner
+	submit, err := types.NewMsgSubmitOperation(quorum.Members[0], &types.MsgUpdatePauser{From: sample.AccAddress(), Address: sample.AccAddress()})
+	require.NoError(t, err)
+	_, err = srv.SubmitOperation(wctx, submit)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// a quorum member cannot mint from a minter they do not control
+	minter := sample.AccAddress()
+	k.SetMinters(ctx, types.Minters{Address: minter, Allowance: sdk.NewInt64Coin("uusdc", 5000)})
+	mint := &types.MsgMint{From: minter, Address: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 2000)}
+	submit, err = types.NewMsgSubmitOperation(quorum.Members[0], mint)
+	require.NoError(t, err)
+	_, err = srv.SubmitOperation(wctx, submit)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Empty(t, k.GetAllPendingOperation(ctx))
+
+	// nor from an account that is not a minter
+	mint.From = quorum.Members[0]
+	submit, err = types.NewMsgSubmitOperation(quorum.Members[0], mint)
+	require.NoError(t, err)
+	_, err = srv.SubmitOperation(wctx, submit)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// a minter in the quorum submits its own mint
+	k.SetMinters(ctx, types.Minters{Address: quorum.Members[0], Allowance: sdk.NewInt64Coin("uusdc", 5000)})
+	res, err := srv.SubmitOperation(wctx, submit)
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+}
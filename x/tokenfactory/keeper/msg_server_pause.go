@@ -13,12 +13,16 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	pauser, found := k.GetPauser(ctx)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
-	}
+	isPauser := found && pauser.Address == msg.From
+
+	// guardians can pause, but only the pauser can unpause
+	_, isGuardian := k.GetGuardian(ctx, msg.From)
 
-	if pauser.Address != msg.From {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
+	if !isPauser && !isGuardian {
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "pauser is not set")
+		}
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser or a guardian")
 	}
 
 	paused := types.Paused{
@@ -27,7 +31,13 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 
 	k.SetPaused(ctx, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	if !isPauser {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianPaused{Guardian: msg.From}); err != nil {
+			return nil, err
+		}
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventPaused{Actor: msg.From})
 
 	return &types.MsgPauseResponse{}, err
 }
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.resolvableRedemption(ctx, msg.From, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := k.GetBlacklisted(ctx, redemption.Holder)
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrBlacklistedRecipient, "holder address is blacklisted")
+	}
+
+	holder, _ := sdk.AccAddressFromBech32(redemption.Holder)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(redemption.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
+	}
+
+	redemption.Status = types.RedemptionRejected
+
+	k.SetRedemption(ctx, redemption)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRejected{
+		Redemption: redemption,
+		Actor:      msg.From,
+	})
+
+	return &types.MsgRejectRedemptionResponse{}, err
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveBridgeRoute(goCtx context.Context, msg *types.MsgRemoveBridgeRoute) (*types.MsgRemoveBridgeRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	route, found := k.GetBridgeRoute(ctx, msg.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotBridgeRoute, "channel %s is not a bridge route", msg.ChannelId)
+	}
+
+	// acknowledgements and timeouts of in flight transfers need the route to refund the sender
+	if !route.InFlight.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrBridgeRouteInFlight, "%s are in flight through channel %s", route.InFlight, msg.ChannelId)
+	}
+
+	k.Keeper.RemoveBridgeRoute(ctx, msg.ChannelId)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeRouteRemoved{
+		ChannelId: msg.ChannelId,
+		Actor:     msg.From,
+	})
+
+	return &types.MsgRemoveBridgeRouteResponse{}, err
+}
@@ -14,7 +14,7 @@ func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinte
 
 	minterController, found := k.GetMinterController(ctx, msg.From)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+		return nil, sdkerrors.Wrapf(types.ErrMinterControllerNotFound, "you are not a minter controller")
 	}
 
 	if msg.From != minterController.Controller {
@@ -28,7 +28,11 @@ func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinte
 
 	k.RemoveMinters(ctx, minter.Address)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Minter:            minter.Address,
+		PreviousAllowance: minter.Allowance,
+		Actor:             msg.From,
+	})
 
 	return &types.MsgRemoveMinterResponse{}, err
 }
@@ -14,19 +14,29 @@ func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgR
 
 	masterMinter, found := k.GetMasterMinter(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "master minter is not set")
 	}
 
 	if msg.From != masterMinter.Address {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
-	_, found = k.GetMinterController(ctx, msg.Controller)
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	controller, found := k.GetMinterController(ctx, msg.Controller)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
+		return nil, sdkerrors.Wrapf(types.ErrMinterControllerNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
 	}
 
 	k.DeleteMinterController(ctx, msg.Controller)
 
-	return &types.MsgRemoveMinterControllerResponse{}, nil
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+		Controller: controller.Controller,
+		Minter:     controller.Minter,
+		Actor:      msg.From,
+	})
+
+	return &types.MsgRemoveMinterControllerResponse{}, err
 }
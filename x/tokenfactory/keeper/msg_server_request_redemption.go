@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetBlacklisted(ctx, msg.From)
+	if found {
+		return nil, sdkerrors.Wrapf(types.ErrBlacklistedSender, "holder address is blacklisted")
+	}
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if msg.Amount.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "redemption denom is incorrect")
+	}
+
+	paused := k.GetPaused(ctx)
+
+	if paused.Paused {
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "redemption is paused")
+	}
+
+	holder, _ := sdk.AccAddressFromBech32(msg.From)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrRedemption, err.Error())
+	}
+
+	redemption := types.Redemption{
+		Holder: msg.From,
+		Amount: msg.Amount,
+		Status: types.RedemptionPending,
+	}
+
+	redemption.Id = k.AppendRedemption(ctx, redemption)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRequested{
+		Redemption: redemption,
+		Actor:      msg.From,
+	})
+
+	return &types.MsgRequestRedemptionResponse{Id: redemption.Id}, err
+}
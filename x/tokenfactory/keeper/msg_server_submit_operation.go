@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SubmitOperation(goCtx context.Context, msg *types.MsgSubmitOperation) (*types.MsgSubmitOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quorum, found := k.GetQuorum(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "quorum is not set")
+	}
+
+	if !quorum.IsMember(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a quorum member")
+	}
+
+	operation, err := msg.GetMessage()
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrQuorum, err.Error())
+	}
+
+	if !k.RequiresQuorum(ctx, operation) {
+		return nil, sdkerrors.Wrapf(types.ErrQuorum, "%s does not require quorum approval", sdk.MsgTypeURL(operation))
+	}
+
+	if err := k.checkOperationSigner(ctx, msg.From, operation); err != nil {
+		return nil, err
+	}
+
+	pendingOperation := types.PendingOperation{
+		Proposer:  msg.From,
+		Msg:       msg.Msg,
+		Approvals: []string{msg.From},
+		ExpiresAt: ctx.BlockTime().Add(quorum.Expiry),
+	}
+
+	pendingOperation.Id = k.AppendPendingOperation(ctx, pendingOperation)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOperationSubmitted{Operation: pendingOperation}); err != nil {
+		return nil, err
+	}
+
+	executed := quorum.CountApprovals(pendingOperation.Approvals) >= quorum.Threshold
+	if executed {
+		if err := k.executeOperation(ctx, pendingOperation); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSubmitOperationResponse{Id: pendingOperation.Id, Executed: executed}, nil
+}
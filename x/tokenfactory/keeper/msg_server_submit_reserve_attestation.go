@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SubmitReserveAttestation(goCtx context.Context, msg *types.MsgSubmitReserveAttestation) (*types.MsgSubmitReserveAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attester, found := k.GetAttester(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "attester is not set")
+	}
+
+	if attester.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the attester")
+	}
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if msg.Reserves.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "reserves denom is incorrect")
+	}
+
+	if msg.Timestamp.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrAttestation, "attestation timestamp is in the future")
+	}
+
+	latest, found := k.GetLatestReserveAttestation(ctx)
+	if found && msg.Timestamp.Before(latest.Timestamp) {
+		return nil, sdkerrors.Wrapf(types.ErrAttestation, "attestation timestamp is older than the latest attestation")
+	}
+
+	attestation := types.ReserveAttestation{
+		Attester:     msg.From,
+		Reserves:     msg.Reserves,
+		Timestamp:    msg.Timestamp,
+		Auditor:      msg.Auditor,
+		DocumentHash: msg.DocumentHash,
+		Height:       ctx.BlockHeight(),
+	}
+
+	attestation.Id = k.AppendReserveAttestation(ctx, attestation)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventReserveAttestationSubmitted{Attestation: attestation})
+
+	return &types.MsgSubmitReserveAttestationResponse{Id: attestation.Id}, err
+}
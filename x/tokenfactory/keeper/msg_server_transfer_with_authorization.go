@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) TransferWithAuthorization(goCtx context.Context, msg *types.MsgTransferWithAuthorization) (*types.MsgTransferWithAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorization := msg.Authorization
+
+	if err := k.verifyTransferAuthorization(ctx, authorization, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if authorization.Amount.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "transfer denom is incorrect")
+	}
+
+	if _, err := k.ValidateTransfer(ctx, authorization.From, authorization.To, authorization.Amount, types.TransferPathBank); err != nil {
+		return nil, err
+	}
+
+	from, _ := sdk.AccAddressFromBech32(authorization.From)
+	to, _ := sdk.AccAddressFromBech32(authorization.To)
+
+	// apply the same checks as a bank send
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, authorization.Amount); err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", authorization.To)
+	}
+
+	k.SetAuthorizationState(ctx, types.AuthorizationState{
+		Authorizer: authorization.From,
+		Nonce:      authorization.Nonce,
+		Status:     types.AuthorizationUsed,
+	})
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(authorization.Amount)); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTransferWithAuthorization{
+		Authorizer: authorization.From,
+		To:         authorization.To,
+		Amount:     authorization.Amount,
+		Nonce:      authorization.Nonce,
+		Relayer:    msg.From,
+	})
+
+	return &types.MsgTransferWithAuthorizationResponse{}, err
+}
@@ -14,7 +14,7 @@ func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist)
 
 	blacklister, found := k.GetBlacklister(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "blacklister is not set")
 	}
 
 	if blacklister.Address != msg.From {
@@ -28,7 +28,10 @@ func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist)
 
 	k.RemoveBlacklisted(ctx, blacklisted.Address)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUnblacklisted{
+		Address: blacklisted.Address,
+		Actor:   msg.From,
+	})
 
 	return &types.MsgUnblacklistResponse{}, err
 }
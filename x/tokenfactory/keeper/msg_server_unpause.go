@@ -14,7 +14,7 @@ func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types
 
 	pauser, found := k.GetPauser(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "pauser is not set")
 	}
 
 	if pauser.Address != msg.From {
@@ -27,7 +27,7 @@ func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types
 
 	k.SetPaused(ctx, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventUnpaused{Actor: msg.From})
 
 	return &types.MsgUnpauseResponse{}, err
 }
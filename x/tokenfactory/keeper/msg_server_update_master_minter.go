@@ -14,20 +14,18 @@ func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdat
 
 	owner, found := k.GetOwner(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
 	}
 
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
-	masterMinter := types.MasterMinter{
-		Address: msg.Address,
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
 	}
 
-	k.SetMasterMinter(ctx, masterMinter)
-
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := k.QueueRoleChange(ctx, types.RoleMasterMinter, msg.Address, msg.From)
 
 	return &types.MsgUpdateMasterMinterResponse{}, err
 }
@@ -14,18 +14,18 @@ func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner)
 
 	owner, found := k.GetOwner(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
 	}
 
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
-	owner.Address = msg.Address
-
-	k.SetOwner(ctx, owner)
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := k.QueueRoleChange(ctx, types.RoleOwner, msg.Address, msg.From)
 
 	return &types.MsgUpdateOwnerResponse{}, err
 }
@@ -14,20 +14,18 @@ func (k msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePause
 
 	owner, found := k.GetOwner(ctx)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
 	}
 
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
-	pauser := types.Pauser{
-		Address: msg.Address,
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
 	}
 
-	k.SetPauser(ctx, pauser)
-
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := k.QueueRoleChange(ctx, types.RolePauser, msg.Address, msg.From)
 
 	return &types.MsgUpdatePauserResponse{}, err
 }
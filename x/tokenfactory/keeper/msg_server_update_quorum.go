@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateQuorum(goCtx context.Context, msg *types.MsgUpdateQuorum) (*types.MsgUpdateQuorumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	var previous *types.Quorum
+	if quorum, found := k.GetQuorum(ctx); found {
+		previous = &quorum
+	}
+
+	if len(msg.Members) == 0 {
+		k.RemoveQuorum(ctx)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventQuorumChanged{
+			Previous: previous,
+			Actor:    msg.From,
+		})
+		return &types.MsgUpdateQuorumResponse{}, err
+	}
+
+	if msg.MintThreshold != nil {
+		mintingDenom := k.GetMintingDenom(ctx)
+
+		if msg.MintThreshold.Denom != mintingDenom.Denom {
+			return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "mint threshold denom is incorrect")
+		}
+	}
+
+	quorum := types.Quorum{
+		Members:       msg.Members,
+		Threshold:     msg.Threshold,
+		Expiry:        msg.Expiry,
+		MintThreshold: msg.MintThreshold,
+	}
+
+	k.SetQuorum(ctx, quorum)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventQuorumChanged{
+		Previous: previous,
+		Current:  &quorum,
+		Actor:    msg.From,
+	})
+
+	return &types.MsgUpdateQuorumResponse{}, err
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateSupplyCap(goCtx context.Context, msg *types.MsgUpdateSupplyCap) (*types.MsgUpdateSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRoleNotSet, "owner is not set")
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	if err := k.checkQuorum(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	mintingDenom := k.GetMintingDenom(ctx)
+
+	if msg.Amount.Denom != mintingDenom.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongDenom, "supply cap denom is incorrect")
+	}
+
+	var previous *sdk.Coin
+	if supplyCap, found := k.GetSupplyCap(ctx); found {
+		previous = &supplyCap.Amount
+	}
+
+	supplyCap := types.SupplyCap{
+		Amount: msg.Amount,
+	}
+
+	k.SetSupplyCap(ctx, supplyCap)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSupplyCapChanged{
+		Previous: previous,
+		Current:  msg.Amount,
+		Actor:    msg.From,
+	})
+
+	return &types.MsgUpdateSupplyCapResponse{}, err
+}
@@ -1,16 +1,43 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.EnforceReserves(ctx),
+		k.RoleChangeDelay(ctx),
+		k.FeeConversionRate(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// EnforceReserves returns the EnforceReserves param
+func (k Keeper) EnforceReserves(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyEnforceReserves, &res)
+	return
+}
+
+// RoleChangeDelay returns the RoleChangeDelay param
+func (k Keeper) RoleChangeDelay(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyRoleChangeDelay, &res)
+	return
+}
+
+// FeeConversionRate returns the FeeConversionRate param, an unset rate is zero
+func (k Keeper) FeeConversionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyFeeConversionRate, &res)
+	if res.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingOperationCount get the total number of pendingOperation
+func (k Keeper) GetPendingOperationCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PendingOperationCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPendingOperationCount set the total number of pendingOperation
+func (k Keeper) SetPendingOperationCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PendingOperationCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendPendingOperation appends a pendingOperation in the store with a new id and update the count
+func (k Keeper) AppendPendingOperation(
+	ctx sdk.Context,
+	pendingOperation types.PendingOperation,
+) uint64 {
+	// Create the pendingOperation
+	count := k.GetPendingOperationCount(ctx)
+
+	// Set the ID of the appended value
+	pendingOperation.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOperationKey))
+	appendedValue := k.cdc.MustMarshal(&pendingOperation)
+	store.Set(GetPendingOperationIDBytes(pendingOperation.Id), appendedValue)
+
+	// Update pendingOperation count
+	k.SetPendingOperationCount(ctx, count+1)
+
+	return count
+}
+
+// SetPendingOperation set a specific pendingOperation in the store
+func (k Keeper) SetPendingOperation(ctx sdk.Context, pendingOperation types.PendingOperation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOperationKey))
+	b := k.cdc.MustMarshal(&pendingOperation)
+	store.Set(GetPendingOperationIDBytes(pendingOperation.Id), b)
+}
+
+// GetPendingOperation returns a pendingOperation from its id
+func (k Keeper) GetPendingOperation(ctx sdk.Context, id uint64) (val types.PendingOperation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOperationKey))
+	b := store.Get(GetPendingOperationIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingOperation removes a pendingOperation from the store
+func (k Keeper) RemovePendingOperation(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOperationKey))
+	store.Delete(GetPendingOperationIDBytes(id))
+}
+
+// GetAllPendingOperation returns all pendingOperation
+func (k Keeper) GetAllPendingOperation(ctx sdk.Context) (list []types.PendingOperation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingOperationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingOperation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPendingOperationIDBytes returns the byte representation of the ID
+func GetPendingOperationIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetPendingOperationIDFromBytes returns ID in uint64 format from a byte array
+func GetPendingOperationIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// RemoveExpiredPendingOperations removes every pendingOperation that expired before the current block time
+func (k Keeper) RemoveExpiredPendingOperations(ctx sdk.Context) error {
+	for _, pendingOperation := range k.GetAllPendingOperation(ctx) {
+		if !ctx.BlockTime().After(pendingOperation.ExpiresAt) {
+			continue
+		}
+
+		k.RemovePendingOperation(ctx, pendingOperation.Id)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOperationExpired{Id: pendingOperation.Id}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
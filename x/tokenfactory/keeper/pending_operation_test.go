@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createNPendingOperation(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PendingOperation {
+	items := make([]types.PendingOperation, n)
+	for i := range items {
+		items[i].Proposer = sample.AccAddress()
+		items[i].Approvals = []string{items[i].Proposer}
+		items[i].ExpiresAt = time.Unix(int64(i), 0).UTC()
+		items[i].Id = keeper.AppendPendingOperation(ctx, items[i])
+	}
+	return items
+}
+
+func TestPendingOperationGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNPendingOperation(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetPendingOperation(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestPendingOperationRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNPendingOperation(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePendingOperation(ctx, item.Id)
+		_, found := keeper.GetPendingOperation(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestPendingOperationGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNPendingOperation(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPendingOperation(ctx)),
+	)
+}
+
+func TestPendingOperationCount(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNPendingOperation(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetPendingOperationCount(ctx))
+}
+
+func TestRemoveExpiredPendingOperations(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNPendingOperation(keeper, ctx, 10)
+
+	require.NoError(t, keeper.RemoveExpiredPendingOperations(ctx.WithBlockTime(items[4].ExpiresAt.Add(time.Second))))
+
+	for _, item := range items {
+		_, found := keeper.GetPendingOperation(ctx, item.Id)
+		require.Equal(t, item.Id > 4, found)
+	}
+}
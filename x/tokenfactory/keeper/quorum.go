@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetQuorum set quorum in the store
+func (k Keeper) SetQuorum(ctx sdk.Context, quorum types.Quorum) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&quorum)
+	store.Set(types.KeyPrefix(types.QuorumKey), b)
+}
+
+// GetQuorum returns quorum
+func (k Keeper) GetQuorum(ctx sdk.Context) (val types.Quorum, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.QuorumKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveQuorum removes quorum from the store
+func (k Keeper) RemoveQuorum(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(types.QuorumKey))
+}
+
+// quorumApprovedKey marks a context in which an operation approved by the quorum is executed
+type quorumApprovedKey struct{}
+
+// RequiresQuorum returns true if the message can only be executed as an operation approved
+// by the quorum. Owner and master minter messages always require approval while a quorum
+// is set, mints only when they exceed the mint threshold.
+func (k Keeper) RequiresQuorum(ctx sdk.Context, msg sdk.Msg) bool {
+	quorum, found := k.GetQuorum(ctx)
+	if !found {
+		return false
+	}
+
+	switch msg := msg.(type) {
+	case *types.MsgUpdateOwner,
+		*types.MsgUpdateMasterMinter,
+		*types.MsgUpdatePauser,
+		*types.MsgUpdateBlacklister,
+		*types.MsgUpdateAttester,
+		*types.MsgUpdateSupplyCap,
+		*types.MsgUpdateQuorum,
+		*types.MsgAddGuardian,
+		*types.MsgRemoveGuardian,
+		*types.MsgSetRateLimit,
+		*types.MsgRemoveRateLimit,
+		*types.MsgAllowChannel,
+		*types.MsgDisallowChannel,
+		*types.MsgAddBlacklistSyncChannel,
+		*types.MsgRemoveBlacklistSyncChannel,
+		*types.MsgAddBridgeRoute,
+		*types.MsgRemoveBridgeRoute,
+		*types.MsgConfigureMinterController,
+		*types.MsgRemoveMinterController:
+		return true
+	case *types.MsgMint:
+		threshold := quorum.MintThreshold
+		return threshold != nil && threshold.Denom == msg.Amount.Denom && threshold.IsLT(msg.Amount)
+	default:
+		return false
+	}
+}
+
+// checkQuorum returns an error if the message requires quorum approval and is not
+// executed as an approved operation.
+func (k Keeper) checkQuorum(ctx sdk.Context, msg sdk.Msg) error {
+	if ctx.Value(quorumApprovedKey{}) != nil {
+		return nil
+	}
+
+	if k.RequiresQuorum(ctx, msg) {
+		return sdkerrors.Wrapf(types.ErrQuorum, "%s must be submitted as an operation", sdk.MsgTypeURL(msg))
+	}
+
+	return nil
+}
+
+// checkOperationSigner returns an error unless the signer of the operation currently holds the role
+// the message needs. The quorum acts on behalf of the owner and the master minter, while a mint can
+// only be submitted by the minter whose allowance it uses.
+func (k Keeper) checkOperationSigner(ctx sdk.Context, proposer string, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *types.MsgConfigureMinterController,
+		*types.MsgRemoveMinterController:
+		masterMinter, found := k.GetMasterMinter(ctx)
+		if !found || masterMinter.Address != msg.GetSigners()[0].String() {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "operation signer is not the master minter")
+		}
+	case *types.MsgMint:
+		if _, found := k.GetMinters(ctx, msg.From); !found {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "operation signer is not a minter")
+		}
+		if msg.From != proposer {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "mints can only be submitted by the minter")
+		}
+	default:
+		owner, found := k.GetOwner(ctx)
+		if !found || owner.Address != msg.GetSigners()[0].String() {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "operation signer is not the owner")
+		}
+	}
+
+	return nil
+}
+
+// executeOperation runs the message of a pendingOperation once the quorum has approved it.
+// The message is handled as if it was sent directly, so the signer checks of the underlying
+// handler still apply.
+func (k msgServer) executeOperation(ctx sdk.Context, pendingOperation types.PendingOperation) error {
+	msg, err := pendingOperation.GetMessage()
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrQuorum, err.Error())
+	}
+
+	if err := k.checkOperationSigner(ctx, pendingOperation.Proposer, msg); err != nil {
+		return err
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx.WithValue(quorumApprovedKey{}, true))
+
+	switch msg := msg.(type) {
+	case *types.MsgUpdateOwner:
+		_, err = k.UpdateOwner(goCtx, msg)
+	case *types.MsgUpdateMasterMinter:
+		_, err = k.UpdateMasterMinter(goCtx, msg)
+	case *types.MsgUpdatePauser:
+		_, err = k.UpdatePauser(goCtx, msg)
+	case *types.MsgUpdateBlacklister:
+		_, err = k.UpdateBlacklister(goCtx, msg)
+	case *types.MsgUpdateAttester:
+		_, err = k.UpdateAttester(goCtx, msg)
+	case *types.MsgUpdateSupplyCap:
+		_, err = k.UpdateSupplyCap(goCtx, msg)
+	case *types.MsgUpdateQuorum:
+		_, err = k.UpdateQuorum(goCtx, msg)
+	case *types.MsgAddGuardian:
+		_, err = k.AddGuardian(goCtx, msg)
+	case *types.MsgRemoveGuardian:
+		_, err = k.RemoveGuardian(goCtx, msg)
+	case *types.MsgSetRateLimit:
+		_, err = k.SetRateLimit(goCtx, msg)
+	case *types.MsgRemoveRateLimit:
+		_, err = k.RemoveRateLimit(goCtx, msg)
+	case *types.MsgAllowChannel:
+		_, err = k.AllowChannel(goCtx, msg)
+	case *types.MsgDisallowChannel:
+		_, err = k.DisallowChannel(goCtx, msg)
+	case *types.MsgAddBlacklistSyncChannel:
+		_, err = k.AddBlacklistSyncChannel(goCtx, msg)
+	case *types.MsgRemoveBlacklistSyncChannel:
+		_, err = k.RemoveBlacklistSyncChannel(goCtx, msg)
+	case *types.MsgAddBridgeRoute:
+		_, err = k.AddBridgeRoute(goCtx, msg)
+	case *types.MsgRemoveBridgeRoute:
+		_, err = k.RemoveBridgeRoute(goCtx, msg)
+	case *types.MsgConfigureMinterController:
+		_, err = k.ConfigureMinterController(goCtx, msg)
+	case *types.MsgRemoveMinterController:
+		_, err = k.RemoveMinterController(goCtx, msg)
+	case *types.MsgMint:
+		_, err = k.Mint(goCtx, msg)
+	default:
+		err = sdkerrors.Wrapf(types.ErrQuorum, "unsupported operation %s", sdk.MsgTypeURL(msg))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	k.RemovePendingOperation(ctx, pendingOperation.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventOperationExecuted{Id: pendingOperation.Id})
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createTestQuorum(keeper *keeper.Keeper, ctx sdk.Context) types.Quorum {
+	item := types.Quorum{
+		Members:   []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+		Threshold: 2,
+		Expiry:    time.Hour,
+	}
+	keeper.SetQuorum(ctx, item)
+	return item
+}
+
+func TestQuorumGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	item := createTestQuorum(keeper, ctx)
+	rst, found := keeper.GetQuorum(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestRequiresQuorum(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	updatePauser := &types.MsgUpdatePauser{From: sample.AccAddress(), Address: sample.AccAddress()}
+	smallMint := &types.MsgMint{From: sample.AccAddress(), Address: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 100)}
+	largeMint := &types.MsgMint{From: sample.AccAddress(), Address: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 1001)}
+
+	require.False(t, keeper.RequiresQuorum(ctx, updatePauser))
+	require.False(t, keeper.RequiresQuorum(ctx, largeMint))
+
+	quorum := createTestQuorum(keeper, ctx)
+	require.True(t, keeper.RequiresQuorum(ctx, updatePauser))
+	require.False(t, keeper.RequiresQuorum(ctx, largeMint))
+	require.False(t, keeper.RequiresQuorum(ctx, &types.MsgPause{From: sample.AccAddress()}))
+
+	threshold := sdk.NewInt64Coin("uusdc", 1000)
+	quorum.MintThreshold = &threshold
+	keeper.SetQuorum(ctx, quorum)
+	require.False(t, keeper.RequiresQuorum(ctx, smallMint))
+	require.True(t, keeper.RequiresQuorum(ctx, largeMint))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// SetRateLimit set a specific rateLimit in the store from its index
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(
+		rateLimit.ChannelId,
+		rateLimit.Direction,
+	), b)
+}
+
+// GetRateLimit returns an rateLimit from its index
+func (k Keeper) GetRateLimit(
+	ctx sdk.Context,
+	channelId string,
+	direction types.RateLimitDirection,
+
+) (val types.RateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+
+	b := store.Get(types.RateLimitKey(
+		channelId,
+		direction,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRateLimit returns all rateLimit
+func (k Keeper) GetAllRateLimit(ctx sdk.Context) (list []types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveRateLimit removes a rateLimit from the store
+func (k Keeper) RemoveRateLimit(
+	ctx sdk.Context,
+	channelId string,
+	direction types.RateLimitDirection,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	store.Delete(types.RateLimitKey(
+		channelId,
+		direction,
+	))
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNRateLimit(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RateLimit {
+	items := make([]types.RateLimit, n)
+	for i := range items {
+		items[i].ChannelId = "channel-" + strconv.Itoa(i)
+		items[i].Direction = types.RateLimitDirection(i % 2)
+		items[i].MaxAmount = sdk.NewInt(int64(i + 1))
+		items[i].MaxSupplyFraction = sdk.ZeroDec()
+		items[i].Window = time.Hour
+		items[i].Flow = sdk.ZeroInt()
+
+		keeper.SetRateLimit(ctx, items[i])
+	}
+	return items
+}
+
+func TestRateLimitGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRateLimit(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRateLimit(ctx,
+			item.ChannelId,
+			item.Direction,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestRateLimitRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRateLimit(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRateLimit(ctx,
+			item.ChannelId,
+			item.Direction,
+		)
+		_, found := keeper.GetRateLimit(ctx,
+			item.ChannelId,
+			item.Direction,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestRateLimitGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRateLimit(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRateLimit(ctx)),
+	)
+}
+
+func TestConsumeRateLimit(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	owner := sample.AccAddress()
+
+	// channels without a rate limit are not restricted
+	_, err := k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(1000))
+	require.NoError(t, err)
+
+	require.NoError(t, k.UpdateRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(100), sdk.ZeroDec(), time.Hour, owner))
+
+	_, err = k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(60))
+	require.NoError(t, err)
+
+	reason, err := k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(50))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.Equal(t, types.CheckReasonRateLimitExceeded, reason)
+
+	// the other direction has its own quota
+	_, err = k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitOutflow, sdk.NewInt(50))
+	require.NoError(t, err)
+
+	k.RefundRateLimit(ctx, "channel-0", types.RateLimitInflow, ctx.BlockTime(), sdk.NewInt(20))
+	_, err = k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(60))
+	require.NoError(t, err)
+
+	// updating the quota keeps the flow of the current window
+	require.NoError(t, k.UpdateRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(110), sdk.ZeroDec(), time.Hour, owner))
+	rateLimit, quota, found := k.RateLimitUsage(ctx, "channel-0", types.RateLimitInflow)
+	require.True(t, found)
+	require.Equal(t, int64(100), rateLimit.Flow.Int64())
+	require.Equal(t, int64(110), quota.Int64())
+
+	// the flow is reset once the window elapses
+	windowStart := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = k.ConsumeRateLimit(ctx, "channel-0", types.RateLimitInflow, sdk.NewInt(110))
+	require.NoError(t, err)
+
+	// refunds of transfers counted in the previous window do not free the quota of the current one
+	k.RefundRateLimit(ctx, "channel-0", types.RateLimitInflow, windowStart, sdk.NewInt(60))
+	rateLimit, _, _ = k.RateLimitUsage(ctx, "channel-0", types.RateLimitInflow)
+	require.Equal(t, int64(110), rateLimit.Flow.Int64())
+
+	require.NoError(t, k.DeleteRateLimit(ctx, "channel-0", types.RateLimitInflow, owner))
+	require.ErrorIs(t, k.DeleteRateLimit(ctx, "channel-0", types.RateLimitInflow, owner), types.ErrRateLimitNotFound)
+}
+
+func TestRateLimitMsgServer(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetOwner(ctx, types.Owner{Address: owner})
+
+	msg := types.NewMsgSetRateLimit(sample.AccAddress(), "channel-0", types.RateLimitOutflow, sdk.NewInt(100), sdk.ZeroDec(), time.Hour)
+	_, err := srv.SetRateLimit(wctx, msg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	msg.From = owner
+	_, err = srv.SetRateLimit(wctx, msg)
+	require.NoError(t, err)
+
+	event, ok := lastEvent(t, ctx).(*types.EventRateLimitChanged)
+	require.True(t, ok)
+	require.Nil(t, event.Previous)
+	require.Equal(t, int64(100), event.Current.MaxAmount.Int64())
+	require.Equal(t, owner, event.Actor)
+
+	_, err = srv.RemoveRateLimit(wctx, types.NewMsgRemoveRateLimit(owner, "channel-0", types.RateLimitOutflow))
+	require.NoError(t, err)
+
+	_, found := k.GetRateLimit(ctx, "channel-0", types.RateLimitOutflow)
+	require.False(t, found)
+
+	_, err = srv.RemoveRateLimit(wctx, types.NewMsgRemoveRateLimit(owner, "channel-0", types.RateLimitOutflow))
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRedemptionCount get the total number of redemption
+func (k Keeper) GetRedemptionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.RedemptionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRedemptionCount set the total number of redemption
+func (k Keeper) SetRedemptionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.RedemptionCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendRedemption appends a redemption in the store with a new id and update the count
+func (k Keeper) AppendRedemption(
+	ctx sdk.Context,
+	redemption types.Redemption,
+) uint64 {
+	// Create the redemption
+	count := k.GetRedemptionCount(ctx)
+
+	// Set the ID of the appended value
+	redemption.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKey))
+	appendedValue := k.cdc.MustMarshal(&redemption)
+	store.Set(GetRedemptionIDBytes(redemption.Id), appendedValue)
+
+	// Update redemption count
+	k.SetRedemptionCount(ctx, count+1)
+
+	return count
+}
+
+// SetRedemption set a specific redemption in the store
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKey))
+	b := k.cdc.MustMarshal(&redemption)
+	store.Set(GetRedemptionIDBytes(redemption.Id), b)
+}
+
+// GetRedemption returns a redemption from its id
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (val types.Redemption, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKey))
+	b := store.Get(GetRedemptionIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedemption removes a redemption from the store
+func (k Keeper) RemoveRedemption(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKey))
+	store.Delete(GetRedemptionIDBytes(id))
+}
+
+// GetAllRedemption returns all redemption
+func (k Keeper) GetAllRedemption(ctx sdk.Context) (list []types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRedemptionIDBytes returns the byte representation of the ID
+func GetRedemptionIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetRedemptionIDFromBytes returns ID in uint64 format from a byte array
+func GetRedemptionIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
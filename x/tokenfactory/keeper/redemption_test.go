@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createNRedemption(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Redemption {
+	items := make([]types.Redemption, n)
+	for i := range items {
+		items[i].Holder = sample.AccAddress()
+		items[i].Status = types.RedemptionStatus(i % 3)
+		items[i].Id = keeper.AppendRedemption(ctx, items[i])
+	}
+	return items
+}
+
+func TestRedemptionGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemption(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetRedemption(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestRedemptionRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemption(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRedemption(ctx, item.Id)
+		_, found := keeper.GetRedemption(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestRedemptionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemption(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRedemption(ctx)),
+	)
+}
+
+func TestRedemptionCount(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRedemption(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetRedemptionCount(ctx))
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetReserveAttestationCount get the total number of reserveAttestation
+func (k Keeper) GetReserveAttestationCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.ReserveAttestationCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetReserveAttestationCount set the total number of reserveAttestation
+func (k Keeper) SetReserveAttestationCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.ReserveAttestationCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendReserveAttestation appends a reserveAttestation in the store with a new id and update the count
+func (k Keeper) AppendReserveAttestation(
+	ctx sdk.Context,
+	reserveAttestation types.ReserveAttestation,
+) uint64 {
+	// Create the reserveAttestation
+	count := k.GetReserveAttestationCount(ctx)
+
+	// Set the ID of the appended value
+	reserveAttestation.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKey))
+	appendedValue := k.cdc.MustMarshal(&reserveAttestation)
+	store.Set(GetReserveAttestationIDBytes(reserveAttestation.Id), appendedValue)
+
+	// Update reserveAttestation count
+	k.SetReserveAttestationCount(ctx, count+1)
+
+	return count
+}
+
+// SetReserveAttestation set a specific reserveAttestation in the store
+func (k Keeper) SetReserveAttestation(ctx sdk.Context, reserveAttestation types.ReserveAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKey))
+	b := k.cdc.MustMarshal(&reserveAttestation)
+	store.Set(GetReserveAttestationIDBytes(reserveAttestation.Id), b)
+}
+
+// GetReserveAttestation returns a reserveAttestation from its id
+func (k Keeper) GetReserveAttestation(ctx sdk.Context, id uint64) (val types.ReserveAttestation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKey))
+	b := store.Get(GetReserveAttestationIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveReserveAttestation removes a reserveAttestation from the store
+func (k Keeper) RemoveReserveAttestation(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKey))
+	store.Delete(GetReserveAttestationIDBytes(id))
+}
+
+// GetAllReserveAttestation returns all reserveAttestation
+func (k Keeper) GetAllReserveAttestation(ctx sdk.Context) (list []types.ReserveAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReserveAttestationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ReserveAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetReserveAttestationIDBytes returns the byte representation of the ID
+func GetReserveAttestationIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetReserveAttestationIDFromBytes returns ID in uint64 format from a byte array
+func GetReserveAttestationIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetLatestReserveAttestation returns the most recently submitted reserveAttestation
+func (k Keeper) GetLatestReserveAttestation(ctx sdk.Context) (val types.ReserveAttestation, found bool) {
+	count := k.GetReserveAttestationCount(ctx)
+	if count == 0 {
+		return val, false
+	}
+
+	return k.GetReserveAttestation(ctx, count-1)
+}
+
+// ValidateReserves returns an error if minting the given amount would push the
+// total supply above the latest attested reserves. The check only applies while
+// the EnforceReserves param is enabled.
+func (k Keeper) ValidateReserves(ctx sdk.Context, amount sdk.Coin) error {
+	if !k.EnforceReserves(ctx) {
+		return nil
+	}
+
+	attestation, found := k.GetLatestReserveAttestation(ctx)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrReservesExceeded, "no reserve attestation has been submitted")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Add(amount)
+
+	if attestation.Reserves.IsLT(supply) {
+		return sdkerrors.Wrapf(types.ErrReservesExceeded, "total supply (%s) would exceed attested reserves (%s)", supply, attestation.Reserves)
+	}
+
+	return nil
+}
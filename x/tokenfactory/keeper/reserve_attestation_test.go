@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createNReserveAttestation(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ReserveAttestation {
+	items := make([]types.ReserveAttestation, n)
+	for i := range items {
+		items[i].Attester = sample.AccAddress()
+		items[i].Reserves = sdk.NewInt64Coin("uusdc", int64(i))
+		items[i].Id = keeper.AppendReserveAttestation(ctx, items[i])
+	}
+	return items
+}
+
+func TestReserveAttestationGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNReserveAttestation(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetReserveAttestation(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestReserveAttestationRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNReserveAttestation(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveReserveAttestation(ctx, item.Id)
+		_, found := keeper.GetReserveAttestation(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestReserveAttestationGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNReserveAttestation(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllReserveAttestation(ctx)),
+	)
+}
+
+func TestReserveAttestationCount(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNReserveAttestation(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetReserveAttestationCount(ctx))
+}
+
+func TestLatestReserveAttestationGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	_, found := keeper.GetLatestReserveAttestation(ctx)
+	require.False(t, found)
+
+	items := createNReserveAttestation(keeper, ctx, 10)
+	got, found := keeper.GetLatestReserveAttestation(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&items[len(items)-1]),
+		nullify.Fill(&got),
+	)
+}
+
+func TestValidateReserves(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	amount := sdk.NewInt64Coin("uusdc", 100)
+
+	require.NoError(t, keeper.ValidateReserves(ctx, amount))
+
+	keeper.SetParams(ctx, types.NewParams(true, types.DefaultRoleChangeDelay, types.DefaultFeeConversionRate))
+	require.ErrorIs(t, keeper.ValidateReserves(ctx, amount), types.ErrReservesExceeded)
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRoleChangeCount get the total number of roleChange
+func (k Keeper) GetRoleChangeCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.RoleChangeCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRoleChangeCount set the total number of roleChange
+func (k Keeper) SetRoleChangeCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.RoleChangeCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendRoleChange appends a roleChange in the store with a new id and update the count
+func (k Keeper) AppendRoleChange(
+	ctx sdk.Context,
+	roleChange types.RoleChange,
+) uint64 {
+	// Create the roleChange
+	count := k.GetRoleChangeCount(ctx)
+
+	// Set the ID of the appended value
+	roleChange.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleChangeKey))
+	appendedValue := k.cdc.MustMarshal(&roleChange)
+	store.Set(GetRoleChangeIDBytes(roleChange.Id), appendedValue)
+
+	// Update roleChange count
+	k.SetRoleChangeCount(ctx, count+1)
+
+	return count
+}
+
+// SetRoleChange set a specific roleChange in the store
+func (k Keeper) SetRoleChange(ctx sdk.Context, roleChange types.RoleChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleChangeKey))
+	b := k.cdc.MustMarshal(&roleChange)
+	store.Set(GetRoleChangeIDBytes(roleChange.Id), b)
+}
+
+// GetRoleChange returns a roleChange from its id
+func (k Keeper) GetRoleChange(ctx sdk.Context, id uint64) (val types.RoleChange, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleChangeKey))
+	b := store.Get(GetRoleChangeIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRoleChange removes a roleChange from the store
+func (k Keeper) RemoveRoleChange(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleChangeKey))
+	store.Delete(GetRoleChangeIDBytes(id))
+}
+
+// GetAllRoleChange returns all roleChange
+func (k Keeper) GetAllRoleChange(ctx sdk.Context) (list []types.RoleChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleChangeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoleChange
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRoleChangeIDBytes returns the byte representation of the ID
+func GetRoleChangeIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetRoleChangeIDFromBytes returns ID in uint64 format from a byte array
+func GetRoleChangeIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetRoleHolder returns the address currently holding the role
+func (k Keeper) GetRoleHolder(ctx sdk.Context, role types.Role) (string, bool) {
+	switch role {
+	case types.RoleOwner:
+		owner, found := k.GetOwner(ctx)
+		return owner.Address, found
+	case types.RoleMasterMinter:
+		masterMinter, found := k.GetMasterMinter(ctx)
+		return masterMinter.Address, found
+	case types.RolePauser:
+		pauser, found := k.GetPauser(ctx)
+		return pauser.Address, found
+	case types.RoleBlacklister:
+		blacklister, found := k.GetBlacklister(ctx)
+		return blacklister.Address, found
+	case types.RoleAttester:
+		attester, found := k.GetAttester(ctx)
+		return attester.Address, found
+	default:
+		return "", false
+	}
+}
+
+// SetRoleHolder assigns the role to the address and emits EventRoleChanged
+func (k Keeper) SetRoleHolder(ctx sdk.Context, role types.Role, address string, actor string) error {
+	previous, _ := k.GetRoleHolder(ctx, role)
+
+	switch role {
+	case types.RoleOwner:
+		k.SetOwner(ctx, types.Owner{Address: address})
+	case types.RoleMasterMinter:
+		k.SetMasterMinter(ctx, types.MasterMinter{Address: address})
+	case types.RolePauser:
+		k.SetPauser(ctx, types.Pauser{Address: address})
+	case types.RoleBlacklister:
+		k.SetBlacklister(ctx, types.Blacklister{Address: address})
+	case types.RoleAttester:
+		k.SetAttester(ctx, types.Attester{Address: address})
+	default:
+		panic(fmt.Sprintf("unknown role %s", role))
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRoleChanged{
+		Role:     role,
+		Previous: previous,
+		Current:  address,
+		Actor:    actor,
+	})
+}
+
+// QueueRoleChange assigns the role to the address once the RoleChangeDelay param has passed.
+// The role is assigned immediately while no delay is configured.
+func (k Keeper) QueueRoleChange(ctx sdk.Context, role types.Role, address string, actor string) error {
+	delay := k.RoleChangeDelay(ctx)
+	if delay == 0 {
+		return k.SetRoleHolder(ctx, role, address, actor)
+	}
+
+	previous, _ := k.GetRoleHolder(ctx, role)
+
+	roleChange := types.RoleChange{
+		Role:        role,
+		Address:     address,
+		Previous:    previous,
+		ExecuteAt:   ctx.BlockTime().Add(delay),
+		RequestedBy: actor,
+	}
+
+	roleChange.Id = k.AppendRoleChange(ctx, roleChange)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRoleChangeQueued{RoleChange: roleChange})
+}
+
+// ExecuteDueRoleChanges assigns the roles of every queued roleChange whose delay has passed
+func (k Keeper) ExecuteDueRoleChanges(ctx sdk.Context) error {
+	for _, roleChange := range k.GetAllRoleChange(ctx) {
+		if ctx.BlockTime().Before(roleChange.ExecuteAt) {
+			continue
+		}
+
+		if err := k.SetRoleHolder(ctx, roleChange.Role, roleChange.Address, roleChange.RequestedBy); err != nil {
+			return err
+		}
+		k.RemoveRoleChange(ctx, roleChange.Id)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleChangeExecuted{RoleChange: roleChange}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CancelQueuedRoleChange removes a queued roleChange before it takes effect
+func (k Keeper) CancelQueuedRoleChange(ctx sdk.Context, id uint64, cancelledBy string) error {
+	roleChange, found := k.GetRoleChange(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRoleChange, "role change with id %d doesn't exist", id)
+	}
+
+	k.RemoveRoleChange(ctx, id)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRoleChangeCancelled{
+		RoleChange:  roleChange,
+		CancelledBy: cancelledBy,
+	})
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createNRoleChange(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RoleChange {
+	items := make([]types.RoleChange, n)
+	for i := range items {
+		items[i].Role = types.RolePauser
+		items[i].Address = sample.AccAddress()
+		items[i].ExecuteAt = time.Unix(int64(i), 0).UTC()
+		items[i].Id = keeper.AppendRoleChange(ctx, items[i])
+	}
+	return items
+}
+
+func TestRoleChangeGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRoleChange(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetRoleChange(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestRoleChangeRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRoleChange(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRoleChange(ctx, item.Id)
+		_, found := keeper.GetRoleChange(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestRoleChangeGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRoleChange(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRoleChange(ctx)),
+	)
+}
+
+func TestRoleChangeCount(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRoleChange(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetRoleChangeCount(ctx))
+}
+
+func TestExecuteDueRoleChanges(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	items := createNRoleChange(keeper, ctx, 10)
+
+	require.NoError(t, keeper.ExecuteDueRoleChanges(ctx.WithBlockTime(items[4].ExecuteAt)))
+
+	for _, item := range items {
+		_, found := keeper.GetRoleChange(ctx, item.Id)
+		require.Equal(t, item.Id > 4, found)
+	}
+
+	pauser, found := keeper.GetPauser(ctx)
+	require.True(t, found)
+	require.Equal(t, items[4].Address, pauser.Address)
+}
+
+func TestQueueRoleChange(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	owner := sample.AccAddress()
+	pauser := sample.AccAddress()
+
+	require.NoError(t, keeper.QueueRoleChange(ctx, types.RolePauser, pauser, owner))
+	got, found := keeper.GetRoleHolder(ctx, types.RolePauser)
+	require.True(t, found)
+	require.Equal(t, pauser, got)
+
+	keeper.SetParams(ctx, types.NewParams(types.DefaultEnforceReserves, time.Hour, types.DefaultFeeConversionRate))
+	next := sample.AccAddress()
+	require.NoError(t, keeper.QueueRoleChange(ctx, types.RolePauser, next, owner))
+
+	got, _ = keeper.GetRoleHolder(ctx, types.RolePauser)
+	require.Equal(t, pauser, got)
+
+	roleChanges := keeper.GetAllRoleChange(ctx)
+	require.Len(t, roleChanges, 1)
+	require.Equal(t, pauser, roleChanges[0].Previous)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), roleChanges[0].ExecuteAt)
+	require.Equal(t, owner, roleChanges[0].RequestedBy)
+
+	require.NoError(t, keeper.CancelQueuedRoleChange(ctx, roleChanges[0].Id, pauser))
+	require.Empty(t, keeper.GetAllRoleChange(ctx))
+	require.ErrorIs(t, keeper.CancelQueuedRoleChange(ctx, roleChanges[0].Id, pauser), types.ErrRoleChange)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSupplyCap set supplyCap in the store
+func (k Keeper) SetSupplyCap(ctx sdk.Context, supplyCap types.SupplyCap) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&supplyCap)
+	store.Set(types.KeyPrefix(types.SupplyCapKey), b)
+}
+
+// GetSupplyCap returns supplyCap
+func (k Keeper) GetSupplyCap(ctx sdk.Context) (val types.SupplyCap, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(types.KeyPrefix(types.SupplyCapKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSupplyCap removes supplyCap from the store
+func (k Keeper) RemoveSupplyCap(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(types.SupplyCapKey))
+}
+
+// ValidateSupplyCap returns an error if adding the given amount to the total supply
+// would exceed the supply cap. Minting is uncapped while no supply cap is set.
+// Tokens sent out over IBC are held in escrow and remain part of the total supply,
+// so their return can never push the supply above the cap.
+func (k Keeper) ValidateSupplyCap(ctx sdk.Context, amount sdk.Coin) error {
+	supplyCap, found := k.GetSupplyCap(ctx)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Add(amount)
+
+	if supplyCap.Amount.IsLT(supply) {
+		return sdkerrors.Wrapf(types.ErrSupplyCapExceeded, "total supply (%s) would exceed the supply cap (%s)", supply, supplyCap.Amount)
+	}
+
+	return nil
+}
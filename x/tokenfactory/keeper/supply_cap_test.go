@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/nullify"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+func createTestSupplyCap(keeper *keeper.Keeper, ctx sdk.Context) types.SupplyCap {
+	item := types.SupplyCap{Amount: sdk.NewInt64Coin("uusdc", 1000000)}
+	keeper.SetSupplyCap(ctx, item)
+	return item
+}
+
+func TestSupplyCapGet(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	item := createTestSupplyCap(keeper, ctx)
+	rst, found := keeper.GetSupplyCap(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestSupplyCapRemove(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	createTestSupplyCap(keeper, ctx)
+	keeper.RemoveSupplyCap(ctx)
+	_, found := keeper.GetSupplyCap(ctx)
+	require.False(t, found)
+}
+
+func TestValidateSupplyCapUnset(t *testing.T) {
+	keeper, ctx := keepertest.TokenfactoryKeeper(t)
+	require.NoError(t, keeper.ValidateSupplyCap(ctx, sdk.NewInt64Coin("uusdc", 100)))
+}
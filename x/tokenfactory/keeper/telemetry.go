@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Telemetry keys and labels of the tokenfactory metrics
+const (
+	MetricKeyMint             = "mint"
+	MetricKeyBurn             = "burn"
+	MetricKeyAmount           = "amount"
+	MetricKeyPaused           = "paused"
+	MetricKeyBlacklistSize    = "blacklist_size"
+	MetricKeyRejectedTransfer = "rejected_transfer"
+
+	MetricLabelMinter = "minter"
+	MetricLabelDenom  = "denom"
+	MetricLabelReason = "reason"
+	MetricLabelSource = "source"
+)
+
+// Sources of rejected transfers reported by IncrRejectedTransfer
+const (
+	RejectionSourceAnte = "ante"
+	RejectionSourceIBC  = "ibc"
+	RejectionSourceICA  = "ica"
+)
+
+// incrSupplyChange counts a mint or burn of the minter and adds its amount
+func incrSupplyChange(key string, minter string, amount sdk.Coin) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(MetricLabelMinter, minter),
+		telemetry.NewLabel(MetricLabelDenom, amount.Denom),
+	}
+
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, key}, 1, labels)
+
+	if amount.Amount.IsInt64() {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, key, MetricKeyAmount}, float32(amount.Amount.Int64()), labels)
+	}
+}
+
+// SetStateGauges reports whether the token is paused and the number of blacklisted addresses. It is
+// called at the end of each block so that the gauges are not set by CheckTx or simulated transactions.
+func (k Keeper) SetStateGauges(ctx sdk.Context) {
+	var paused float32
+	if ctx.KVStore(k.storeKey).Has(types.KeyPrefix(types.PausedKey)) && k.GetPaused(ctx).Paused {
+		paused = 1
+	}
+	telemetry.SetGauge(paused, types.ModuleName, MetricKeyPaused)
+
+	telemetry.SetGauge(float32(k.GetBlacklistedCount(ctx)), types.ModuleName, MetricKeyBlacklistSize)
+}
+
+// IncrRejectedTransfer counts a transfer rejected by the ante decorators or the IBC middlewares
+func IncrRejectedTransfer(source string, reason types.CheckReason) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricKeyRejectedTransfer},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(MetricLabelSource, source),
+			telemetry.NewLabel(MetricLabelReason, reason.String()),
+		},
+	)
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/strangelove-ventures/hero/testutil/keeper"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// setupMetrics replaces the global metrics sink with an in-memory sink
+func setupMetrics(t *testing.T) *metrics.InmemSink {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	cfg.EnableServiceLabel = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	return sink
+}
+
+// findMetric returns the name of the first metric with the given prefix, including its labels
+func findMetric(names []string, prefix string) (string, bool) {
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func TestSupplyChangeMetrics(t *testing.T) {
+	sink := setupMetrics(t)
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	minter := sample.AccAddress()
+
+	k.RecordMint(ctx, minter, sdk.NewInt64Coin("uusdc", 10))
+	k.RecordMint(ctx, minter, sdk.NewInt64Coin("uusdc", 5))
+	k.RecordBurn(ctx, minter, sdk.NewInt64Coin("uusdc", 3))
+
+	counters := sink.Data()[0].Counters
+	names := make([]string, 0, len(counters))
+	for name := range counters {
+		names = append(names, name)
+	}
+
+	name, found := findMetric(names, "tokenfactory.mint;minter="+minter)
+	require.True(t, found)
+	require.Equal(t, 2, counters[name].Count)
+
+	name, found = findMetric(names, "tokenfactory.mint.amount;minter="+minter)
+	require.True(t, found)
+	require.Equal(t, float64(15), counters[name].Sum)
+
+	name, found = findMetric(names, "tokenfactory.burn.amount;minter="+minter)
+	require.True(t, found)
+	require.Equal(t, float64(3), counters[name].Sum)
+}
+
+func TestStateGauges(t *testing.T) {
+	sink := setupMetrics(t)
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	pauser := sample.AccAddress()
+	blacklister := sample.AccAddress()
+	k.SetPauser(ctx, types.Pauser{Address: pauser})
+	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister})
+
+	_, err := srv.Pause(wctx, &types.MsgPause{From: pauser})
+	require.NoError(t, err)
+
+	// the gauges are only set at the end of the block
+	_, found := sink.Data()[0].Gauges["tokenfactory.paused"]
+	require.False(t, found)
+
+	k.SetStateGauges(ctx)
+	require.Equal(t, float32(1), sink.Data()[0].Gauges["tokenfactory.paused"].Value)
+
+	_, err = srv.Unpause(wctx, &types.MsgUnpause{From: pauser})
+	require.NoError(t, err)
+	k.SetStateGauges(ctx)
+	require.Equal(t, float32(0), sink.Data()[0].Gauges["tokenfactory.paused"].Value)
+
+	for i := 0; i < 2; i++ {
+		_, err = srv.Blacklist(wctx, &types.MsgBlacklist{From: blacklister, Address: sample.AccAddress()})
+		require.NoError(t, err)
+	}
+	k.SetStateGauges(ctx)
+	require.Equal(t, float32(2), sink.Data()[0].Gauges["tokenfactory.blacklist_size"].Value)
+}
+
+func TestBlacklistedCount(t *testing.T) {
+	k, ctx := keepertest.TokenfactoryKeeper(t)
+	address := sample.AccAddress()
+
+	k.SetBlacklisted(ctx, types.Blacklisted{Address: address})
+	k.SetBlacklisted(ctx, types.Blacklisted{Address: address})
+	k.SetBlacklisted(ctx, types.Blacklisted{Address: sample.AccAddress()})
+	require.Equal(t, uint64(2), k.GetBlacklistedCount(ctx))
+
+	k.RemoveBlacklisted(ctx, address)
+	k.RemoveBlacklisted(ctx, address)
+	k.RemoveBlacklisted(ctx, sample.AccAddress())
+	require.Equal(t, uint64(1), k.GetBlacklistedCount(ctx))
+}
+
+func TestRejectedTransferMetrics(t *testing.T) {
+	sink := setupMetrics(t)
+
+	keeper.IncrRejectedTransfer(keeper.RejectionSourceAnte, types.CheckReasonPaused)
+	keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, types.CheckReasonSenderBlacklisted)
+	keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, types.CheckReasonSenderBlacklisted)
+
+	counters := sink.Data()[0].Counters
+	require.Equal(t, 1, counters["tokenfactory.rejected_transfer;source=ante;reason="+types.CheckReasonPaused.String()].Count)
+	require.Equal(t, 2, counters["tokenfactory.rejected_transfer;source=ibc;reason="+types.CheckReasonSenderBlacklisted.String()].Count)
+}
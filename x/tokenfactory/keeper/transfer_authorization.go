@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// verifyTransferAuthorization checks that the authorization is valid at the current block time,
+// that its nonce has not been used or cancelled and that it was signed by the account of the holder.
+func (k Keeper) verifyTransferAuthorization(ctx sdk.Context, authorization types.TransferAuthorization, signature []byte) error {
+	if !ctx.BlockTime().After(authorization.ValidAfter) {
+		return sdkerrors.Wrapf(types.ErrAuthorization, "authorization is not valid before %s", authorization.ValidAfter)
+	}
+
+	if !ctx.BlockTime().Before(authorization.ValidBefore) {
+		return sdkerrors.Wrapf(types.ErrAuthorization, "authorization expired at %s", authorization.ValidBefore)
+	}
+
+	if state, found := k.GetAuthorizationState(ctx, authorization.From, authorization.Nonce); found {
+		return sdkerrors.Wrapf(types.ErrAuthorization, "nonce %s is already %s", authorization.Nonce, state.Status)
+	}
+
+	from, err := sdk.AccAddressFromBech32(authorization.From)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrAuthorization, err.Error())
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, from)
+	if account == nil || account.GetPubKey() == nil {
+		return sdkerrors.Wrapf(types.ErrAuthorization, "authorizer %s has no public key", authorization.From)
+	}
+
+	if !account.GetPubKey().VerifySignature(authorization.GetSignBytes(ctx.ChainID()), signature) {
+		return sdkerrors.Wrap(types.ErrAuthorization, "signature does not match the authorizer")
+	}
+
+	return nil
+}
@@ -80,6 +80,46 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRemoveMinterController int = 100
 
+	opWeightMsgUpdateSupplyCap = "op_weight_msg_update_supply_cap"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateSupplyCap int = 100
+
+	opWeightMsgUpdateQuorum = "op_weight_msg_update_quorum"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateQuorum int = 100
+
+	opWeightMsgSubmitOperation = "op_weight_msg_submit_operation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitOperation int = 100
+
+	opWeightMsgApproveOperation = "op_weight_msg_approve_operation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgApproveOperation int = 100
+
+	opWeightMsgAddGuardian = "op_weight_msg_add_guardian"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAddGuardian int = 100
+
+	opWeightMsgRemoveGuardian = "op_weight_msg_remove_guardian"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRemoveGuardian int = 100
+
+	opWeightMsgTransferWithAuthorization = "op_weight_msg_transfer_with_authorization"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferWithAuthorization int = 100
+
+	opWeightMsgCancelAuthorization = "op_weight_msg_cancel_authorization"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelAuthorization int = 100
+
+	opWeightMsgAllowChannel = "op_weight_msg_allow_channel"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAllowChannel int = 100
+
+	opWeightMsgDisallowChannel = "op_weight_msg_disallow_channel"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDisallowChannel int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -90,7 +130,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 		accs[i] = acc.Address.String()
 	}
 	tokenfactoryGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:       types.DefaultParams(),
+		PortId:       types.PortID,
+		BridgePortId: types.BridgePortID,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&tokenfactoryGenesis)
@@ -268,6 +310,116 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		tokenfactorysimulation.SimulateMsgRemoveMinterController(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgUpdateSupplyCap int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateSupplyCap, &weightMsgUpdateSupplyCap, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateSupplyCap = defaultWeightMsgUpdateSupplyCap
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateSupplyCap,
+		tokenfactorysimulation.SimulateMsgUpdateSupplyCap(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateQuorum int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateQuorum, &weightMsgUpdateQuorum, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateQuorum = defaultWeightMsgUpdateQuorum
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateQuorum,
+		tokenfactorysimulation.SimulateMsgUpdateQuorum(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitOperation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitOperation, &weightMsgSubmitOperation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitOperation = defaultWeightMsgSubmitOperation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitOperation,
+		tokenfactorysimulation.SimulateMsgSubmitOperation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgApproveOperation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgApproveOperation, &weightMsgApproveOperation, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveOperation = defaultWeightMsgApproveOperation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgApproveOperation,
+		tokenfactorysimulation.SimulateMsgApproveOperation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAddGuardian int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAddGuardian, &weightMsgAddGuardian, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddGuardian = defaultWeightMsgAddGuardian
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAddGuardian,
+		tokenfactorysimulation.SimulateMsgAddGuardian(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRemoveGuardian int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRemoveGuardian, &weightMsgRemoveGuardian, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveGuardian = defaultWeightMsgRemoveGuardian
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveGuardian,
+		tokenfactorysimulation.SimulateMsgRemoveGuardian(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransferWithAuthorization int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransferWithAuthorization, &weightMsgTransferWithAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferWithAuthorization = defaultWeightMsgTransferWithAuthorization
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferWithAuthorization,
+		tokenfactorysimulation.SimulateMsgTransferWithAuthorization(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelAuthorization int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancelAuthorization, &weightMsgCancelAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelAuthorization = defaultWeightMsgCancelAuthorization
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelAuthorization,
+		tokenfactorysimulation.SimulateMsgCancelAuthorization(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAllowChannel int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAllowChannel, &weightMsgAllowChannel, nil,
+		func(_ *rand.Rand) {
+			weightMsgAllowChannel = defaultWeightMsgAllowChannel
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAllowChannel,
+		tokenfactorysimulation.SimulateMsgAllowChannel(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDisallowChannel int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDisallowChannel, &weightMsgDisallowChannel, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisallowChannel = defaultWeightMsgDisallowChannel
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDisallowChannel,
+		tokenfactorysimulation.SimulateMsgDisallowChannel(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -0,0 +1,30 @@
+package tokenfactory
+
+import (
+	adminmoduletypes "github.com/cosmos/admin-module/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+// NewProposalHandler creates a governance handler for the tokenfactory proposals submitted through the admin module.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CancelRoleChangeProposal:
+			return k.CancelQueuedRoleChange(ctx, c.Id, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		case *types.SetRateLimitProposal:
+			return k.UpdateRateLimit(ctx, c.ChannelId, c.Direction, c.MaxAmount, c.MaxSupplyFraction, c.Window, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		case *types.RemoveRateLimitProposal:
+			return k.DeleteRateLimit(ctx, c.ChannelId, c.Direction, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized tokenfactory proposal content type: %T", c)
+		}
+	}
+}
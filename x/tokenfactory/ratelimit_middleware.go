@@ -0,0 +1,224 @@
+package tokenfactory
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/keeper"
+	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
+)
+
+var (
+	_ porttypes.IBCModule   = &RateLimitMiddleware{}
+	_ porttypes.ICS4Wrapper = &RateLimitICS4Wrapper{}
+)
+
+// RateLimitMiddleware counts the minting denom received through each channel against the inflow
+// rate limit of the channel. Packets over the quota are acknowledged with an error so that the
+// tokens are refunded on the counterparty chain.
+type RateLimitMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewRateLimitMiddleware creates a new RateLimitMiddleware given the keeper and underlying application.
+func NewRateLimitMiddleware(app porttypes.IBCModule, k keeper.Keeper) RateLimitMiddleware {
+	return RateLimitMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (version string, err error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im RateLimitMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket adds the received amount of the minting denom to the inflow of the destination channel
+// and returns an error acknowledgement if it exceeds the quota. The flow is reverted along with the
+// rest of the packet state if the underlying application returns an error acknowledgement.
+func (im RateLimitMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if _, found := im.keeper.GetRateLimit(ctx, packet.GetDestChannel(), types.RateLimitInflow); !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !isReceivedMintingDenom(packet, data.Denom, im.keeper.GetMintingDenom(ctx).Denom) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if reason, err := im.keeper.ConsumeRateLimit(ctx, packet.GetDestChannel(), types.RateLimitInflow, amount); err != nil {
+		keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket refunds the outflow of a transfer of the minting denom that the
+// counterparty chain rejected.
+func (im RateLimitMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		im.refundOutflow(ctx, packet)
+	} else {
+		im.keeper.RemoveRateLimitPacketWindow(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket refunds the outflow of a transfer of the minting denom that timed out.
+func (im RateLimitMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	im.refundOutflow(ctx, packet)
+
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// refundOutflow removes a sent transfer of the minting denom from the outflow of its source channel
+// if the window that counted the transfer is still the current one.
+func (im RateLimitMiddleware) refundOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	windowStart, found := im.keeper.GetRateLimitPacketWindow(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	im.keeper.RemoveRateLimitPacketWindow(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	if amount, ok := sdk.NewIntFromString(data.Amount); ok {
+		im.keeper.RefundRateLimit(ctx, packet.GetSourceChannel(), types.RateLimitOutflow, windowStart, amount)
+	}
+}
+
+// RateLimitICS4Wrapper counts the minting denom sent through each channel against the outflow rate
+// limit of the channel and rejects transfers over the quota before the packet is sent.
+type RateLimitICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewRateLimitICS4Wrapper creates a new RateLimitICS4Wrapper given the keeper and the underlying channel keeper.
+func NewRateLimitICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) RateLimitICS4Wrapper {
+	return RateLimitICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket adds the sent amount of the minting denom to the outflow of the source channel and
+// returns an error if it exceeds the quota. The window that counted the packet is recorded so that
+// a refund of the packet is only credited to that window.
+func (w RateLimitICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if _, found := w.keeper.GetRateLimit(ctx, packet.GetSourceChannel(), types.RateLimitOutflow); !found {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if ok && data.Denom == w.keeper.GetMintingDenom(ctx).Denom {
+		if reason, err := w.keeper.ConsumeRateLimit(ctx, packet.GetSourceChannel(), types.RateLimitOutflow, amount); err != nil {
+			keeper.IncrRejectedTransfer(keeper.RejectionSourceIBC, reason)
+			return err
+		}
+
+		rateLimit, _, _ := w.keeper.RateLimitUsage(ctx, packet.GetSourceChannel(), types.RateLimitOutflow)
+		w.keeper.SetRateLimitPacketWindow(ctx, packet.GetSourceChannel(), packet.GetSequence(), rateLimit.WindowStart)
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (w RateLimitICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// isReceivedMintingDenom returns true if a received packet returns the minting denom of this chain,
+// that is if its denom is the minting denom prefixed with the source port and channel.
+func isReceivedMintingDenom(packet channeltypes.Packet, denom string, mintingDenom string) bool {
+	if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		return false
+	}
+
+	prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	return strings.TrimPrefix(denom, prefix) == mintingDenom
+}
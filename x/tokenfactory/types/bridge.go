@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BridgeMinterAddress returns the minter address tokens received over a bridge route are minted
+// by. Its allowance caps the supply that can be minted through the bridge.
+func BridgeMinterAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(BridgeMinterName)
+}
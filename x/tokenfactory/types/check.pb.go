@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/check.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckReason enumerates why a transfer or mint of the minting denom would be rejected.
+type CheckReason int32
+
+const (
+	// the transfer or mint is allowed
+	CheckReasonOk                  CheckReason = 0
+	CheckReasonPaused              CheckReason = 1
+	CheckReasonSenderBlacklisted   CheckReason = 2
+	CheckReasonReceiverBlacklisted CheckReason = 3
+	CheckReasonNotMinter           CheckReason = 4
+	CheckReasonQuorumRequired      CheckReason = 5
+	CheckReasonInvalidDenom        CheckReason = 6
+	CheckReasonAllowanceExceeded   CheckReason = 7
+	CheckReasonSupplyCapExceeded   CheckReason = 8
+	CheckReasonReservesExceeded    CheckReason = 9
+	CheckReasonRateLimitExceeded   CheckReason = 10
+	CheckReasonChannelNotAllowed   CheckReason = 11
+)
+
+var CheckReason_name = map[int32]string{
+	0:  "CHECK_REASON_OK",
+	1:  "CHECK_REASON_PAUSED",
+	2:  "CHECK_REASON_SENDER_BLACKLISTED",
+	3:  "CHECK_REASON_RECEIVER_BLACKLISTED",
+	4:  "CHECK_REASON_NOT_MINTER",
+	5:  "CHECK_REASON_QUORUM_REQUIRED",
+	6:  "CHECK_REASON_INVALID_DENOM",
+	7:  "CHECK_REASON_ALLOWANCE_EXCEEDED",
+	8:  "CHECK_REASON_SUPPLY_CAP_EXCEEDED",
+	9:  "CHECK_REASON_RESERVES_EXCEEDED",
+	10: "CHECK_REASON_RATE_LIMIT_EXCEEDED",
+	11: "CHECK_REASON_CHANNEL_NOT_ALLOWED",
+}
+
+var CheckReason_value = map[string]int32{
+	"CHECK_REASON_OK":                   0,
+	"CHECK_REASON_PAUSED":               1,
+	"CHECK_REASON_SENDER_BLACKLISTED":   2,
+	"CHECK_REASON_RECEIVER_BLACKLISTED": 3,
+	"CHECK_REASON_NOT_MINTER":           4,
+	"CHECK_REASON_QUORUM_REQUIRED":      5,
+	"CHECK_REASON_INVALID_DENOM":        6,
+	"CHECK_REASON_ALLOWANCE_EXCEEDED":   7,
+	"CHECK_REASON_SUPPLY_CAP_EXCEEDED":  8,
+	"CHECK_REASON_RESERVES_EXCEEDED":    9,
+	"CHECK_REASON_RATE_LIMIT_EXCEEDED":  10,
+	"CHECK_REASON_CHANNEL_NOT_ALLOWED":  11,
+}
+
+func (x CheckReason) String() string {
+	return proto.EnumName(CheckReason_name, int32(x))
+}
+
+func (CheckReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_149ea54c88ba85a3, []int{0}
+}
+
+// TransferPath enumerates how tokens leave the sender account.
+type TransferPath int32
+
+const (
+	// a bank send on this chain
+	TransferPathBank TransferPath = 0
+	// an ICS-20 transfer to another chain
+	TransferPathIbc TransferPath = 1
+)
+
+var TransferPath_name = map[int32]string{
+	0: "TRANSFER_PATH_BANK",
+	1: "TRANSFER_PATH_IBC",
+}
+
+var TransferPath_value = map[string]int32{
+	"TRANSFER_PATH_BANK": 0,
+	"TRANSFER_PATH_IBC":  1,
+}
+
+func (x TransferPath) String() string {
+	return proto.EnumName(TransferPath_name, int32(x))
+}
+
+func (TransferPath) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_149ea54c88ba85a3, []int{1}
+}
+
+func init() {
+	proto.RegisterEnum("hero.tokenfactory.CheckReason", CheckReason_name, CheckReason_value)
+	proto.RegisterEnum("hero.tokenfactory.TransferPath", TransferPath_name, TransferPath_value)
+}
+
+func init() { proto.RegisterFile("tokenfactory/check.proto", fileDescriptor_149ea54c88ba85a3) }
+
+var fileDescriptor_149ea54c88ba85a3 = []byte{
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x13, 0x28, 0x05, 0xae, 0xa0, 0x3a, 0x6e, 0x51, 0x8b, 0x5b, 0x5c, 0xc3, 0xc0, 0x50,
+	0x41, 0x32, 0x20, 0x06, 0xc4, 0x80, 0x1c, 0xfb, 0xaa, 0x5a, 0x75, 0x6c, 0xf7, 0xec, 0x94, 0x3f,
+	0x8b, 0xe5, 0xda, 0x6f, 0x13, 0x2b, 0xce, 0x5d, 0xb0, 0xcf, 0xa1, 0xfd, 0x06, 0xc8, 0x13, 0x13,
+	0x9b, 0x27, 0xbe, 0x0c, 0x63, 0x47, 0x46, 0xd4, 0x7e, 0x11, 0x94, 0x54, 0xa2, 0x76, 0xa3, 0x6e,
+	0x96, 0xee, 0xf7, 0xfc, 0x7c, 0x7a, 0x1f, 0xbd, 0x87, 0x36, 0x39, 0x1b, 0x01, 0x3d, 0x09, 0x42,
+	0xce, 0xd2, 0xb3, 0x4e, 0x38, 0x84, 0x70, 0xd4, 0x9e, 0xa4, 0x8c, 0x33, 0xb1, 0x35, 0x84, 0x94,
+	0xb5, 0xab, 0xc7, 0xd2, 0xfa, 0x80, 0x0d, 0xd8, 0xfc, 0xb4, 0x33, 0xfb, 0xba, 0x02, 0x77, 0x7f,
+	0x2e, 0xa3, 0x15, 0x6d, 0x16, 0x24, 0x10, 0x64, 0x8c, 0x8a, 0x2f, 0xd1, 0xaa, 0xb6, 0x8f, 0xb5,
+	0x03, 0x9f, 0x60, 0xd5, 0xb5, 0x2d, 0xdf, 0x3e, 0x10, 0x1a, 0x52, 0xab, 0x28, 0x95, 0xc7, 0x15,
+	0xca, 0x1e, 0x89, 0x6d, 0xb4, 0x56, 0xe3, 0x1c, 0xb5, 0xef, 0x62, 0x5d, 0x68, 0x4a, 0x4f, 0x8a,
+	0x52, 0x69, 0x55, 0x58, 0x27, 0xc8, 0x33, 0x88, 0x44, 0x8c, 0x76, 0x6a, 0xbc, 0x8b, 0x2d, 0x1d,
+	0x13, 0xbf, 0x6b, 0xaa, 0xda, 0x81, 0x69, 0xb8, 0x1e, 0xd6, 0x85, 0x3b, 0x92, 0x52, 0x94, 0xca,
+	0x76, 0x25, 0xeb, 0x02, 0x8d, 0x20, 0xed, 0x26, 0x41, 0x38, 0x4a, 0xe2, 0x8c, 0x43, 0x24, 0x1a,
+	0xe8, 0x79, 0x4d, 0x43, 0xb0, 0x86, 0x8d, 0xa3, 0x1b, 0xa2, 0xbb, 0xd2, 0x8b, 0xa2, 0x54, 0xe4,
+	0x8a, 0x88, 0x40, 0x08, 0xf1, 0xb4, 0xae, 0x7a, 0x8b, 0x36, 0x6a, 0x2a, 0xcb, 0xf6, 0xfc, 0x9e,
+	0x61, 0x79, 0x98, 0x08, 0x4b, 0xd2, 0x66, 0x51, 0x2a, 0xeb, 0x15, 0x81, 0xc5, 0x78, 0x2f, 0xa6,
+	0x1c, 0x52, 0xf1, 0x03, 0xda, 0xae, 0xc5, 0x0e, 0xfb, 0x36, 0xe9, 0xf7, 0x7c, 0x82, 0x0f, 0xfb,
+	0x06, 0xc1, 0xba, 0x70, 0x4f, 0x7a, 0x56, 0x94, 0xca, 0xd3, 0x4a, 0xf6, 0x30, 0x67, 0x69, 0x3e,
+	0x26, 0xf0, 0x35, 0x8f, 0x53, 0x88, 0xc4, 0xf7, 0x48, 0xaa, 0x09, 0x0c, 0xeb, 0x48, 0x35, 0x0d,
+	0xdd, 0xd7, 0xb1, 0x65, 0xf7, 0x84, 0x65, 0x69, 0xab, 0x28, 0x95, 0x8d, 0x4a, 0xdc, 0xa0, 0xd3,
+	0x20, 0x89, 0x23, 0x1d, 0x28, 0x1b, 0x2f, 0x8c, 0x51, 0x35, 0x4d, 0xfb, 0xa3, 0x6a, 0x69, 0xd8,
+	0xc7, 0x9f, 0x34, 0x8c, 0x75, 0xac, 0x0b, 0xf7, 0x17, 0xc6, 0xa8, 0x26, 0x09, 0xfb, 0x16, 0xd0,
+	0x10, 0xf0, 0x69, 0x08, 0x10, 0x41, 0x24, 0xee, 0x21, 0xa5, 0xde, 0x46, 0xdf, 0x71, 0xcc, 0xcf,
+	0xbe, 0xa6, 0x3a, 0xd7, 0x9e, 0x07, 0x8b, 0x75, 0xe4, 0x93, 0x49, 0x72, 0xa6, 0x05, 0x93, 0xff,
+	0x1e, 0x0d, 0xc9, 0x37, 0xea, 0x70, 0x31, 0x39, 0xc2, 0xee, 0xb5, 0xe5, 0xa1, 0xb4, 0x53, 0x94,
+	0xca, 0x56, 0xad, 0x8b, 0x0c, 0xd2, 0x29, 0x64, 0xb7, 0x5e, 0x86, 0xa8, 0x1e, 0xf6, 0x4d, 0xa3,
+	0x67, 0x78, 0xd7, 0x1a, 0xb4, 0x70, 0x19, 0x12, 0x70, 0x30, 0xe3, 0x71, 0xcc, 0x6f, 0xf5, 0x68,
+	0xfb, 0xaa, 0x65, 0x61, 0x73, 0x5e, 0xec, 0x7c, 0x4e, 0x58, 0x17, 0x56, 0x16, 0x3c, 0xda, 0x30,
+	0xa0, 0x14, 0x12, 0x8b, 0xf1, 0xf9, 0x98, 0x20, 0x92, 0x96, 0xbe, 0xff, 0x92, 0x1b, 0xbb, 0x14,
+	0x3d, 0xf2, 0xd2, 0x80, 0x66, 0x27, 0x90, 0x3a, 0x01, 0x1f, 0x8a, 0xaf, 0x90, 0xe8, 0x11, 0xd5,
+	0x72, 0xf7, 0x30, 0xf1, 0x1d, 0xd5, 0xdb, 0xf7, 0xbb, 0xaa, 0x35, 0xdb, 0x8d, 0xf5, 0xa2, 0x54,
+	0x84, 0x2a, 0xd9, 0x0d, 0xe8, 0x48, 0xdc, 0x45, 0xad, 0x3a, 0x6d, 0x74, 0x35, 0xa1, 0x29, 0xad,
+	0x15, 0xa5, 0xb2, 0x5a, 0x85, 0x8d, 0xe3, 0xf0, 0xea, 0x7f, 0x5d, 0xf7, 0xf7, 0x85, 0xdc, 0x3c,
+	0xbf, 0x90, 0x9b, 0x7f, 0x2f, 0xe4, 0xe6, 0x8f, 0x4b, 0xb9, 0x71, 0x7e, 0x29, 0x37, 0xfe, 0x5c,
+	0xca, 0x8d, 0x2f, 0xef, 0x06, 0x31, 0x1f, 0xe6, 0xc7, 0xed, 0x90, 0x8d, 0x3b, 0x19, 0x4f, 0x03,
+	0x3a, 0x80, 0x84, 0x4d, 0xe1, 0xf5, 0x14, 0x28, 0xcf, 0x53, 0xc8, 0x3a, 0xb3, 0x5d, 0xef, 0x9c,
+	0x76, 0x6a, 0x8f, 0x01, 0x3f, 0x9b, 0x40, 0x76, 0xbc, 0x3c, 0x5f, 0xf2, 0x37, 0xff, 0x06, 0x00,
+	0x78, 0x14, 0x05, 0xd6, 0x29, 0x04, 0x00, 0x00,
+}
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -22,6 +23,30 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpause{}, "tokenfactory/Unpause", nil)
 	cdc.RegisterConcrete(&MsgConfigureMinterController{}, "tokenfactory/ConfigureMinterController", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinterController{}, "tokenfactory/RemoveMinterController", nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "tokenfactory/RequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgFulfillRedemption{}, "tokenfactory/FulfillRedemption", nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, "tokenfactory/RejectRedemption", nil)
+	cdc.RegisterConcrete(&MsgUpdateAttester{}, "tokenfactory/UpdateAttester", nil)
+	cdc.RegisterConcrete(&MsgSubmitReserveAttestation{}, "tokenfactory/SubmitReserveAttestation", nil)
+	cdc.RegisterConcrete(&MsgUpdateSupplyCap{}, "tokenfactory/UpdateSupplyCap", nil)
+	cdc.RegisterConcrete(&MsgUpdateQuorum{}, "tokenfactory/UpdateQuorum", nil)
+	cdc.RegisterConcrete(&MsgSubmitOperation{}, "tokenfactory/SubmitOperation", nil)
+	cdc.RegisterConcrete(&MsgApproveOperation{}, "tokenfactory/ApproveOperation", nil)
+	cdc.RegisterConcrete(&MsgCancelRoleChange{}, "tokenfactory/CancelRoleChange", nil)
+	cdc.RegisterConcrete(&MsgAddGuardian{}, "tokenfactory/AddGuardian", nil)
+	cdc.RegisterConcrete(&MsgRemoveGuardian{}, "tokenfactory/RemoveGuardian", nil)
+	cdc.RegisterConcrete(&MsgTransferWithAuthorization{}, "tokenfactory/TransferWithAuthorization", nil)
+	cdc.RegisterConcrete(&MsgCancelAuthorization{}, "tokenfactory/CancelAuthorization", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "tokenfactory/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "tokenfactory/RemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgAllowChannel{}, "tokenfactory/AllowChannel", nil)
+	cdc.RegisterConcrete(&MsgDisallowChannel{}, "tokenfactory/DisallowChannel", nil)
+	cdc.RegisterConcrete(&MsgAddBlacklistSyncChannel{}, "tokenfactory/AddBlacklistSyncChannel", nil)
+	cdc.RegisterConcrete(&MsgRemoveBlacklistSyncChannel{}, "tokenfactory/RemoveBlacklistSyncChannel", nil)
+	cdc.RegisterConcrete(&MsgBroadcastBlacklistUpdate{}, "tokenfactory/BroadcastBlacklistUpdate", nil)
+	cdc.RegisterConcrete(&MsgAddBridgeRoute{}, "tokenfactory/AddBridgeRoute", nil)
+	cdc.RegisterConcrete(&MsgRemoveBridgeRoute{}, "tokenfactory/RemoveBridgeRoute", nil)
+	cdc.RegisterConcrete(&MsgBridgeTransfer{}, "tokenfactory/BridgeTransfer", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -68,6 +93,55 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRemoveMinterController{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestRedemption{},
+		&MsgFulfillRedemption{},
+		&MsgRejectRedemption{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateAttester{},
+		&MsgSubmitReserveAttestation{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateSupplyCap{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateQuorum{},
+		&MsgSubmitOperation{},
+		&MsgApproveOperation{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelRoleChange{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&CancelRoleChangeProposal{},
+		&SetRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddGuardian{},
+		&MsgRemoveGuardian{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferWithAuthorization{},
+		&MsgCancelAuthorization{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgAllowChannel{},
+		&MsgDisallowChannel{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddBlacklistSyncChannel{},
+		&MsgRemoveBlacklistSyncChannel{},
+		&MsgBroadcastBlacklistUpdate{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddBridgeRoute{},
+		&MsgRemoveBridgeRoute{},
+		&MsgBridgeTransfer{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
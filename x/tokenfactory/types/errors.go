@@ -8,10 +8,34 @@ import (
 
 // x/tokenfactory module sentinel errors
 var (
-	ErrUnauthorized       = sdkerrors.Register(ModuleName, 2, "unauthorized")
-	ErrUserNotFound       = sdkerrors.Register(ModuleName, 3, "user not found")
-	ErrMint               = sdkerrors.Register(ModuleName, 4, "tokens can not be minted")
-	ErrSendCoinsToAccount = sdkerrors.Register(ModuleName, 5, "can't send tokens to account")
-	ErrBurn               = sdkerrors.Register(ModuleName, 6, "tokens can not be burned")
-	ErrPaused             = sdkerrors.Register(ModuleName, 7, "the chain is paused")
+	ErrUnauthorized             = sdkerrors.Register(ModuleName, 2, "unauthorized")
+	ErrUserNotFound             = sdkerrors.Register(ModuleName, 3, "user not found")
+	ErrMint                     = sdkerrors.Register(ModuleName, 4, "tokens can not be minted")
+	ErrSendCoinsToAccount       = sdkerrors.Register(ModuleName, 5, "can't send tokens to account")
+	ErrBurn                     = sdkerrors.Register(ModuleName, 6, "tokens can not be burned")
+	ErrPaused                   = sdkerrors.Register(ModuleName, 7, "the chain is paused")
+	ErrRedemption               = sdkerrors.Register(ModuleName, 8, "tokens can not be redeemed")
+	ErrAttestation              = sdkerrors.Register(ModuleName, 9, "reserve attestation is invalid")
+	ErrQuorum                   = sdkerrors.Register(ModuleName, 10, "operation requires quorum approval")
+	ErrRoleChange               = sdkerrors.Register(ModuleName, 11, "role change can not be processed")
+	ErrBlacklistedSender        = sdkerrors.Register(ModuleName, 12, "sender address is blacklisted")
+	ErrBlacklistedRecipient     = sdkerrors.Register(ModuleName, 13, "recipient address is blacklisted")
+	ErrAllowanceExceeded        = sdkerrors.Register(ModuleName, 14, "amount exceeds the minter allowance")
+	ErrWrongDenom               = sdkerrors.Register(ModuleName, 15, "denom is incorrect")
+	ErrRoleNotSet               = sdkerrors.Register(ModuleName, 16, "role is not set")
+	ErrSupplyCapExceeded        = sdkerrors.Register(ModuleName, 17, "amount exceeds the supply cap")
+	ErrReservesExceeded         = sdkerrors.Register(ModuleName, 18, "amount exceeds the attested reserves")
+	ErrAuthorization            = sdkerrors.Register(ModuleName, 19, "transfer authorization is invalid")
+	ErrRateLimitExceeded        = sdkerrors.Register(ModuleName, 20, "amount exceeds the channel rate limit")
+	ErrRateLimitNotFound        = sdkerrors.Register(ModuleName, 21, "channel rate limit is not set")
+	ErrChannelNotAllowed        = sdkerrors.Register(ModuleName, 22, "channel is not allowed for the minting denom")
+	ErrNotSyncChannel           = sdkerrors.Register(ModuleName, 23, "channel is not a blacklist sync channel")
+	ErrInvalidPacket            = sdkerrors.Register(ModuleName, 24, "blacklist sync packet is invalid")
+	ErrNotBridgeRoute           = sdkerrors.Register(ModuleName, 25, "channel is not a bridge route")
+	ErrBridgeRouteInFlight      = sdkerrors.Register(ModuleName, 26, "bridge route has transfers in flight")
+	ErrInvalidBridgePacket      = sdkerrors.Register(ModuleName, 27, "bridge packet is invalid")
+	ErrMinterControllerNotFound = sdkerrors.Register(ModuleName, 28, "minter controller not found")
+	ErrRedemptionNotFound       = sdkerrors.Register(ModuleName, 29, "redemption not found")
+	ErrBridgeMinterNotSet       = sdkerrors.Register(ModuleName, 30, "bridge minter is not a minter")
+	ErrSyncChannelNotOpen       = sdkerrors.Register(ModuleName, 31, "channel is not an open blacklist sync channel")
 )
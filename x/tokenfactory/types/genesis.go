@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // DefaultIndex is the default global index
@@ -10,15 +14,31 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		BlacklistedList:      []Blacklisted{},
-		Paused:               nil,
-		MasterMinter:         nil,
-		MintersList:          []Minters{},
-		Pauser:               nil,
-		Blacklister:          nil,
-		Owner:                nil,
-		MinterControllerList: []MinterController{},
-		MintingDenom:         nil,
+		BlacklistedList:          []Blacklisted{},
+		Paused:                   nil,
+		MasterMinter:             nil,
+		MintersList:              []Minters{},
+		Pauser:                   nil,
+		Blacklister:              nil,
+		Owner:                    nil,
+		MinterControllerList:     []MinterController{},
+		MintingDenom:             nil,
+		RedemptionList:           []Redemption{},
+		Attester:                 nil,
+		ReserveAttestationList:   []ReserveAttestation{},
+		SupplyCap:                nil,
+		Quorum:                   nil,
+		PendingOperationList:     []PendingOperation{},
+		RoleChangeList:           []RoleChange{},
+		GuardianList:             []Guardian{},
+		MinterStatsList:          []MinterStats{},
+		AuthorizationStateList:   []AuthorizationState{},
+		RateLimitList:            []RateLimit{},
+		AllowedChannelList:       []AllowedChannel{},
+		BlacklistSyncChannelList: []BlacklistSyncChannel{},
+		PortId:                   PortID,
+		BridgeRouteList:          []BridgeRoute{},
+		BridgePortId:             BridgePortID,
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -57,7 +77,162 @@ func (gs GenesisState) Validate() error {
 		}
 		minterControllerIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated ID in redemption
+	redemptionIdMap := make(map[uint64]bool)
+	redemptionCount := gs.GetRedemptionCount()
+	for _, elem := range gs.RedemptionList {
+		if _, ok := redemptionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for redemption")
+		}
+		if elem.Id >= redemptionCount {
+			return fmt.Errorf("redemption id should be lower or equal than the last id")
+		}
+		redemptionIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in reserveAttestation
+	reserveAttestationIdMap := make(map[uint64]bool)
+	reserveAttestationCount := gs.GetReserveAttestationCount()
+	for _, elem := range gs.ReserveAttestationList {
+		if _, ok := reserveAttestationIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for reserveAttestation")
+		}
+		if elem.Id >= reserveAttestationCount {
+			return fmt.Errorf("reserveAttestation id should be lower or equal than the last id")
+		}
+		reserveAttestationIdMap[elem.Id] = true
+	}
+	if gs.SupplyCap != nil {
+		if err := gs.SupplyCap.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid supplyCap: %w", err)
+		}
+		if gs.MintingDenom != nil && gs.SupplyCap.Amount.Denom != gs.MintingDenom.Denom {
+			return fmt.Errorf("supplyCap denom should match the minting denom")
+		}
+	}
+	if gs.Quorum != nil {
+		if gs.Quorum.Threshold == 0 || gs.Quorum.Threshold > uint64(len(gs.Quorum.Members)) {
+			return fmt.Errorf("quorum threshold should be between 1 and the number of members")
+		}
+	}
+	// Check for duplicated ID in pendingOperation
+	pendingOperationIdMap := make(map[uint64]bool)
+	pendingOperationCount := gs.GetPendingOperationCount()
+	for _, elem := range gs.PendingOperationList {
+		if _, ok := pendingOperationIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pendingOperation")
+		}
+		if elem.Id >= pendingOperationCount {
+			return fmt.Errorf("pendingOperation id should be lower or equal than the last id")
+		}
+		pendingOperationIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in roleChange
+	roleChangeIdMap := make(map[uint64]bool)
+	roleChangeCount := gs.GetRoleChangeCount()
+	for _, elem := range gs.RoleChangeList {
+		if _, ok := roleChangeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for roleChange")
+		}
+		if elem.Id >= roleChangeCount {
+			return fmt.Errorf("roleChange id should be lower or equal than the last id")
+		}
+		roleChangeIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in guardian
+	guardianIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GuardianList {
+		index := string(GuardianKey(elem.Address))
+		if _, ok := guardianIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for guardian")
+		}
+		guardianIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in minterStats
+	minterStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MinterStatsList {
+		index := string(MinterStatsKey(elem.Address))
+		if _, ok := minterStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for minterStats")
+		}
+		minterStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in authorizationState
+	authorizationStateIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AuthorizationStateList {
+		index := string(AuthorizationStateKey(elem.Authorizer, elem.Nonce))
+		if _, ok := authorizationStateIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for authorizationState")
+		}
+		authorizationStateIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in rateLimit
+	rateLimitIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RateLimitList {
+		index := string(RateLimitKey(elem.ChannelId, elem.Direction))
+		if _, ok := rateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimit")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		rateLimitIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in allowedChannel
+	allowedChannelIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AllowedChannelList {
+		index := string(AllowedChannelKey(elem.ChannelId))
+		if _, ok := allowedChannelIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for allowedChannel")
+		}
+		allowedChannelIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in blacklistSyncChannel
+	blacklistSyncChannelIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BlacklistSyncChannelList {
+		index := string(BlacklistSyncChannelKey(elem.ChannelId))
+		if _, ok := blacklistSyncChannelIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for blacklistSyncChannel")
+		}
+		blacklistSyncChannelIndexMap[index] = struct{}{}
+	}
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	// Check for duplicated index in bridgeRoute
+	bridgeRouteIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BridgeRouteList {
+		index := string(BridgeRouteKey(elem.ChannelId))
+		if _, ok := bridgeRouteIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bridgeRoute")
+		}
+		for _, amount := range []sdk.Int{elem.Sent, elem.Received, elem.InFlight} {
+			if amount.IsNil() || amount.IsNegative() {
+				return fmt.Errorf("invalid bridgeRoute amount for channel %s", elem.ChannelId)
+			}
+		}
+		bridgeRouteIndexMap[index] = struct{}{}
+	}
+	if err := host.PortIdentifierValidator(gs.BridgePortId); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, pendingOperation := range gs.PendingOperationList {
+		if err := pendingOperation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
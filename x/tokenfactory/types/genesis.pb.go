@@ -25,16 +25,37 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
-	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BlacklistedList      []Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
-	Paused               *Paused            `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	MasterMinter         *MasterMinter      `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
-	MintersList          []Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
-	Pauser               *Pauser            `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
-	Blacklister          *Blacklister       `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
-	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
-	MinterControllerList []MinterController `protobuf:"bytes,10,rep,name=minterControllerList,proto3" json:"minterControllerList"`
-	MintingDenom         *MintingDenom      `protobuf:"bytes,11,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	Params                   Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BlacklistedList          []Blacklisted          `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
+	Paused                   *Paused                `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	MasterMinter             *MasterMinter          `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	MintersList              []Minters              `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
+	Pauser                   *Pauser                `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister              *Blacklister           `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Owner                    *Owner                 `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	MinterControllerList     []MinterController     `protobuf:"bytes,10,rep,name=minterControllerList,proto3" json:"minterControllerList"`
+	MintingDenom             *MintingDenom          `protobuf:"bytes,11,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	RedemptionList           []Redemption           `protobuf:"bytes,12,rep,name=redemptionList,proto3" json:"redemptionList"`
+	RedemptionCount          uint64                 `protobuf:"varint,13,opt,name=redemptionCount,proto3" json:"redemptionCount,omitempty"`
+	Attester                 *Attester              `protobuf:"bytes,14,opt,name=attester,proto3" json:"attester,omitempty"`
+	ReserveAttestationList   []ReserveAttestation   `protobuf:"bytes,15,rep,name=reserveAttestationList,proto3" json:"reserveAttestationList"`
+	ReserveAttestationCount  uint64                 `protobuf:"varint,16,opt,name=reserveAttestationCount,proto3" json:"reserveAttestationCount,omitempty"`
+	SupplyCap                *SupplyCap             `protobuf:"bytes,17,opt,name=supplyCap,proto3" json:"supplyCap,omitempty"`
+	Quorum                   *Quorum                `protobuf:"bytes,18,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	PendingOperationList     []PendingOperation     `protobuf:"bytes,19,rep,name=pendingOperationList,proto3" json:"pendingOperationList"`
+	PendingOperationCount    uint64                 `protobuf:"varint,20,opt,name=pendingOperationCount,proto3" json:"pendingOperationCount,omitempty"`
+	RoleChangeList           []RoleChange           `protobuf:"bytes,21,rep,name=roleChangeList,proto3" json:"roleChangeList"`
+	RoleChangeCount          uint64                 `protobuf:"varint,22,opt,name=roleChangeCount,proto3" json:"roleChangeCount,omitempty"`
+	GuardianList             []Guardian             `protobuf:"bytes,23,rep,name=guardianList,proto3" json:"guardianList"`
+	MinterStatsList          []MinterStats          `protobuf:"bytes,24,rep,name=minterStatsList,proto3" json:"minterStatsList"`
+	MintingTotals            *MintingTotals         `protobuf:"bytes,25,opt,name=mintingTotals,proto3" json:"mintingTotals,omitempty"`
+	AuthorizationStateList   []AuthorizationState   `protobuf:"bytes,26,rep,name=authorizationStateList,proto3" json:"authorizationStateList"`
+	RateLimitList            []RateLimit            `protobuf:"bytes,27,rep,name=rateLimitList,proto3" json:"rateLimitList"`
+	AllowedChannelList       []AllowedChannel       `protobuf:"bytes,28,rep,name=allowedChannelList,proto3" json:"allowedChannelList"`
+	BlacklistSyncChannelList []BlacklistSyncChannel `protobuf:"bytes,29,rep,name=blacklistSyncChannelList,proto3" json:"blacklistSyncChannelList"`
+	PortId                   string                 `protobuf:"bytes,30,opt,name=portId,proto3" json:"portId,omitempty"`
+	BridgeRouteList          []BridgeRoute          `protobuf:"bytes,31,rep,name=bridgeRouteList,proto3" json:"bridgeRouteList"`
+	BridgePortId             string                 `protobuf:"bytes,32,opt,name=bridgePortId,proto3" json:"bridgePortId,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -140,6 +161,153 @@ func (m *GenesisState) GetMintingDenom() *MintingDenom {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptionList() []Redemption {
+	if m != nil {
+		return m.RedemptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionCount() uint64 {
+	if m != nil {
+		return m.RedemptionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttester() *Attester {
+	if m != nil {
+		return m.Attester
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestationList() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestationCount() uint64 {
+	if m != nil {
+		return m.ReserveAttestationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSupplyCap() *SupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuorum() *Quorum {
+	if m != nil {
+		return m.Quorum
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOperationList() []PendingOperation {
+	if m != nil {
+		return m.PendingOperationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOperationCount() uint64 {
+	if m != nil {
+		return m.PendingOperationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRoleChangeList() []RoleChange {
+	if m != nil {
+		return m.RoleChangeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleChangeCount() uint64 {
+	if m != nil {
+		return m.RoleChangeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetGuardianList() []Guardian {
+	if m != nil {
+		return m.GuardianList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterStatsList() []MinterStats {
+	if m != nil {
+		return m.MinterStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintingTotals() *MintingTotals {
+	if m != nil {
+		return m.MintingTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizationStateList() []AuthorizationState {
+	if m != nil {
+		return m.AuthorizationStateList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitList() []RateLimit {
+	if m != nil {
+		return m.RateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedChannelList() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlacklistSyncChannelList() []BlacklistSyncChannel {
+	if m != nil {
+		return m.BlacklistSyncChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetBridgeRouteList() []BridgeRoute {
+	if m != nil {
+		return m.BridgeRouteList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePortId() string {
+	if m != nil {
+		return m.BridgePortId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hero.tokenfactory.GenesisState")
 }
@@ -147,36 +315,69 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/genesis.proto", fileDescriptor_415d5acd9b7bd461) }
 
 var fileDescriptor_415d5acd9b7bd461 = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0xee, 0x5a, 0x90, 0x73, 0x12, 0xc2, 0xba, 0xc1, 0x97, 0xc1, 0x17, 0x01, 0xc3,
-	0x2d, 0x24, 0xe2, 0x18, 0x10, 0x1b, 0xca, 0x21, 0xb1, 0x70, 0x80, 0x72, 0x1b, 0x12, 0xaa, 0xd2,
-	0xd4, 0xa4, 0x51, 0x13, 0xbb, 0xb2, 0xdd, 0x42, 0xbf, 0x05, 0x1f, 0x80, 0x0f, 0xd4, 0xb1, 0x23,
-	0x13, 0x42, 0xed, 0x17, 0x41, 0xb1, 0x4d, 0x1a, 0xd3, 0xa4, 0x6c, 0x91, 0x9e, 0xdf, 0xf3, 0xfa,
-	0x79, 0xff, 0x04, 0xf8, 0x92, 0xcd, 0x08, 0xfd, 0x92, 0x66, 0x92, 0xf1, 0x55, 0x94, 0x13, 0x4a,
-	0x44, 0x21, 0xc2, 0x39, 0x67, 0x92, 0xc1, 0x47, 0x53, 0xc2, 0x59, 0xd8, 0x06, 0xfc, 0xf3, 0x9c,
-	0xe5, 0x4c, 0xa9, 0x51, 0xfd, 0xa5, 0x41, 0xff, 0xc2, 0x2a, 0x32, 0x4f, 0x79, 0x5a, 0x99, 0x1a,
-	0x3e, 0xb6, 0xa4, 0x71, 0x99, 0x66, 0xb3, 0xb2, 0x10, 0x92, 0x4c, 0x7a, 0xac, 0x0b, 0xd1, 0x48,
-	0x81, 0x25, 0x55, 0xa9, 0x90, 0x84, 0x8f, 0xaa, 0x82, 0x4a, 0xc2, 0x0d, 0x61, 0x87, 0xd7, 0x92,
-	0xe8, 0x2f, 0xcc, 0xff, 0x93, 0xe9, 0xaf, 0x8e, 0x2c, 0x9d, 0x7d, 0xa5, 0x8d, 0xf2, 0xb4, 0xe3,
-	0xc1, 0x51, 0xc6, 0xa8, 0xe4, 0xac, 0x2c, 0x1b, 0x2a, 0x38, 0xa0, 0x0a, 0x9a, 0x8f, 0x26, 0x84,
-	0xb2, 0x4a, 0x13, 0x8f, 0x7f, 0x0c, 0xc0, 0xd9, 0x5b, 0x3d, 0xeb, 0x3b, 0x99, 0x4a, 0x02, 0x5f,
-	0x82, 0xa1, 0x1e, 0x1b, 0x72, 0x03, 0xf7, 0xca, 0xbb, 0xbe, 0x08, 0x0f, 0x66, 0x1f, 0x7e, 0x54,
-	0x40, 0x7c, 0xba, 0xfe, 0x75, 0xe9, 0x24, 0x06, 0x87, 0xef, 0xc1, 0xc3, 0xd6, 0x50, 0xdf, 0x15,
-	0x42, 0xa2, 0x7b, 0xc1, 0xc9, 0x95, 0x77, 0x8d, 0x3b, 0x2a, 0xc4, 0x7b, 0xd2, 0x94, 0xf9, 0xd7,
-	0x0c, 0x9f, 0xd7, 0x41, 0xea, 0x25, 0xa0, 0x93, 0x23, 0x41, 0x6a, 0x20, 0x31, 0x20, 0xbc, 0x01,
-	0x67, 0x7a, 0x39, 0xb7, 0x6a, 0x1e, 0xe8, 0x54, 0x19, 0x2f, 0x3b, 0x8c, 0xb7, 0x2d, 0x2c, 0xb1,
-	0x4c, 0x30, 0x06, 0x9e, 0xd9, 0x9f, 0xea, 0x61, 0xa0, 0x7a, 0xf0, 0xbb, 0x6a, 0x68, 0xca, 0xe4,
-	0x6f, 0x9b, 0x9a, 0xec, 0x1c, 0x0d, 0x8f, 0x67, 0xe7, 0x26, 0x3b, 0x87, 0xaf, 0x81, 0xd7, 0xda,
-	0x3f, 0xba, 0xaf, 0x7c, 0xc7, 0x47, 0xc7, 0x93, 0xb6, 0x05, 0x86, 0x60, 0xa0, 0x2e, 0x04, 0x3d,
-	0x50, 0x5e, 0xd4, 0xe1, 0xfd, 0x50, 0xeb, 0x89, 0xc6, 0xe0, 0x67, 0x70, 0xae, 0x33, 0xdf, 0x34,
-	0x67, 0xa3, 0x3a, 0x06, 0xaa, 0xe3, 0x27, 0xbd, 0x1d, 0xef, 0x71, 0xd3, 0x7a, 0x67, 0x19, 0xb5,
-	0x0c, 0x7d, 0x70, 0x6f, 0xea, 0x7b, 0x43, 0x5e, 0xff, 0x32, 0x5a, 0x58, 0x62, 0x99, 0xe2, 0xbb,
-	0xf5, 0x16, 0xbb, 0x9b, 0x2d, 0x76, 0x7f, 0x6f, 0xb1, 0xfb, 0x7d, 0x87, 0x9d, 0xcd, 0x0e, 0x3b,
-	0x3f, 0x77, 0xd8, 0xf9, 0xf4, 0x2a, 0x2f, 0xe4, 0x74, 0x31, 0x0e, 0x33, 0x56, 0x45, 0x42, 0xf2,
-	0x94, 0xe6, 0xa4, 0x64, 0x4b, 0xf2, 0x6c, 0x49, 0xa8, 0x5c, 0x70, 0x22, 0xa2, 0xfa, 0x9d, 0xe8,
-	0x5b, 0x64, 0xfd, 0x01, 0x72, 0x35, 0x27, 0x62, 0x3c, 0x54, 0xa7, 0xff, 0xe2, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xce, 0x16, 0xe1, 0x70, 0x72, 0x04, 0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x5d, 0x73, 0xdc, 0x34,
+	0x14, 0xcd, 0x92, 0x74, 0x69, 0xb5, 0x9b, 0xa6, 0x15, 0x69, 0xaa, 0x6c, 0x9a, 0x8d, 0x09, 0x5f,
+	0xcb, 0x03, 0xbb, 0x43, 0x61, 0xa6, 0xc0, 0x13, 0xc9, 0x02, 0x85, 0x81, 0xd0, 0xe0, 0x30, 0xc3,
+	0x0c, 0x33, 0x8c, 0x47, 0xf1, 0xaa, 0x1b, 0x4f, 0x6d, 0xcb, 0xc8, 0x72, 0xca, 0xf2, 0x2b, 0xf8,
+	0x51, 0x3c, 0xf4, 0xb1, 0x8f, 0x3c, 0x31, 0x4c, 0xf2, 0x47, 0x18, 0x5f, 0xc9, 0x5e, 0xcb, 0x91,
+	0x92, 0xb7, 0x44, 0xf7, 0x9c, 0x73, 0xcf, 0x95, 0xef, 0xbd, 0x5a, 0x34, 0x90, 0xfc, 0x05, 0x4b,
+	0x9f, 0xd3, 0x50, 0x72, 0xb1, 0x98, 0xcc, 0x59, 0xca, 0xf2, 0x28, 0x1f, 0x67, 0x82, 0x4b, 0x8e,
+	0xef, 0x9f, 0x31, 0xc1, 0xc7, 0x4d, 0xc0, 0x60, 0x73, 0xce, 0xe7, 0x1c, 0xa2, 0x93, 0xf2, 0x2f,
+	0x05, 0x1c, 0x6c, 0x1b, 0x22, 0x19, 0x15, 0x34, 0xd1, 0x1a, 0x83, 0xa1, 0x11, 0x3a, 0x8d, 0x69,
+	0xf8, 0x22, 0x8e, 0x72, 0xc9, 0x66, 0x0e, 0x6a, 0x91, 0xd7, 0x21, 0xcf, 0x08, 0x25, 0x34, 0x97,
+	0x4c, 0x04, 0x49, 0x94, 0x4a, 0x26, 0x34, 0xc2, 0x34, 0xaf, 0x42, 0xb9, 0x5b, 0x58, 0xdc, 0xe0,
+	0xa9, 0x8a, 0x13, 0x23, 0xce, 0x5f, 0xa6, 0x75, 0xe4, 0x5d, 0x4b, 0xc2, 0x20, 0xe4, 0xa9, 0x14,
+	0x3c, 0x8e, 0x99, 0xb0, 0x1b, 0x8f, 0x52, 0x19, 0xa5, 0xf3, 0x60, 0xc6, 0x52, 0x9e, 0x68, 0xc4,
+	0xae, 0x81, 0x10, 0x6c, 0xc6, 0x92, 0x4c, 0x46, 0x3c, 0xd5, 0xe1, 0x1d, 0x23, 0x4c, 0xa5, 0x64,
+	0x0d, 0x77, 0xef, 0xb7, 0xb8, 0x39, 0x13, 0xe7, 0x2c, 0x50, 0x20, 0xda, 0x10, 0x31, 0x73, 0xe4,
+	0x45, 0x96, 0xc5, 0x8b, 0x20, 0xa4, 0x99, 0xf5, 0x7e, 0x7e, 0x2f, 0xb8, 0x28, 0x12, 0x6b, 0x95,
+	0x19, 0x4b, 0x67, 0xa5, 0x7f, 0x9e, 0x31, 0xd1, 0xd4, 0x37, 0x6f, 0x51, 0xf0, 0x98, 0x05, 0xe1,
+	0x19, 0x4d, 0xe7, 0xcc, 0x5a, 0xc4, 0xbc, 0xa0, 0x62, 0x16, 0xd1, 0x8a, 0xbc, 0x67, 0xbb, 0xc8,
+	0xd2, 0x7f, 0xf5, 0xf9, 0x3e, 0x34, 0x00, 0x52, 0xd0, 0x34, 0x7f, 0xce, 0x44, 0x40, 0x0b, 0x79,
+	0xc6, 0x45, 0xf4, 0xa7, 0xbb, 0x50, 0x41, 0x25, 0x0b, 0xe2, 0x28, 0x89, 0xa4, 0x0e, 0xef, 0x1b,
+	0x61, 0x1a, 0xc7, 0xfc, 0x25, 0x9b, 0x81, 0xd5, 0x94, 0xc5, 0xd6, 0x6c, 0x75, 0x47, 0x04, 0xf9,
+	0x22, 0x0d, 0x5b, 0x50, 0xd3, 0xf9, 0xa9, 0x88, 0x66, 0x73, 0x16, 0x08, 0x5e, 0x48, 0x5d, 0xf7,
+	0xfe, 0xdf, 0xf7, 0x50, 0xff, 0xa9, 0x9a, 0xa3, 0x13, 0x49, 0x25, 0xc3, 0x4f, 0x50, 0x57, 0x8d,
+	0x04, 0xe9, 0x78, 0x9d, 0x51, 0xef, 0xf1, 0xf6, 0xf8, 0xca, 0x5c, 0x8d, 0x8f, 0x01, 0x70, 0xb8,
+	0xf6, 0xea, 0xdf, 0xbd, 0x15, 0x5f, 0xc3, 0xf1, 0x8f, 0x68, 0xa3, 0x31, 0x30, 0x3f, 0x44, 0xb9,
+	0x24, 0x6f, 0x78, 0xab, 0xa3, 0xde, 0xe3, 0xa1, 0x45, 0xe1, 0x70, 0x89, 0xd4, 0x32, 0x6d, 0x32,
+	0xfe, 0x18, 0x75, 0xd5, 0x80, 0x91, 0xd5, 0x6b, 0x8c, 0x94, 0x00, 0x5f, 0x03, 0xf1, 0x14, 0xf5,
+	0xd5, 0xe0, 0x1d, 0xc1, 0x27, 0x22, 0x6b, 0x40, 0xdc, 0xb3, 0x10, 0x8f, 0x1a, 0x30, 0xdf, 0x20,
+	0xe1, 0x43, 0xd4, 0xd3, 0xb3, 0x09, 0x35, 0xdc, 0x82, 0x1a, 0x06, 0x36, 0x0d, 0x85, 0xd2, 0xfe,
+	0x9b, 0xa4, 0xda, 0xbb, 0x20, 0xdd, 0xeb, 0xbd, 0x0b, 0xed, 0x5d, 0xe0, 0x2f, 0x51, 0xaf, 0x31,
+	0xdb, 0xe4, 0x4d, 0xaf, 0x73, 0xe3, 0xd5, 0x09, 0xbf, 0x49, 0xc1, 0x63, 0x74, 0x0b, 0xa6, 0x9f,
+	0xdc, 0x06, 0x2e, 0xb1, 0x70, 0x9f, 0x95, 0x71, 0x5f, 0xc1, 0xf0, 0x6f, 0x68, 0x53, 0x79, 0x9e,
+	0xd6, 0x2b, 0x01, 0x2a, 0x46, 0x50, 0xf1, 0x3b, 0xce, 0x8a, 0x97, 0x70, 0x5d, 0xba, 0x55, 0x06,
+	0x3e, 0x86, 0x5a, 0x26, 0x5f, 0x95, 0xbb, 0x84, 0xf4, 0xdc, 0x1f, 0xa3, 0x01, 0xf3, 0x0d, 0x12,
+	0xfe, 0x1e, 0xdd, 0x5d, 0xee, 0x1b, 0x70, 0xd7, 0x07, 0x77, 0xbb, 0x16, 0x19, 0xbf, 0x06, 0x6a,
+	0x5f, 0x2d, 0x2a, 0x1e, 0xa1, 0x8d, 0xe5, 0xc9, 0x94, 0x17, 0xa9, 0x24, 0xeb, 0x5e, 0x67, 0xb4,
+	0xe6, 0xb7, 0x8f, 0xf1, 0x13, 0x74, 0xbb, 0xda, 0x63, 0xe4, 0x2e, 0xf8, 0xde, 0xb1, 0x24, 0x3c,
+	0xd0, 0x10, 0xbf, 0x06, 0xe3, 0x10, 0x6d, 0xe9, 0x1d, 0x77, 0xb0, 0x5c, 0x71, 0xe0, 0x7b, 0x03,
+	0x7c, 0xbf, 0x67, 0xf5, 0xdd, 0x26, 0x68, 0xff, 0x0e, 0x29, 0xfc, 0x19, 0x7a, 0x78, 0x35, 0xa2,
+	0xea, 0xb9, 0x07, 0xf5, 0xb8, 0xc2, 0xf8, 0x0b, 0x74, 0x47, 0xad, 0xd6, 0x29, 0xcd, 0xc8, 0x7d,
+	0x28, 0xec, 0x91, 0xc5, 0xd1, 0x49, 0x85, 0xf1, 0x97, 0xf0, 0xb2, 0xa7, 0xd5, 0xde, 0x25, 0xd8,
+	0xd9, 0xd3, 0x3f, 0x01, 0xc0, 0xd7, 0xc0, 0xb2, 0xc3, 0xf4, 0x3e, 0x7e, 0x56, 0xad, 0x63, 0xb8,
+	0x8b, 0xb7, 0x9c, 0x1d, 0x76, 0xdc, 0x82, 0x57, 0x1d, 0x66, 0x93, 0xc1, 0x9f, 0xa2, 0x07, 0xed,
+	0x73, 0x75, 0x0b, 0x9b, 0x70, 0x0b, 0xf6, 0x20, 0xb4, 0x14, 0x8f, 0xd9, 0x14, 0xb6, 0x3f, 0xd8,
+	0x79, 0xe0, 0x6e, 0xa9, 0x1a, 0x58, 0xb7, 0x94, 0x41, 0x85, 0x96, 0xaa, 0x4f, 0x54, 0xf2, 0x2d,
+	0xdd, 0x52, 0xe6, 0x31, 0xfe, 0x1a, 0xf5, 0xab, 0x57, 0x05, 0x92, 0x3e, 0xf4, 0x56, 0x1d, 0x6d,
+	0xf5, 0x54, 0xc3, 0x74, 0x4a, 0x83, 0x56, 0x6e, 0x59, 0x35, 0x6d, 0xe5, 0xb6, 0x56, 0x1b, 0x8a,
+	0x38, 0xb7, 0xec, 0xd1, 0x12, 0x59, 0x6d, 0xd9, 0x16, 0x19, 0x7f, 0x83, 0xd6, 0xf5, 0xc0, 0xfd,
+	0xcc, 0x25, 0x8d, 0x73, 0xb2, 0x0d, 0x1f, 0xd7, 0x73, 0x8f, 0xa9, 0xc2, 0xf9, 0x26, 0xad, 0x6c,
+	0x7c, 0xe3, 0xb5, 0x2b, 0x33, 0xa8, 0xdb, 0x1d, 0x38, 0x1b, 0xff, 0xe0, 0x0a, 0xa1, 0x6a, 0x7c,
+	0xbb, 0x14, 0xfe, 0x16, 0xad, 0x0b, 0xf8, 0x3b, 0x89, 0x24, 0x68, 0xef, 0x78, 0xab, 0x8e, 0x16,
+	0xf6, 0x2b, 0x9c, 0x96, 0x34, 0x89, 0xf8, 0x17, 0x84, 0xf5, 0xdb, 0x3a, 0x55, 0xef, 0x25, 0xc8,
+	0x3d, 0x02, 0xb9, 0xb7, 0x6d, 0x56, 0x0d, 0xb0, 0xd6, 0xb4, 0x48, 0xe0, 0x08, 0x91, 0x7a, 0x27,
+	0x9f, 0x2c, 0xd2, 0xb0, 0x29, 0xbf, 0x0b, 0xf2, 0x1f, 0x5c, 0xb7, 0xd3, 0x1b, 0x14, 0x9d, 0xc4,
+	0x29, 0x87, 0xb7, 0x50, 0x37, 0xe3, 0x42, 0x7e, 0x37, 0x23, 0x43, 0xaf, 0x33, 0xba, 0xe3, 0xeb,
+	0xff, 0xe0, 0x21, 0x86, 0x87, 0xde, 0x2f, 0xdf, 0x79, 0xc8, 0xbc, 0xe7, 0x7e, 0x88, 0x97, 0xc8,
+	0xfa, 0x21, 0x36, 0xc9, 0x78, 0x1f, 0xf5, 0xd5, 0xd1, 0xb1, 0xca, 0xe6, 0x41, 0x36, 0xe3, 0xec,
+	0xf0, 0xe4, 0xd5, 0xc5, 0xb0, 0xf3, 0xfa, 0x62, 0xd8, 0xf9, 0xef, 0x62, 0xd8, 0xf9, 0xeb, 0x72,
+	0xb8, 0xf2, 0xfa, 0x72, 0xb8, 0xf2, 0xcf, 0xe5, 0x70, 0xe5, 0xd7, 0xcf, 0xe7, 0x91, 0x3c, 0x2b,
+	0x4e, 0xc7, 0x21, 0x4f, 0x26, 0xb9, 0x14, 0xe5, 0x40, 0xc4, 0xfc, 0x9c, 0x7d, 0x74, 0xce, 0x52,
+	0x59, 0x08, 0x96, 0x4f, 0x4a, 0x4f, 0x93, 0x3f, 0x26, 0xe6, 0x2f, 0xa8, 0x45, 0xc6, 0xf2, 0xd3,
+	0x2e, 0xfc, 0x44, 0xf9, 0xe4, 0xff, 0x01, 0x00, 0xe2, 0xcb, 0xa5, 0x9e, 0xf6, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,22 +400,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintingDenom != nil {
-		{
-			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.BridgePortId) > 0 {
+		i -= len(m.BridgePortId)
+		copy(dAtA[i:], m.BridgePortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgePortId)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BridgeRouteList) > 0 {
+		for iNdEx := len(m.BridgeRouteList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeRouteList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	if len(m.MinterControllerList) > 0 {
-		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlacklistSyncChannelList) > 0 {
+		for iNdEx := len(m.BlacklistSyncChannelList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlacklistSyncChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -222,12 +445,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if m.Owner != nil {
+	if len(m.AllowedChannelList) > 0 {
+		for iNdEx := len(m.AllowedChannelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.RateLimitList) > 0 {
+		for iNdEx := len(m.RateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.AuthorizationStateList) > 0 {
+		for iNdEx := len(m.AuthorizationStateList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizationStateList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.MintingTotals != nil {
 		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MintingTotals.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -235,11 +508,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if m.Blacklister != nil {
+	if len(m.MinterStatsList) > 0 {
+		for iNdEx := len(m.MinterStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.GuardianList) > 0 {
+		for iNdEx := len(m.GuardianList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.RoleChangeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoleChangeCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.RoleChangeList) > 0 {
+		for iNdEx := len(m.RoleChangeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleChangeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.PendingOperationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingOperationCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PendingOperationList) > 0 {
+		for iNdEx := len(m.PendingOperationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOperationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.Quorum != nil {
 		{
-			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -247,11 +600,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pauser != nil {
+	if m.SupplyCap != nil {
 		{
-			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -259,12 +614,21 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if len(m.MintersList) > 0 {
-		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ReserveAttestationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReserveAttestationCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ReserveAttestationList) > 0 {
+		for iNdEx := len(m.ReserveAttestationList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReserveAttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -272,12 +636,12 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x7a
 		}
 	}
-	if m.MasterMinter != nil {
+	if m.Attester != nil {
 		{
-			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Attester.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -285,11 +649,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x72
 	}
-	if m.Paused != nil {
+	if m.RedemptionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedemptionCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RedemptionList) > 0 {
+		for iNdEx := len(m.RedemptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MintingDenom != nil {
 		{
-			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -297,12 +680,12 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if len(m.BlacklistedList) > 0 {
-		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MinterControllerList) > 0 {
+		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -310,7 +693,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Blacklister != nil {
+		{
+			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pauser != nil {
+		{
+			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MintersList) > 0 {
+		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MasterMinter != nil {
+		{
+			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused != nil {
+		{
+			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
 	{
@@ -387,6 +858,108 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MintingDenom.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.RedemptionList) > 0 {
+		for _, e := range m.RedemptionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RedemptionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RedemptionCount))
+	}
+	if m.Attester != nil {
+		l = m.Attester.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ReserveAttestationList) > 0 {
+		for _, e := range m.ReserveAttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ReserveAttestationCount != 0 {
+		n += 2 + sovGenesis(uint64(m.ReserveAttestationCount))
+	}
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingOperationList) > 0 {
+		for _, e := range m.PendingOperationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingOperationCount != 0 {
+		n += 2 + sovGenesis(uint64(m.PendingOperationCount))
+	}
+	if len(m.RoleChangeList) > 0 {
+		for _, e := range m.RoleChangeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RoleChangeCount != 0 {
+		n += 2 + sovGenesis(uint64(m.RoleChangeCount))
+	}
+	if len(m.GuardianList) > 0 {
+		for _, e := range m.GuardianList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterStatsList) > 0 {
+		for _, e := range m.MinterStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintingTotals != nil {
+		l = m.MintingTotals.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AuthorizationStateList) > 0 {
+		for _, e := range m.AuthorizationStateList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitList) > 0 {
+		for _, e := range m.RateLimitList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedChannelList) > 0 {
+		for _, e := range m.AllowedChannelList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlacklistSyncChannelList) > 0 {
+		for _, e := range m.BlacklistSyncChannelList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BridgeRouteList) > 0 {
+		for _, e := range m.BridgeRouteList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BridgePortId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -406,28 +979,468 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
+			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Paused == nil {
+				m.Paused = &Paused{}
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MasterMinter == nil {
+				m.MasterMinter = &MasterMinter{}
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintersList = append(m.MintersList, Minters{})
+			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pauser == nil {
+				m.Pauser = &Pauser{}
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blacklister == nil {
+				m.Blacklister = &Blacklister{}
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &Owner{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
+			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintingDenom == nil {
+				m.MintingDenom = &MintingDenom{}
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionList = append(m.RedemptionList, Redemption{})
+			if err := m.RedemptionList[len(m.RedemptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionCount", wireType)
+			}
+			m.RedemptionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Attester == nil {
+				m.Attester = &Attester{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Attester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -454,13 +1467,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReserveAttestationList = append(m.ReserveAttestationList, ReserveAttestation{})
+			if err := m.ReserveAttestationList[len(m.ReserveAttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestationCount", wireType)
+			}
+			m.ReserveAttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveAttestationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -487,14 +1520,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
-			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SupplyCap == nil {
+				m.SupplyCap = &SupplyCap{}
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -521,16 +1556,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Paused == nil {
-				m.Paused = &Paused{}
+			if m.Quorum == nil {
+				m.Quorum = &Quorum{}
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -557,16 +1592,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterMinter == nil {
-				m.MasterMinter = &MasterMinter{}
-			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingOperationList = append(m.PendingOperationList, PendingOperation{})
+			if err := m.PendingOperationList[len(m.PendingOperationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperationCount", wireType)
+			}
+			m.PendingOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingOperationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChangeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,14 +1645,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintersList = append(m.MintersList, Minters{})
-			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RoleChangeList = append(m.RoleChangeList, RoleChange{})
+			if err := m.RoleChangeList[len(m.RoleChangeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChangeCount", wireType)
+			}
+			m.RoleChangeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoleChangeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,16 +1698,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pauser == nil {
-				m.Pauser = &Pauser{}
+			m.GuardianList = append(m.GuardianList, Guardian{})
+			if err := m.GuardianList[len(m.GuardianList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterStatsList = append(m.MinterStatsList, MinterStats{})
+			if err := m.MinterStatsList[len(m.MinterStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingTotals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -663,16 +1766,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blacklister == nil {
-				m.Blacklister = &Blacklister{}
+			if m.MintingTotals == nil {
+				m.MintingTotals = &MintingTotals{}
 			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintingTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationStateList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -699,16 +1802,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &Owner{}
+			m.AuthorizationStateList = append(m.AuthorizationStateList, AuthorizationState{})
+			if err := m.AuthorizationStateList[len(m.AuthorizationStateList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitList = append(m.RateLimitList, RateLimit{})
+			if err := m.RateLimitList[len(m.RateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannelList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -735,14 +1870,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
-			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedChannelList = append(m.AllowedChannelList, AllowedChannel{})
+			if err := m.AllowedChannelList[len(m.AllowedChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistSyncChannelList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -769,13 +1904,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MintingDenom == nil {
-				m.MintingDenom = &MintingDenom{}
+			m.BlacklistSyncChannelList = append(m.BlacklistSyncChannelList, BlacklistSyncChannel{})
+			if err := m.BlacklistSyncChannelList[len(m.BlacklistSyncChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRouteList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeRouteList = append(m.BridgeRouteList, BridgeRoute{})
+			if err := m.BridgeRouteList[len(m.BridgeRouteList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
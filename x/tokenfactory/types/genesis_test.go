@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/strangelove-ventures/hero/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/require"
@@ -63,8 +65,133 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				MintingDenom: &types.MintingDenom{
-					Denom: "56",
+					Denom: "uusdc",
 				},
+				RedemptionList: []types.Redemption{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				RedemptionCount: 2,
+				Attester: &types.Attester{
+					Address: "17",
+				},
+				ReserveAttestationList: []types.ReserveAttestation{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				ReserveAttestationCount: 2,
+				SupplyCap: &types.SupplyCap{
+					Amount: sdk.NewInt64Coin("uusdc", 1000000),
+				},
+				Quorum: &types.Quorum{
+					Members:   []string{"23", "24"},
+					Threshold: 2,
+				},
+				PendingOperationList: []types.PendingOperation{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				PendingOperationCount: 2,
+				RoleChangeList: []types.RoleChange{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				RoleChangeCount: 2,
+				GuardianList: []types.Guardian{
+					{
+						Address: "0",
+					},
+					{
+						Address: "1",
+					},
+				},
+				MinterStatsList: []types.MinterStats{
+					{
+						Address: "0",
+					},
+					{
+						Address: "1",
+					},
+				},
+				MintingTotals: &types.MintingTotals{
+					MintCount: 42,
+				},
+				AuthorizationStateList: []types.AuthorizationState{
+					{
+						Authorizer: "0",
+						Nonce:      "0",
+					},
+					{
+						Authorizer: "0",
+						Nonce:      "1",
+					},
+				},
+				RateLimitList: []types.RateLimit{
+					{
+						ChannelId:         "channel-0",
+						Direction:         types.RateLimitInflow,
+						MaxAmount:         sdk.NewInt(100),
+						MaxSupplyFraction: sdk.ZeroDec(),
+						Window:            time.Hour,
+						Flow:              sdk.ZeroInt(),
+					},
+					{
+						ChannelId:         "channel-0",
+						Direction:         types.RateLimitOutflow,
+						MaxAmount:         sdk.NewInt(100),
+						MaxSupplyFraction: sdk.ZeroDec(),
+						Window:            time.Hour,
+						Flow:              sdk.ZeroInt(),
+					},
+				},
+				AllowedChannelList: []types.AllowedChannel{
+					{
+						ChannelId: "channel-0",
+					},
+					{
+						ChannelId: "channel-1",
+					},
+				},
+				BlacklistSyncChannelList: []types.BlacklistSyncChannel{
+					{
+						ChannelId: "channel-0",
+					},
+					{
+						ChannelId: "channel-1",
+					},
+				},
+				PortId: types.PortID,
+				BridgeRouteList: []types.BridgeRoute{
+					{
+						ChannelId: "channel-0",
+						Sent:      sdk.NewInt(10),
+						Received:  sdk.NewInt(5),
+						InFlight:  sdk.ZeroInt(),
+					},
+					{
+						ChannelId: "channel-1",
+						Sent:      sdk.ZeroInt(),
+						Received:  sdk.ZeroInt(),
+						InFlight:  sdk.NewInt(3),
+					},
+				},
+				BridgePortId: types.BridgePortID,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -111,6 +238,296 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated redemption",
+			genState: &types.GenesisState{
+				RedemptionList: []types.Redemption{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid redemption count",
+			genState: &types.GenesisState{
+				RedemptionList: []types.Redemption{
+					{
+						Id: 1,
+					},
+				},
+				RedemptionCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reserveAttestation",
+			genState: &types.GenesisState{
+				ReserveAttestationList: []types.ReserveAttestation{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reserveAttestation count",
+			genState: &types.GenesisState{
+				ReserveAttestationList: []types.ReserveAttestation{
+					{
+						Id: 1,
+					},
+				},
+				ReserveAttestationCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "supplyCap denom mismatch",
+			genState: &types.GenesisState{
+				MintingDenom: &types.MintingDenom{
+					Denom: "uusdc",
+				},
+				SupplyCap: &types.SupplyCap{
+					Amount: sdk.NewInt64Coin("ueurc", 1000000),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid quorum threshold",
+			genState: &types.GenesisState{
+				Quorum: &types.Quorum{
+					Members:   []string{"0"},
+					Threshold: 2,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pendingOperation",
+			genState: &types.GenesisState{
+				PendingOperationList: []types.PendingOperation{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid pendingOperation count",
+			genState: &types.GenesisState{
+				PendingOperationList: []types.PendingOperation{
+					{
+						Id: 1,
+					},
+				},
+				PendingOperationCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated roleChange",
+			genState: &types.GenesisState{
+				RoleChangeList: []types.RoleChange{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid roleChange count",
+			genState: &types.GenesisState{
+				RoleChangeList: []types.RoleChange{
+					{
+						Id: 1,
+					},
+				},
+				RoleChangeCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated guardian",
+			genState: &types.GenesisState{
+				GuardianList: []types.Guardian{
+					{
+						Address: "0",
+					},
+					{
+						Address: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated minterStats",
+			genState: &types.GenesisState{
+				MinterStatsList: []types.MinterStats{
+					{
+						Address: "0",
+					},
+					{
+						Address: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated authorizationState",
+			genState: &types.GenesisState{
+				AuthorizationStateList: []types.AuthorizationState{
+					{
+						Authorizer: "0",
+						Nonce:      "0",
+					},
+					{
+						Authorizer: "0",
+						Nonce:      "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rateLimit",
+			genState: &types.GenesisState{
+				RateLimitList: []types.RateLimit{
+					{
+						ChannelId:         "channel-0",
+						Direction:         types.RateLimitInflow,
+						MaxAmount:         sdk.NewInt(100),
+						MaxSupplyFraction: sdk.ZeroDec(),
+						Window:            time.Hour,
+						Flow:              sdk.ZeroInt(),
+					},
+					{
+						ChannelId:         "channel-0",
+						Direction:         types.RateLimitInflow,
+						MaxAmount:         sdk.NewInt(100),
+						MaxSupplyFraction: sdk.ZeroDec(),
+						Window:            time.Hour,
+						Flow:              sdk.ZeroInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rateLimit without quota",
+			genState: &types.GenesisState{
+				RateLimitList: []types.RateLimit{
+					{
+						ChannelId:         "channel-0",
+						MaxAmount:         sdk.ZeroInt(),
+						MaxSupplyFraction: sdk.ZeroDec(),
+						Window:            time.Hour,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowedChannel",
+			genState: &types.GenesisState{
+				AllowedChannelList: []types.AllowedChannel{
+					{
+						ChannelId: "channel-0",
+					},
+					{
+						ChannelId: "channel-0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated blacklistSyncChannel",
+			genState: &types.GenesisState{
+				BlacklistSyncChannelList: []types.BlacklistSyncChannel{
+					{
+						ChannelId: "channel-0",
+					},
+					{
+						ChannelId: "channel-0",
+					},
+				},
+				PortId: types.PortID,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid port",
+			genState: &types.GenesisState{
+				PortId: "",
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bridgeRoute",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				BridgeRouteList: []types.BridgeRoute{
+					{
+						ChannelId: "channel-0",
+						Sent:      sdk.ZeroInt(),
+						Received:  sdk.ZeroInt(),
+						InFlight:  sdk.ZeroInt(),
+					},
+					{
+						ChannelId: "channel-0",
+						Sent:      sdk.ZeroInt(),
+						Received:  sdk.ZeroInt(),
+						InFlight:  sdk.ZeroInt(),
+					},
+				},
+				BridgePortId: types.BridgePortID,
+			},
+			valid: false,
+		},
+		{
+			desc: "negative bridgeRoute amount",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				BridgeRouteList: []types.BridgeRoute{
+					{
+						ChannelId: "channel-0",
+						Sent:      sdk.NewInt(-1),
+						Received:  sdk.ZeroInt(),
+						InFlight:  sdk.ZeroInt(),
+					},
+				},
+				BridgePortId: types.BridgePortID,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bridge port",
+			genState: &types.GenesisState{
+				PortId:       types.PortID,
+				BridgePortId: "",
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,7 @@
 package types
 
+import "strconv"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "tokenfactory"
@@ -13,6 +15,21 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_tokenfactory"
 
+	// Version defines the current version of the blacklist sync IBC application
+	Version = "blacklistsync-1"
+
+	// PortID is the default port id the blacklist sync IBC application binds to
+	PortID = "blacklistsync"
+
+	// BridgeVersion defines the current version of the bridge IBC application
+	BridgeVersion = "herobridge-1"
+
+	// BridgePortID is the default port id the bridge IBC application binds to
+	BridgePortID = "herobridge"
+
+	// BridgeMinterName is the name of the module address bridge transfers are minted by
+	BridgeMinterName = "tokenfactory-bridge"
+
 	PausedKey                 = "Paused/value/"
 	MasterMinterKey           = "MasterMinter/value/"
 	PauserKey                 = "Pauser/value/"
@@ -21,6 +38,18 @@ const (
 	BlacklistedKeyPrefix      = "Blacklisted/value/"
 	MintersKeyPrefix          = "Minters/value/"
 	MinterControllerKeyPrefix = "MinterController/value/"
+	AttesterKey               = "Attester/value/"
+	SupplyCapKey              = "SupplyCap/value/"
+	QuorumKey                 = "Quorum/value/"
+	GuardianKeyPrefix         = "Guardian/value/"
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = KeyPrefix("blacklistsync-port-")
+
+	// BridgePortKey defines the key to store the bridge port ID in store
+	BridgePortKey = KeyPrefix("herobridge-port-")
 )
 
 func KeyPrefix(p string) []byte {
@@ -37,12 +66,135 @@ func MintersKey(address string) []byte {
 	return append([]byte(address), []byte("/")...)
 }
 
+// GuardianKey returns the store key to retrieve a Guardian from the index fields
+func GuardianKey(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
+
 // MinterControllerKey returns the store key to retrieve a MinterController from the index fields
 func MinterControllerKey(controllerAddress string) []byte {
 	return append([]byte(controllerAddress), []byte("/")...)
 
 }
 
+// MinterControllerByMinterKey returns the store key of the minter to controller index
+func MinterControllerByMinterKey(minterAddress string, controllerAddress string) []byte {
+	return append(MintersKey(minterAddress), MinterControllerKey(controllerAddress)...)
+}
+
 const (
 	MintingDenomKey = "MintingDenom/value/"
 )
+
+const (
+	RedemptionKey      = "Redemption/value/"
+	RedemptionCountKey = "Redemption/count/"
+)
+
+const (
+	ReserveAttestationKey      = "ReserveAttestation/value/"
+	ReserveAttestationCountKey = "ReserveAttestation/count/"
+)
+
+const (
+	PendingOperationKey      = "PendingOperation/value/"
+	PendingOperationCountKey = "PendingOperation/count/"
+)
+
+const (
+	// BlacklistedCountKey stores the number of blacklisted addresses
+	BlacklistedCountKey = "Blacklisted/count/"
+)
+
+const (
+	RoleChangeKey      = "RoleChange/value/"
+	RoleChangeCountKey = "RoleChange/count/"
+)
+
+const (
+	// MinterControllerByMinterKeyPrefix indexes the controllers of each minter
+	MinterControllerByMinterKeyPrefix = "MinterController/minter/"
+)
+
+const (
+	MinterStatsKeyPrefix = "MinterStats/value/"
+	MintingTotalsKey     = "MintingTotals/value/"
+)
+
+const (
+	AuthorizationStateKeyPrefix = "AuthorizationState/value/"
+)
+
+const (
+	RateLimitKeyPrefix = "RateLimit/value/"
+	// RateLimitPacketKeyPrefix stores the window that counted each sent packet against the outflow
+	RateLimitPacketKeyPrefix = "RateLimit/packet/"
+)
+
+const (
+	AllowedChannelKeyPrefix = "AllowedChannel/value/"
+)
+
+const (
+	BlacklistSyncChannelKeyPrefix = "BlacklistSyncChannel/value/"
+)
+
+const (
+	BridgeRouteKeyPrefix = "BridgeRoute/value/"
+)
+
+// BridgeRouteKey returns the store key to retrieve a BridgeRoute from the index fields
+func BridgeRouteKey(channelId string) []byte {
+	return append([]byte(channelId), []byte("/")...)
+}
+
+// BlacklistSyncChannelKey returns the store key to retrieve a BlacklistSyncChannel from the index fields
+func BlacklistSyncChannelKey(channelId string) []byte {
+	return append([]byte(channelId), []byte("/")...)
+}
+
+// AllowedChannelKey returns the store key to retrieve an AllowedChannel from the index fields
+func AllowedChannelKey(channelId string) []byte {
+	return append([]byte(channelId), []byte("/")...)
+}
+
+// RateLimitKey returns the store key to retrieve a RateLimit from the index fields
+func RateLimitKey(channelId string, direction RateLimitDirection) []byte {
+	var key []byte
+
+	key = append(key, []byte(channelId)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(direction.String())...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RateLimitPacketKey returns the store key to retrieve the window of a sent packet from its channel and sequence
+func RateLimitPacketKey(channelId string, sequence uint64) []byte {
+	var key []byte
+
+	key = append(key, []byte(channelId)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(strconv.FormatUint(sequence, 10))...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuthorizationStateKey returns the store key to retrieve an AuthorizationState from the index fields
+func AuthorizationStateKey(authorizer string, nonce string) []byte {
+	var key []byte
+
+	key = append(key, []byte(authorizer)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(nonce)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MinterStatsKey returns the store key to retrieve a MinterStats from the index fields
+func MinterStatsKey(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
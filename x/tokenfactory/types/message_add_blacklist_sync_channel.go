@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgAddBlacklistSyncChannel = "add_blacklist_sync_channel"
+
+var _ sdk.Msg = &MsgAddBlacklistSyncChannel{}
+
+func NewMsgAddBlacklistSyncChannel(from string, channelId string) *MsgAddBlacklistSyncChannel {
+	return &MsgAddBlacklistSyncChannel{
+		From:      from,
+		ChannelId: channelId,
+	}
+}
+
+func (msg *MsgAddBlacklistSyncChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddBlacklistSyncChannel) Type() string {
+	return TypeMsgAddBlacklistSyncChannel
+}
+
+func (msg *MsgAddBlacklistSyncChannel) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAddBlacklistSyncChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddBlacklistSyncChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgAddBridgeRoute = "add_bridge_route"
+
+var _ sdk.Msg = &MsgAddBridgeRoute{}
+
+func NewMsgAddBridgeRoute(from string, channelId string) *MsgAddBridgeRoute {
+	return &MsgAddBridgeRoute{
+		From:      from,
+		ChannelId: channelId,
+	}
+}
+
+func (msg *MsgAddBridgeRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddBridgeRoute) Type() string {
+	return TypeMsgAddBridgeRoute
+}
+
+func (msg *MsgAddBridgeRoute) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAddBridgeRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddBridgeRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
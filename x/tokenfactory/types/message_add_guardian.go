@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddGuardian = "add_guardian"
+
+var _ sdk.Msg = &MsgAddGuardian{}
+
+func NewMsgAddGuardian(from string, address string) *MsgAddGuardian {
+	return &MsgAddGuardian{
+		From:    from,
+		Address: address,
+	}
+}
+
+func (msg *MsgAddGuardian) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddGuardian) Type() string {
+	return TypeMsgAddGuardian
+}
+
+func (msg *MsgAddGuardian) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgAddGuardian) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddGuardian) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+	return nil
+}
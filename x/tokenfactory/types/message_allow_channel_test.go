@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAllowChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAllowChannel
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAllowChannel{
+				From:      "invalid_address",
+				ChannelId: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgAllowChannel{
+				From: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgAllowChannel{
+				From:      sample.AccAddress(),
+				ChannelId: "channel-0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
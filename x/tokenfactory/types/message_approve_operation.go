@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveOperation = "approve_operation"
+
+var _ sdk.Msg = &MsgApproveOperation{}
+
+func NewMsgApproveOperation(from string, id uint64) *MsgApproveOperation {
+	return &MsgApproveOperation{
+		From: from,
+		Id:   id,
+	}
+}
+
+func (msg *MsgApproveOperation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveOperation) Type() string {
+	return TypeMsgApproveOperation
+}
+
+func (msg *MsgApproveOperation) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgApproveOperation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveOperation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
@@ -23,7 +23,8 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgBlacklist{
-				From: sample.AccAddress(),
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
 			},
 		},
 	}
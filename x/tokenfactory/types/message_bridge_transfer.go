@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgBridgeTransfer = "bridge_transfer"
+
+var _ sdk.Msg = &MsgBridgeTransfer{}
+
+func NewMsgBridgeTransfer(from string, channelId string, receiver string, amount sdk.Coin, timeoutTimestamp uint64) *MsgBridgeTransfer {
+	return &MsgBridgeTransfer{
+		From:             from,
+		ChannelId:        channelId,
+		Receiver:         receiver,
+		Amount:           amount,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgBridgeTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBridgeTransfer) Type() string {
+	return TypeMsgBridgeTransfer
+}
+
+func (msg *MsgBridgeTransfer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgBridgeTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBridgeTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", msg.Amount)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp cannot be zero")
+	}
+	return nil
+}
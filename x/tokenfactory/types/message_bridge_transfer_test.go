@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBridgeTransfer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBridgeTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBridgeTransfer{
+				From:             "invalid_address",
+				ChannelId:        "channel-0",
+				Receiver:         sample.AccAddress(),
+				Amount:           sdk.NewInt64Coin("uusdc", 1),
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgBridgeTransfer{
+				From:             sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				Amount:           sdk.NewInt64Coin("uusdc", 1),
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid receiver",
+			msg: MsgBridgeTransfer{
+				From:             sample.AccAddress(),
+				ChannelId:        "channel-0",
+				Receiver:         "invalid_address",
+				Amount:           sdk.NewInt64Coin("uusdc", 1),
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgBridgeTransfer{
+				From:             sample.AccAddress(),
+				ChannelId:        "channel-0",
+				Receiver:         sample.AccAddress(),
+				Amount:           sdk.NewInt64Coin("uusdc", 0),
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "zero timeout",
+			msg: MsgBridgeTransfer{
+				From:      sample.AccAddress(),
+				ChannelId: "channel-0",
+				Receiver:  sample.AccAddress(),
+				Amount:    sdk.NewInt64Coin("uusdc", 1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgBridgeTransfer{
+				From:             sample.AccAddress(),
+				ChannelId:        "channel-0",
+				Receiver:         sample.AccAddress(),
+				Amount:           sdk.NewInt64Coin("uusdc", 1),
+				TimeoutTimestamp: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBroadcastBlacklistUpdate = "broadcast_blacklist_update"
+
+var _ sdk.Msg = &MsgBroadcastBlacklistUpdate{}
+
+func NewMsgBroadcastBlacklistUpdate(from string, address string, timeoutTimestamp uint64) *MsgBroadcastBlacklistUpdate {
+	return &MsgBroadcastBlacklistUpdate{
+		From:             from,
+		Address:          address,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgBroadcastBlacklistUpdate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBroadcastBlacklistUpdate) Type() string {
+	return TypeMsgBroadcastBlacklistUpdate
+}
+
+func (msg *MsgBroadcastBlacklistUpdate) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgBroadcastBlacklistUpdate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBroadcastBlacklistUpdate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid blacklisted address (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp cannot be zero")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBroadcastBlacklistUpdate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBroadcastBlacklistUpdate
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBroadcastBlacklistUpdate{
+				From:             "invalid_address",
+				Address:          sample.AccAddress(),
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid blacklisted address",
+			msg: MsgBroadcastBlacklistUpdate{
+				From:             sample.AccAddress(),
+				Address:          "invalid_address",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero timeout",
+			msg: MsgBroadcastBlacklistUpdate{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgBroadcastBlacklistUpdate{
+				From:             sample.AccAddress(),
+				Address:          sample.AccAddress(),
+				TimeoutTimestamp: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
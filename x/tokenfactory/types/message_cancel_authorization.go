@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelAuthorization = "cancel_authorization"
+
+var _ sdk.Msg = &MsgCancelAuthorization{}
+
+func NewMsgCancelAuthorization(from string, nonce string) *MsgCancelAuthorization {
+	return &MsgCancelAuthorization{
+		From:  from,
+		Nonce: nonce,
+	}
+}
+
+func (msg *MsgCancelAuthorization) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAuthorization) Type() string {
+	return TypeMsgCancelAuthorization
+}
+
+func (msg *MsgCancelAuthorization) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCancelAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAuthorization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return validateNonce(msg.Nonce)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelAuthorization
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelAuthorization{
+				From:  "invalid_address",
+				Nonce: "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty nonce",
+			msg: MsgCancelAuthorization{
+				From: sample.AccAddress(),
+			},
+			err: ErrAuthorization,
+		}, {
+			name: "valid address",
+			msg: MsgCancelAuthorization{
+				From:  sample.AccAddress(),
+				Nonce: "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
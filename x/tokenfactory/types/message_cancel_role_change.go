@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRoleChange = "cancel_role_change"
+
+var _ sdk.Msg = &MsgCancelRoleChange{}
+
+func NewMsgCancelRoleChange(from string, id uint64) *MsgCancelRoleChange {
+	return &MsgCancelRoleChange{
+		From: from,
+		Id:   id,
+	}
+}
+
+func (msg *MsgCancelRoleChange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRoleChange) Type() string {
+	return TypeMsgCancelRoleChange
+}
+
+func (msg *MsgCancelRoleChange) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCancelRoleChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRoleChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
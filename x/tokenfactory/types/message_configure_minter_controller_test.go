@@ -23,7 +23,9 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgConfigureMinterController{
-				From: sample.AccAddress(),
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Minter:     sample.AccAddress(),
 			},
 		},
 	}
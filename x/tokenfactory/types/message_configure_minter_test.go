@@ -23,7 +23,8 @@ func TestMsgConfigureMinter_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgConfigureMinter{
-				From: sample.AccAddress(),
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
 			},
 		},
 	}
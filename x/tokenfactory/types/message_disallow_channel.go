@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgDisallowChannel = "disallow_channel"
+
+var _ sdk.Msg = &MsgDisallowChannel{}
+
+func NewMsgDisallowChannel(from string, channelId string) *MsgDisallowChannel {
+	return &MsgDisallowChannel{
+		From:      from,
+		ChannelId: channelId,
+	}
+}
+
+func (msg *MsgDisallowChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDisallowChannel) Type() string {
+	return TypeMsgDisallowChannel
+}
+
+func (msg *MsgDisallowChannel) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgDisallowChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDisallowChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
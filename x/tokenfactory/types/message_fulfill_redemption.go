@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFulfillRedemption = "fulfill_redemption"
+
+var _ sdk.Msg = &MsgFulfillRedemption{}
+
+func NewMsgFulfillRedemption(from string, id uint64) *MsgFulfillRedemption {
+	return &MsgFulfillRedemption{
+		From: from,
+		Id:   id,
+	}
+}
+
+func (msg *MsgFulfillRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFulfillRedemption) Type() string {
+	return TypeMsgFulfillRedemption
+}
+
+func (msg *MsgFulfillRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgFulfillRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFulfillRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return nil
+}
@@ -23,7 +23,8 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgMint{
-				From: sample.AccAddress(),
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
 			},
 		},
 	}
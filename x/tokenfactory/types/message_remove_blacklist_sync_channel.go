@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgRemoveBlacklistSyncChannel = "remove_blacklist_sync_channel"
+
+var _ sdk.Msg = &MsgRemoveBlacklistSyncChannel{}
+
+func NewMsgRemoveBlacklistSyncChannel(from string, channelId string) *MsgRemoveBlacklistSyncChannel {
+	return &MsgRemoveBlacklistSyncChannel{
+		From:      from,
+		ChannelId: channelId,
+	}
+}
+
+func (msg *MsgRemoveBlacklistSyncChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveBlacklistSyncChannel) Type() string {
+	return TypeMsgRemoveBlacklistSyncChannel
+}
+
+func (msg *MsgRemoveBlacklistSyncChannel) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveBlacklistSyncChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveBlacklistSyncChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgRemoveBridgeRoute = "remove_bridge_route"
+
+var _ sdk.Msg = &MsgRemoveBridgeRoute{}
+
+func NewMsgRemoveBridgeRoute(from string, channelId string) *MsgRemoveBridgeRoute {
+	return &MsgRemoveBridgeRoute{
+		From:      from,
+		ChannelId: channelId,
+	}
+}
+
+func (msg *MsgRemoveBridgeRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveBridgeRoute) Type() string {
+	return TypeMsgRemoveBridgeRoute
+}
+
+func (msg *MsgRemoveBridgeRoute) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveBridgeRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveBridgeRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveGuardian = "remove_guardian"
+
+var _ sdk.Msg = &MsgRemoveGuardian{}
+
+func NewMsgRemoveGuardian(from string, address string) *MsgRemoveGuardian {
+	return &MsgRemoveGuardian{
+		From:    from,
+		Address: address,
+	}
+}
+
+func (msg *MsgRemoveGuardian) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveGuardian) Type() string {
+	return TypeMsgRemoveGuardian
+}
+
+func (msg *MsgRemoveGuardian) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveGuardian) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveGuardian) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+	return nil
+}
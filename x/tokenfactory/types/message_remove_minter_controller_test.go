@@ -23,7 +23,8 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgRemoveMinterController{
-				From: sample.AccAddress(),
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
 			},
 		},
 	}
@@ -23,7 +23,8 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgRemoveMinter{
-				From: sample.AccAddress(),
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
 			},
 		},
 	}
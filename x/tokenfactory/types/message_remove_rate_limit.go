@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const TypeMsgRemoveRateLimit = "remove_rate_limit"
+
+var _ sdk.Msg = &MsgRemoveRateLimit{}
+
+func NewMsgRemoveRateLimit(from string, channelId string, direction RateLimitDirection) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		From:      from,
+		ChannelId: channelId,
+		Direction: direction,
+	}
+}
+
+func (msg *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRateLimit) Type() string {
+	return TypeMsgRemoveRateLimit
+}
+
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestRedemption = "request_redemption"
+
+var _ sdk.Msg = &MsgRequestRedemption{}
+
+func NewMsgRequestRedemption(from string, amount sdk.Coin) *MsgRequestRedemption {
+	return &MsgRequestRedemption{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgRequestRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestRedemption) Type() string {
+	return TypeMsgRequestRedemption
+}
+
+func (msg *MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgRequestRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid redemption amount (%s)", msg.Amount)
+	}
+	return nil
+}
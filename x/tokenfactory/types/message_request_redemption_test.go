@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestRedemption_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestRedemption
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestRedemption{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgRequestRedemption{
+				From:   sample.AccAddress(),
+				Amount: sdk.NewInt64Coin("uusdc", 0),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgRequestRedemption{
+				From:   sample.AccAddress(),
+				Amount: sdk.NewInt64Coin("uusdc", 100),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRateLimit = "set_rate_limit"
+
+var _ sdk.Msg = &MsgSetRateLimit{}
+
+func NewMsgSetRateLimit(from string, channelId string, direction RateLimitDirection, maxAmount sdk.Int, maxSupplyFraction sdk.Dec, window time.Duration) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		From:              from,
+		ChannelId:         channelId,
+		Direction:         direction,
+		MaxAmount:         maxAmount,
+		MaxSupplyFraction: maxSupplyFraction,
+		Window:            window,
+	}
+}
+
+func (msg *MsgSetRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRateLimit) Type() string {
+	return TypeMsgSetRateLimit
+}
+
+func (msg *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSetRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	return ValidateRateLimit(msg.ChannelId, msg.Direction, msg.MaxAmount, msg.MaxSupplyFraction, msg.Window)
+}
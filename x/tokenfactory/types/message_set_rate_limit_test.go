@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetRateLimit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRateLimit{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgSetRateLimit{
+				From:              sample.AccAddress(),
+				ChannelId:         "invalid channel",
+				MaxAmount:         sdk.NewInt(100),
+				MaxSupplyFraction: sdk.ZeroDec(),
+				Window:            time.Hour,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no quota",
+			msg: MsgSetRateLimit{
+				From:              sample.AccAddress(),
+				ChannelId:         "channel-0",
+				MaxAmount:         sdk.ZeroInt(),
+				MaxSupplyFraction: sdk.ZeroDec(),
+				Window:            time.Hour,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "supply fraction above one",
+			msg: MsgSetRateLimit{
+				From:              sample.AccAddress(),
+				ChannelId:         "channel-0",
+				MaxAmount:         sdk.ZeroInt(),
+				MaxSupplyFraction: sdk.NewDec(2),
+				Window:            time.Hour,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no window",
+			msg: MsgSetRateLimit{
+				From:              sample.AccAddress(),
+				ChannelId:         "channel-0",
+				MaxAmount:         sdk.NewInt(100),
+				MaxSupplyFraction: sdk.ZeroDec(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSetRateLimit{
+				From:              sample.AccAddress(),
+				ChannelId:         "channel-0",
+				Direction:         RateLimitOutflow,
+				MaxAmount:         sdk.NewInt(100),
+				MaxSupplyFraction: sdk.NewDecWithPrec(5, 2),
+				Window:            time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
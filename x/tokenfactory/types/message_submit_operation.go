@@ -0,0 +1,82 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitOperation = "submit_operation"
+
+var (
+	_ sdk.Msg                          = &MsgSubmitOperation{}
+	_ cdctypes.UnpackInterfacesMessage = MsgSubmitOperation{}
+)
+
+func NewMsgSubmitOperation(from string, msg sdk.Msg) (*MsgSubmitOperation, error) {
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitOperation{
+		From: from,
+		Msg:  any,
+	}, nil
+}
+
+func (msg *MsgSubmitOperation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitOperation) Type() string {
+	return TypeMsgSubmitOperation
+}
+
+func (msg *MsgSubmitOperation) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes resolves the wrapped message through the global proto registry, as
+// ModuleCdc has no knowledge of the types that can be packed into the Any.
+func (msg *MsgSubmitOperation) GetSignBytes() []byte {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// GetMessage returns the message wrapped by the operation.
+func (msg *MsgSubmitOperation) GetMessage() (sdk.Msg, error) {
+	inner, ok := msg.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "operation does not contain a message")
+	}
+	return inner, nil
+}
+
+func (msg *MsgSubmitOperation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "operation message is empty")
+	}
+	inner, err := msg.GetMessage()
+	if err != nil {
+		return err
+	}
+	return inner.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitOperation) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var inner sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &inner)
+}
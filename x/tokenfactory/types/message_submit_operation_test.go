@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitOperation_ValidateBasic(t *testing.T) {
+	newMsg := func(from string, inner sdk.Msg) MsgSubmitOperation {
+		msg, err := NewMsgSubmitOperation(from, inner)
+		require.NoError(t, err)
+		return *msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSubmitOperation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitOperation{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty message",
+			msg: MsgSubmitOperation{
+				From: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid message",
+			msg:  newMsg(sample.AccAddress(), &MsgUpdateOwner{From: "invalid_address"}),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  newMsg(sample.AccAddress(), &MsgUpdateOwner{From: sample.AccAddress(), Address: sample.AccAddress()}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitOperation_GetSignBytes(t *testing.T) {
+	msg, err := NewMsgSubmitOperation(sample.AccAddress(), &MsgUpdateOwner{From: sample.AccAddress(), Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Contains(t, string(msg.GetSignBytes()), "/hero.tokenfactory.MsgUpdateOwner")
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitReserveAttestation = "submit_reserve_attestation"
+
+var _ sdk.Msg = &MsgSubmitReserveAttestation{}
+
+func NewMsgSubmitReserveAttestation(from string, reserves sdk.Coin, timestamp time.Time, auditor string, documentHash string) *MsgSubmitReserveAttestation {
+	return &MsgSubmitReserveAttestation{
+		From:         from,
+		Reserves:     reserves,
+		Timestamp:    timestamp,
+		Auditor:      auditor,
+		DocumentHash: documentHash,
+	}
+}
+
+func (msg *MsgSubmitReserveAttestation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitReserveAttestation) Type() string {
+	return TypeMsgSubmitReserveAttestation
+}
+
+func (msg *MsgSubmitReserveAttestation) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgSubmitReserveAttestation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitReserveAttestation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if !msg.Reserves.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid reserves (%s)", msg.Reserves)
+	}
+	if msg.Timestamp.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "attestation timestamp is not set")
+	}
+	if msg.Auditor == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auditor is not set")
+	}
+	if _, err := hex.DecodeString(msg.DocumentHash); err != nil || msg.DocumentHash == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "document hash must be a non-empty hex string")
+	}
+	return nil
+}
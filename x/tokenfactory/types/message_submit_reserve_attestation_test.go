@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitReserveAttestation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitReserveAttestation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitReserveAttestation{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing document hash",
+			msg: MsgSubmitReserveAttestation{
+				From:      sample.AccAddress(),
+				Reserves:  sdk.NewInt64Coin("uusdc", 100),
+				Timestamp: time.Now(),
+				Auditor:   "auditor",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSubmitReserveAttestation{
+				From:         sample.AccAddress(),
+				Reserves:     sdk.NewInt64Coin("uusdc", 100),
+				Timestamp:    time.Now(),
+				Auditor:      "auditor",
+				DocumentHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferWithAuthorization = "transfer_with_authorization"
+
+var _ sdk.Msg = &MsgTransferWithAuthorization{}
+
+func NewMsgTransferWithAuthorization(from string, authorization TransferAuthorization, signature []byte) *MsgTransferWithAuthorization {
+	return &MsgTransferWithAuthorization{
+		From:          from,
+		Authorization: authorization,
+		Signature:     signature,
+	}
+}
+
+func (msg *MsgTransferWithAuthorization) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferWithAuthorization) Type() string {
+	return TypeMsgTransferWithAuthorization
+}
+
+func (msg *MsgTransferWithAuthorization) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgTransferWithAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferWithAuthorization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := msg.Authorization.Validate(); err != nil {
+		return err
+	}
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(ErrAuthorization, "signature is empty")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferWithAuthorization_ValidateBasic(t *testing.T) {
+	now := time.Now().UTC()
+	authorization := func(modify func(*TransferAuthorization)) TransferAuthorization {
+		a := TransferAuthorization{
+			From:        sample.AccAddress(),
+			To:          sample.AccAddress(),
+			Amount:      sdk.NewInt64Coin("uusdc", 1),
+			ValidAfter:  now,
+			ValidBefore: now.Add(time.Hour),
+			Nonce:       "1",
+		}
+		modify(&a)
+		return a
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgTransferWithAuthorization
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferWithAuthorization{
+				From:          "invalid_address",
+				Authorization: authorization(func(*TransferAuthorization) {}),
+				Signature:     []byte("signature"),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(a *TransferAuthorization) { a.To = "invalid_address" }),
+				Signature:     []byte("signature"),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(a *TransferAuthorization) { a.Amount = sdk.NewInt64Coin("uusdc", 0) }),
+				Signature:     []byte("signature"),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "empty validity window",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(a *TransferAuthorization) { a.ValidBefore = a.ValidAfter }),
+				Signature:     []byte("signature"),
+			},
+			err: ErrAuthorization,
+		}, {
+			name: "empty nonce",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(a *TransferAuthorization) { a.Nonce = "" }),
+				Signature:     []byte("signature"),
+			},
+			err: ErrAuthorization,
+		}, {
+			name: "empty signature",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(*TransferAuthorization) {}),
+			},
+			err: ErrAuthorization,
+		}, {
+			name: "valid address",
+			msg: MsgTransferWithAuthorization{
+				From:          sample.AccAddress(),
+				Authorization: authorization(func(*TransferAuthorization) {}),
+				Signature:     []byte("signature"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
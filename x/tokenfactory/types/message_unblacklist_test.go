@@ -23,7 +23,8 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 		}, {
 			name: "valid address",
 			msg: MsgUnblacklist{
-				From: sample.AccAddress(),
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
 			},
 		},
 	}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateAttester = "update_attester"
+
+var _ sdk.Msg = &MsgUpdateAttester{}
+
+func NewMsgUpdateAttester(from string, address string) *MsgUpdateAttester {
+	return &MsgUpdateAttester{
+		From:    from,
+		Address: address,
+	}
+}
+
+func (msg *MsgUpdateAttester) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateAttester) Type() string {
+	return TypeMsgUpdateAttester
+}
+
+func (msg *MsgUpdateAttester) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateAttester) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAttester) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester address (%s)", err)
+	}
+	return nil
+}
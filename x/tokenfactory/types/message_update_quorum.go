@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateQuorum = "update_quorum"
+
+var _ sdk.Msg = &MsgUpdateQuorum{}
+
+func NewMsgUpdateQuorum(from string, members []string, threshold uint64, expiry time.Duration, mintThreshold *sdk.Coin) *MsgUpdateQuorum {
+	return &MsgUpdateQuorum{
+		From:          from,
+		Members:       members,
+		Threshold:     threshold,
+		Expiry:        expiry,
+		MintThreshold: mintThreshold,
+	}
+}
+
+func (msg *MsgUpdateQuorum) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateQuorum) Type() string {
+	return TypeMsgUpdateQuorum
+}
+
+func (msg *MsgUpdateQuorum) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateQuorum) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateQuorum) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	members := make(map[string]struct{})
+	for _, member := range msg.Members {
+		_, err = sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+		}
+		if _, ok := members[member]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated member (%s)", member)
+		}
+		members[member] = struct{}{}
+	}
+	// an empty member set removes the quorum
+	if len(msg.Members) == 0 {
+		if msg.Threshold != 0 || msg.MintThreshold != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quorum without members can not have thresholds")
+		}
+		return nil
+	}
+	if msg.Threshold == 0 || msg.Threshold > uint64(len(msg.Members)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "threshold must be between 1 and the number of members")
+	}
+	if msg.Expiry <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiry must be positive")
+	}
+	if msg.MintThreshold != nil {
+		if err := msg.MintThreshold.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint threshold (%s)", err)
+		}
+	}
+	return nil
+}
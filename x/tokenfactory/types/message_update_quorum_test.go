@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateQuorum_ValidateBasic(t *testing.T) {
+	members := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateQuorum
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateQuorum{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid signer",
+			msg: MsgUpdateQuorum{
+				From:      sample.AccAddress(),
+				Members:   []string{"invalid_address"},
+				Threshold: 1,
+				Expiry:    time.Hour,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicated signer",
+			msg: MsgUpdateQuorum{
+				From:      sample.AccAddress(),
+				Members:   []string{members[0], members[0]},
+				Threshold: 1,
+				Expiry:    time.Hour,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "threshold above members",
+			msg: MsgUpdateQuorum{
+				From:      sample.AccAddress(),
+				Members:   members,
+				Threshold: 4,
+				Expiry:    time.Hour,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero expiry",
+			msg: MsgUpdateQuorum{
+				From:      sample.AccAddress(),
+				Members:   members,
+				Threshold: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "threshold without members",
+			msg: MsgUpdateQuorum{
+				From:      sample.AccAddress(),
+				Threshold: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "remove quorum",
+			msg: MsgUpdateQuorum{
+				From: sample.AccAddress(),
+			},
+		}, {
+			name: "valid address",
+			msg: MsgUpdateQuorum{
+				From:          sample.AccAddress(),
+				Members:       members,
+				Threshold:     2,
+				Expiry:        time.Hour,
+				MintThreshold: &sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(1000000)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
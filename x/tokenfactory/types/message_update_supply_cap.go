@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateSupplyCap = "update_supply_cap"
+
+var _ sdk.Msg = &MsgUpdateSupplyCap{}
+
+func NewMsgUpdateSupplyCap(from string, amount sdk.Coin) *MsgUpdateSupplyCap {
+	return &MsgUpdateSupplyCap{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgUpdateSupplyCap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateSupplyCap) Type() string {
+	return TypeMsgUpdateSupplyCap
+}
+
+func (msg *MsgUpdateSupplyCap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgUpdateSupplyCap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateSupplyCap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid supply cap (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/strangelove-ventures/hero/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateSupplyCap_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateSupplyCap
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateSupplyCap{
+				From: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid amount",
+			msg: MsgUpdateSupplyCap{
+				From:   sample.AccAddress(),
+				Amount: sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgUpdateSupplyCap{
+				From:   sample.AccAddress(),
+				Amount: sdk.NewInt64Coin("uusdc", 1000000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/minter_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MinterStats holds the lifetime mint and burn counters of a minter.
+type MinterStats struct {
+	Address        string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Minted         types.Coin `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted"`
+	Burned         types.Coin `protobuf:"bytes,3,opt,name=burned,proto3" json:"burned"`
+	MintCount      uint64     `protobuf:"varint,4,opt,name=mintCount,proto3" json:"mintCount,omitempty"`
+	LastMintHeight int64      `protobuf:"varint,5,opt,name=lastMintHeight,proto3" json:"lastMintHeight,omitempty"`
+}
+
+func (m *MinterStats) Reset()         { *m = MinterStats{} }
+func (m *MinterStats) String() string { return proto.CompactTextString(m) }
+func (*MinterStats) ProtoMessage()    {}
+func (*MinterStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5b1b89bd3c53495, []int{0}
+}
+func (m *MinterStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterStats.Merge(m, src)
+}
+func (m *MinterStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterStats proto.InternalMessageInfo
+
+func (m *MinterStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MinterStats) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *MinterStats) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
+func (m *MinterStats) GetMintCount() uint64 {
+	if m != nil {
+		return m.MintCount
+	}
+	return 0
+}
+
+func (m *MinterStats) GetLastMintHeight() int64 {
+	if m != nil {
+		return m.LastMintHeight
+	}
+	return 0
+}
+
+// MintingTotals holds the lifetime mint and burn counters across all minters.
+type MintingTotals struct {
+	Minted         types.Coin `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted"`
+	Burned         types.Coin `protobuf:"bytes,2,opt,name=burned,proto3" json:"burned"`
+	MintCount      uint64     `protobuf:"varint,3,opt,name=mintCount,proto3" json:"mintCount,omitempty"`
+	LastMintHeight int64      `protobuf:"varint,4,opt,name=lastMintHeight,proto3" json:"lastMintHeight,omitempty"`
+}
+
+func (m *MintingTotals) Reset()         { *m = MintingTotals{} }
+func (m *MintingTotals) String() string { return proto.CompactTextString(m) }
+func (*MintingTotals) ProtoMessage()    {}
+func (*MintingTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5b1b89bd3c53495, []int{1}
+}
+func (m *MintingTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintingTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintingTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintingTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintingTotals.Merge(m, src)
+}
+func (m *MintingTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintingTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintingTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintingTotals proto.InternalMessageInfo
+
+func (m *MintingTotals) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *MintingTotals) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
+func (m *MintingTotals) GetMintCount() uint64 {
+	if m != nil {
+		return m.MintCount
+	}
+	return 0
+}
+
+func (m *MintingTotals) GetLastMintHeight() int64 {
+	if m != nil {
+		return m.LastMintHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MinterStats)(nil), "hero.tokenfactory.MinterStats")
+	proto.RegisterType((*MintingTotals)(nil), "hero.tokenfactory.MintingTotals")
+}
+
+func init() { proto.RegisterFile("tokenfactory/minter_stats.proto", fileDescriptor_e5b1b89bd3c53495) }
+
+var fileDescriptor_e5b1b89bd3c53495 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x33, 0x6d, 0x6e, 0x2f, 0x4d, 0xb9, 0x17, 0x0c, 0x2e, 0x62, 0x91, 0x34, 0x74, 0x21,
+	0xd9, 0x38, 0x43, 0x75, 0x21, 0x6e, 0xdb, 0x8d, 0x1b, 0x37, 0xa9, 0x2b, 0x37, 0x32, 0x49, 0xc6,
+	0x34, 0xd8, 0xce, 0x29, 0x33, 0x27, 0xc5, 0xbe, 0x85, 0x6f, 0x65, 0x97, 0x5d, 0xba, 0x52, 0x69,
+	0x5f, 0x44, 0x26, 0xa9, 0x58, 0x45, 0x50, 0x74, 0x77, 0xfe, 0x7c, 0xe7, 0xc7, 0xf9, 0xe0, 0x73,
+	0x3a, 0x08, 0x37, 0x42, 0x5e, 0xf3, 0x04, 0x41, 0xcd, 0xd9, 0x24, 0x97, 0x28, 0xd4, 0x95, 0x46,
+	0x8e, 0x9a, 0x4e, 0x15, 0x20, 0xb8, 0x3b, 0x23, 0xa1, 0x80, 0x6e, 0xab, 0xda, 0xbb, 0x19, 0x64,
+	0x50, 0x6e, 0x99, 0xa9, 0x2a, 0x61, 0xdb, 0x4f, 0x40, 0x4f, 0x40, 0xb3, 0x98, 0x6b, 0xc1, 0x66,
+	0xbd, 0x58, 0x20, 0xef, 0xb1, 0x04, 0x72, 0x59, 0xed, 0xbb, 0x4f, 0xc4, 0x69, 0x9d, 0x97, 0xfc,
+	0xa1, 0xc1, 0xbb, 0x9e, 0xf3, 0x97, 0xa7, 0xa9, 0x12, 0x5a, 0x7b, 0x24, 0x20, 0x61, 0x33, 0x7a,
+	0x6d, 0xdd, 0x13, 0xa7, 0x51, 0x3e, 0x92, 0x7a, 0xb5, 0x80, 0x84, 0xad, 0xa3, 0x3d, 0x5a, 0xa1,
+	0xa9, 0x41, 0xd3, 0x0d, 0x9a, 0x0e, 0x20, 0x97, 0x7d, 0x7b, 0xf1, 0xd8, 0xb1, 0xa2, 0x8d, 0xdc,
+	0x1c, 0xc6, 0x85, 0x92, 0x22, 0xf5, 0xea, 0xdf, 0x3c, 0xac, 0xe4, 0xee, 0xbe, 0xd3, 0x34, 0x88,
+	0x01, 0x14, 0x12, 0x3d, 0x3b, 0x20, 0xa1, 0x1d, 0xbd, 0x0d, 0xdc, 0x03, 0xe7, 0xff, 0x98, 0x6b,
+	0x34, 0xcf, 0x9f, 0x89, 0x3c, 0x1b, 0xa1, 0xf7, 0x27, 0x20, 0x61, 0x3d, 0xfa, 0x30, 0xed, 0xde,
+	0x13, 0xe7, 0x9f, 0x69, 0x73, 0x99, 0x5d, 0x00, 0xf2, 0xf1, 0xb6, 0x13, 0xf2, 0x53, 0x27, 0xb5,
+	0x5f, 0x38, 0xa9, 0x7f, 0xed, 0xc4, 0xfe, 0xcc, 0x49, 0x7f, 0xb8, 0x58, 0xf9, 0x64, 0xb9, 0xf2,
+	0xc9, 0xf3, 0xca, 0x27, 0x77, 0x6b, 0xdf, 0x5a, 0xae, 0x7d, 0xeb, 0x61, 0xed, 0x5b, 0x97, 0xa7,
+	0x59, 0x8e, 0xa3, 0x22, 0xa6, 0x09, 0x4c, 0x98, 0x46, 0xc5, 0x65, 0x26, 0xc6, 0x30, 0x13, 0x87,
+	0x33, 0x21, 0xb1, 0x50, 0x42, 0x33, 0x13, 0x17, 0x76, 0xcb, 0xde, 0xc5, 0x0a, 0xe7, 0x53, 0xa1,
+	0xe3, 0x46, 0x99, 0x83, 0xe3, 0x97, 0x01, 0x00, 0x84, 0x2e, 0xf3, 0x8e, 0x73, 0x02, 0x00, 0x00,
+}
+
+func (m *MinterStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastMintHeight != 0 {
+		i = encodeVarintMinterStats(dAtA, i, uint64(m.LastMintHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MintCount != 0 {
+		i = encodeVarintMinterStats(dAtA, i, uint64(m.MintCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMinterStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMinterStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMinterStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintingTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintingTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintingTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastMintHeight != 0 {
+		i = encodeVarintMinterStats(dAtA, i, uint64(m.LastMintHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MintCount != 0 {
+		i = encodeVarintMinterStats(dAtA, i, uint64(m.MintCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMinterStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMinterStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMinterStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMinterStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MinterStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMinterStats(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMinterStats(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovMinterStats(uint64(l))
+	if m.MintCount != 0 {
+		n += 1 + sovMinterStats(uint64(m.MintCount))
+	}
+	if m.LastMintHeight != 0 {
+		n += 1 + sovMinterStats(uint64(m.LastMintHeight))
+	}
+	return n
+}
+
+func (m *MintingTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovMinterStats(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovMinterStats(uint64(l))
+	if m.MintCount != 0 {
+		n += 1 + sovMinterStats(uint64(m.MintCount))
+	}
+	if m.LastMintHeight != 0 {
+		n += 1 + sovMinterStats(uint64(m.LastMintHeight))
+	}
+	return n
+}
+
+func sovMinterStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMinterStats(x uint64) (n int) {
+	return sovMinterStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MinterStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinterStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCount", wireType)
+			}
+			m.MintCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintHeight", wireType)
+			}
+			m.LastMintHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMintHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinterStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintingTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinterStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintingTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintingTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCount", wireType)
+			}
+			m.MintCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintHeight", wireType)
+			}
+			m.LastMintHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMintHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinterStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinterStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMinterStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMinterStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinterStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMinterStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMinterStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMinterStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMinterStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMinterStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMinterStats = fmt.Errorf("proto: unexpected end of group")
+)
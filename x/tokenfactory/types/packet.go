@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MinBlacklistUpdateTimeout is the shortest time after the block time a blacklist update may time out
+// at. A timed out packet closes the ordered blacklist sync channel it was sent through.
+const MinBlacklistUpdateTimeout = time.Hour
+
+// ValidateBasic is used for validating the blacklist update packet
+func (p BlacklistUpdatePacketData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising the blacklist update packet
+func (p BlacklistUpdatePacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlacklistSyncPacketData
+
+	modulePacket.Packet = &BlacklistSyncPacketData_BlacklistUpdate{&p}
+
+	return modulePacket.Marshal()
+}
+
+// ValidateBasic is used for validating the bridge transfer packet
+func (p BridgeTransferPacketData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBridgePacket, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBridgePacket, "invalid receiver address (%s)", err)
+	}
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBridgePacket, "invalid amount (%s)", p.Amount)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising the bridge transfer packet
+func (p BridgeTransferPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BridgePacketData
+
+	modulePacket.Packet = &BridgePacketData_BridgeTransfer{&p}
+
+	return modulePacket.Marshal()
+}
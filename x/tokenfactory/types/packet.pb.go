@@ -0,0 +1,1638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlacklistSyncPacketData is the packet data sent over the blacklist sync port.
+type BlacklistSyncPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*BlacklistSyncPacketData_NoData
+	//	*BlacklistSyncPacketData_BlacklistUpdate
+	Packet isBlacklistSyncPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *BlacklistSyncPacketData) Reset()         { *m = BlacklistSyncPacketData{} }
+func (m *BlacklistSyncPacketData) String() string { return proto.CompactTextString(m) }
+func (*BlacklistSyncPacketData) ProtoMessage()    {}
+func (*BlacklistSyncPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{0}
+}
+func (m *BlacklistSyncPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistSyncPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistSyncPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistSyncPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistSyncPacketData.Merge(m, src)
+}
+func (m *BlacklistSyncPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistSyncPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistSyncPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistSyncPacketData proto.InternalMessageInfo
+
+type isBlacklistSyncPacketData_Packet interface {
+	isBlacklistSyncPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BlacklistSyncPacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
+}
+type BlacklistSyncPacketData_BlacklistUpdate struct {
+	BlacklistUpdate *BlacklistUpdatePacketData `protobuf:"bytes,2,opt,name=blacklistUpdate,proto3,oneof" json:"blacklistUpdate,omitempty"`
+}
+
+func (*BlacklistSyncPacketData_NoData) isBlacklistSyncPacketData_Packet()          {}
+func (*BlacklistSyncPacketData_BlacklistUpdate) isBlacklistSyncPacketData_Packet() {}
+
+func (m *BlacklistSyncPacketData) GetPacket() isBlacklistSyncPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *BlacklistSyncPacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*BlacklistSyncPacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *BlacklistSyncPacketData) GetBlacklistUpdate() *BlacklistUpdatePacketData {
+	if x, ok := m.GetPacket().(*BlacklistSyncPacketData_BlacklistUpdate); ok {
+		return x.BlacklistUpdate
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BlacklistSyncPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BlacklistSyncPacketData_NoData)(nil),
+		(*BlacklistSyncPacketData_BlacklistUpdate)(nil),
+	}
+}
+
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// BlacklistUpdatePacketData sets whether an address is blacklisted on the receiving chain.
+type BlacklistUpdatePacketData struct {
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Blacklisted bool   `protobuf:"varint,2,opt,name=blacklisted,proto3" json:"blacklisted,omitempty"`
+}
+
+func (m *BlacklistUpdatePacketData) Reset()         { *m = BlacklistUpdatePacketData{} }
+func (m *BlacklistUpdatePacketData) String() string { return proto.CompactTextString(m) }
+func (*BlacklistUpdatePacketData) ProtoMessage()    {}
+func (*BlacklistUpdatePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{2}
+}
+func (m *BlacklistUpdatePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistUpdatePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistUpdatePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistUpdatePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistUpdatePacketData.Merge(m, src)
+}
+func (m *BlacklistUpdatePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistUpdatePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistUpdatePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistUpdatePacketData proto.InternalMessageInfo
+
+func (m *BlacklistUpdatePacketData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlacklistUpdatePacketData) GetBlacklisted() bool {
+	if m != nil {
+		return m.Blacklisted
+	}
+	return false
+}
+
+// BlacklistUpdatePacketAck is the acknowledgement of an applied blacklist update.
+type BlacklistUpdatePacketAck struct {
+}
+
+func (m *BlacklistUpdatePacketAck) Reset()         { *m = BlacklistUpdatePacketAck{} }
+func (m *BlacklistUpdatePacketAck) String() string { return proto.CompactTextString(m) }
+func (*BlacklistUpdatePacketAck) ProtoMessage()    {}
+func (*BlacklistUpdatePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{3}
+}
+func (m *BlacklistUpdatePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistUpdatePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistUpdatePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistUpdatePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistUpdatePacketAck.Merge(m, src)
+}
+func (m *BlacklistUpdatePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistUpdatePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistUpdatePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistUpdatePacketAck proto.InternalMessageInfo
+
+// BridgePacketData is the packet data sent over the bridge port.
+type BridgePacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*BridgePacketData_NoData
+	//	*BridgePacketData_BridgeTransfer
+	Packet isBridgePacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *BridgePacketData) Reset()         { *m = BridgePacketData{} }
+func (m *BridgePacketData) String() string { return proto.CompactTextString(m) }
+func (*BridgePacketData) ProtoMessage()    {}
+func (*BridgePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{4}
+}
+func (m *BridgePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgePacketData.Merge(m, src)
+}
+func (m *BridgePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgePacketData proto.InternalMessageInfo
+
+type isBridgePacketData_Packet interface {
+	isBridgePacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BridgePacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
+}
+type BridgePacketData_BridgeTransfer struct {
+	BridgeTransfer *BridgeTransferPacketData `protobuf:"bytes,2,opt,name=bridgeTransfer,proto3,oneof" json:"bridgeTransfer,omitempty"`
+}
+
+func (*BridgePacketData_NoData) isBridgePacketData_Packet()         {}
+func (*BridgePacketData_BridgeTransfer) isBridgePacketData_Packet() {}
+
+func (m *BridgePacketData) GetPacket() isBridgePacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *BridgePacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*BridgePacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *BridgePacketData) GetBridgeTransfer() *BridgeTransferPacketData {
+	if x, ok := m.GetPacket().(*BridgePacketData_BridgeTransfer); ok {
+		return x.BridgeTransfer
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BridgePacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BridgePacketData_NoData)(nil),
+		(*BridgePacketData_BridgeTransfer)(nil),
+	}
+}
+
+// BridgeTransferPacketData mints amount of the minting denom of the receiving chain to receiver
+// after it was burned from sender on the sending chain.
+type BridgeTransferPacketData struct {
+	Sender   string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string                                 `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BridgeTransferPacketData) Reset()         { *m = BridgeTransferPacketData{} }
+func (m *BridgeTransferPacketData) String() string { return proto.CompactTextString(m) }
+func (*BridgeTransferPacketData) ProtoMessage()    {}
+func (*BridgeTransferPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{5}
+}
+func (m *BridgeTransferPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeTransferPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeTransferPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeTransferPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeTransferPacketData.Merge(m, src)
+}
+func (m *BridgeTransferPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeTransferPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeTransferPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeTransferPacketData proto.InternalMessageInfo
+
+func (m *BridgeTransferPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *BridgeTransferPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// BridgeTransferPacketAck is the acknowledgement of a minted bridge transfer.
+type BridgeTransferPacketAck struct {
+}
+
+func (m *BridgeTransferPacketAck) Reset()         { *m = BridgeTransferPacketAck{} }
+func (m *BridgeTransferPacketAck) String() string { return proto.CompactTextString(m) }
+func (*BridgeTransferPacketAck) ProtoMessage()    {}
+func (*BridgeTransferPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573fe5cfde2998f, []int{6}
+}
+func (m *BridgeTransferPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeTransferPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeTransferPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeTransferPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeTransferPacketAck.Merge(m, src)
+}
+func (m *BridgeTransferPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeTransferPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeTransferPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeTransferPacketAck proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BlacklistSyncPacketData)(nil), "hero.tokenfactory.BlacklistSyncPacketData")
+	proto.RegisterType((*NoData)(nil), "hero.tokenfactory.NoData")
+	proto.RegisterType((*BlacklistUpdatePacketData)(nil), "hero.tokenfactory.BlacklistUpdatePacketData")
+	proto.RegisterType((*BlacklistUpdatePacketAck)(nil), "hero.tokenfactory.BlacklistUpdatePacketAck")
+	proto.RegisterType((*BridgePacketData)(nil), "hero.tokenfactory.BridgePacketData")
+	proto.RegisterType((*BridgeTransferPacketData)(nil), "hero.tokenfactory.BridgeTransferPacketData")
+	proto.RegisterType((*BridgeTransferPacketAck)(nil), "hero.tokenfactory.BridgeTransferPacketAck")
+}
+
+func init() { proto.RegisterFile("tokenfactory/packet.proto", fileDescriptor_4573fe5cfde2998f) }
+
+var fileDescriptor_4573fe5cfde2998f = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0xbd, 0x20, 0x19, 0x67, 0x22, 0xf1, 0x67, 0x85, 0x88, 0x7d, 0x85, 0x13, 0xb9, 0x40,
+	0x48, 0x10, 0x5b, 0x22, 0x15, 0x65, 0x2c, 0x84, 0x42, 0x83, 0x90, 0x43, 0x04, 0xa2, 0x5b, 0xaf,
+	0x27, 0x8e, 0xe5, 0xbb, 0x5d, 0x6b, 0x77, 0xef, 0xc4, 0xbd, 0x05, 0x15, 0xcf, 0x40, 0xcd, 0x53,
+	0xa4, 0x4c, 0x89, 0x28, 0x22, 0x74, 0xf7, 0x22, 0xc8, 0x7b, 0x4e, 0xb0, 0x83, 0x53, 0x51, 0x79,
+	0xc7, 0xf3, 0xcd, 0xef, 0x9b, 0xfd, 0x64, 0x43, 0x60, 0x64, 0x8d, 0xe2, 0x94, 0x71, 0x23, 0xd5,
+	0x32, 0x69, 0x18, 0xaf, 0xd1, 0xc4, 0x8d, 0x92, 0x46, 0xd2, 0x47, 0x67, 0xa8, 0x64, 0xdc, 0xef,
+	0x4f, 0x1e, 0x97, 0xb2, 0x94, 0xb6, 0x9b, 0xb4, 0xa7, 0x8d, 0x30, 0xfa, 0x41, 0x60, 0x27, 0x9d,
+	0x32, 0x5e, 0x4f, 0x2b, 0x6d, 0x8e, 0x97, 0x82, 0xbf, 0xb7, 0x98, 0xd7, 0xcc, 0x30, 0x7a, 0x00,
+	0xae, 0x90, 0xed, 0xc9, 0x27, 0x7b, 0xe4, 0xd9, 0xf6, 0xcb, 0x20, 0xfe, 0x87, 0x1a, 0xbf, 0xb3,
+	0x82, 0x23, 0x27, 0xeb, 0xa4, 0xf4, 0x13, 0x3c, 0xc8, 0xaf, 0x78, 0x27, 0x4d, 0xc1, 0x0c, 0xfa,
+	0x77, 0xec, 0xf4, 0x8b, 0x91, 0xe9, 0x74, 0xa8, 0xfc, 0xeb, 0x7d, 0xe4, 0x64, 0x37, 0x31, 0xa9,
+	0x07, 0xee, 0xe6, 0x8e, 0x91, 0x07, 0xee, 0xc6, 0x37, 0xfa, 0x08, 0xc1, 0xad, 0x0c, 0xea, 0xc3,
+	0x3d, 0x56, 0x14, 0x0a, 0xb5, 0xb6, 0x17, 0xd8, 0xca, 0xae, 0x4a, 0xba, 0x07, 0xdb, 0xd7, 0x74,
+	0x2c, 0xec, 0x82, 0x5e, 0xd6, 0x7f, 0x15, 0x4d, 0xc0, 0x1f, 0x05, 0x1f, 0xf2, 0x3a, 0xfa, 0x4e,
+	0xe0, 0x61, 0xaa, 0xaa, 0xa2, 0xc4, 0xff, 0x0d, 0xeb, 0x04, 0xee, 0xe7, 0x16, 0xf4, 0x41, 0x31,
+	0xa1, 0x4f, 0x51, 0x75, 0x59, 0x3d, 0x1f, 0xcb, 0x6a, 0x20, 0x1c, 0x44, 0x75, 0x03, 0xd2, 0x4b,
+	0xea, 0x1b, 0x01, 0xff, 0xb6, 0x41, 0xfa, 0x04, 0x5c, 0x8d, 0xa2, 0x40, 0xd5, 0xc5, 0xd3, 0x55,
+	0x74, 0x02, 0x9e, 0x42, 0x8e, 0xd5, 0xa2, 0xdb, 0x67, 0x2b, 0xbb, 0xae, 0xe9, 0x1b, 0x70, 0xd9,
+	0x4c, 0xce, 0x85, 0xf1, 0xef, 0xb6, 0x9d, 0x34, 0x3e, 0xbf, 0xdc, 0x75, 0x7e, 0x5d, 0xee, 0x3e,
+	0x2d, 0x2b, 0x73, 0x36, 0xcf, 0x63, 0x2e, 0x67, 0x09, 0x97, 0x7a, 0x26, 0x75, 0xf7, 0xd8, 0xd7,
+	0x45, 0x9d, 0x98, 0x65, 0x83, 0x3a, 0x7e, 0x2b, 0x4c, 0xd6, 0x4d, 0x47, 0x01, 0xec, 0x8c, 0xed,
+	0x75, 0xc8, 0xeb, 0xf4, 0xf8, 0x7c, 0x15, 0x92, 0x8b, 0x55, 0x48, 0x7e, 0xaf, 0x42, 0xf2, 0x75,
+	0x1d, 0x3a, 0x17, 0xeb, 0xd0, 0xf9, 0xb9, 0x0e, 0x9d, 0xcf, 0xaf, 0x7a, 0x26, 0xda, 0x28, 0x26,
+	0x4a, 0x9c, 0xca, 0x05, 0xee, 0x2f, 0x50, 0x98, 0xb9, 0x42, 0x9d, 0xb4, 0xa9, 0x25, 0x5f, 0x92,
+	0xc1, 0x7f, 0x61, 0xbd, 0x73, 0xd7, 0x7e, 0xee, 0x07, 0x7f, 0x06, 0x00, 0x98, 0xc0, 0xc2, 0x46,
+	0x34, 0x03, 0x00, 0x00,
+}
+
+func (m *BlacklistSyncPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistSyncPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistSyncPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistSyncPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistSyncPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlacklistSyncPacketData_BlacklistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistSyncPacketData_BlacklistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlacklistUpdate != nil {
+		{
+			size, err := m.BlacklistUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistUpdatePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistUpdatePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistUpdatePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blacklisted {
+		i--
+		if m.Blacklisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistUpdatePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistUpdatePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistUpdatePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgePacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BridgePacketData_BridgeTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePacketData_BridgeTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BridgeTransfer != nil {
+		{
+			size, err := m.BridgeTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BridgeTransferPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTransferPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTransferPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeTransferPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTransferPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTransferPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlacklistSyncPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *BlacklistSyncPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlacklistSyncPacketData_BlacklistUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlacklistUpdate != nil {
+		l = m.BlacklistUpdate.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlacklistUpdatePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Blacklisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlacklistUpdatePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BridgePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *BridgePacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BridgePacketData_BridgeTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeTransfer != nil {
+		l = m.BridgeTransfer.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BridgeTransferPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *BridgeTransferPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlacklistSyncPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistSyncPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistSyncPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlacklistSyncPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlacklistUpdatePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlacklistSyncPacketData_BlacklistUpdate{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistUpdatePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistUpdatePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistUpdatePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blacklisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistUpdatePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistUpdatePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistUpdatePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BridgePacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BridgeTransferPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BridgePacketData_BridgeTransfer{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTransferPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTransferPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTransferPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTransferPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTransferPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTransferPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
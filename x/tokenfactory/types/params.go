@@ -1,34 +1,80 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyEnforceReserves = []byte("EnforceReserves")
+	// mints are not limited by the attested reserves unless enforcement is enabled
+	DefaultEnforceReserves = false
+
+	KeyRoleChangeDelay = []byte("RoleChangeDelay")
+	// role changes take effect immediately unless a delay is configured
+	DefaultRoleChangeDelay = time.Duration(0)
+
+	KeyFeeConversionRate = []byte("FeeConversionRate")
+	// fees can only be paid in the minting denom once a conversion rate is configured
+	DefaultFeeConversionRate = sdk.ZeroDec()
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	enforceReserves bool,
+	roleChangeDelay time.Duration,
+	feeConversionRate sdk.Dec,
+) Params {
+	return Params{
+		EnforceReserves:   enforceReserves,
+		RoleChangeDelay:   roleChangeDelay,
+		FeeConversionRate: feeConversionRate,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultEnforceReserves,
+		DefaultRoleChangeDelay,
+		DefaultFeeConversionRate,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnforceReserves, &p.EnforceReserves, validateEnforceReserves),
+		paramtypes.NewParamSetPair(KeyRoleChangeDelay, &p.RoleChangeDelay, validateRoleChangeDelay),
+		paramtypes.NewParamSetPair(KeyFeeConversionRate, &p.FeeConversionRate, validateFeeConversionRate),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateEnforceReserves(p.EnforceReserves); err != nil {
+		return err
+	}
+
+	if err := validateRoleChangeDelay(p.RoleChangeDelay); err != nil {
+		return err
+	}
+
+	if err := validateFeeConversionRate(p.FeeConversionRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +83,41 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateEnforceReserves validates the EnforceReserves param
+func validateEnforceReserves(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRoleChangeDelay validates the RoleChangeDelay param
+func validateRoleChangeDelay(v interface{}) error {
+	roleChangeDelay, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if roleChangeDelay < 0 {
+		return fmt.Errorf("role change delay must not be negative: %s", roleChangeDelay)
+	}
+
+	return nil
+}
+
+// validateFeeConversionRate validates the FeeConversionRate param
+func validateFeeConversionRate(v interface{}) error {
+	feeConversionRate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if !feeConversionRate.IsNil() && feeConversionRate.IsNegative() {
+		return fmt.Errorf("fee conversion rate must not be negative: %s", feeConversionRate)
+	}
+
+	return nil
+}
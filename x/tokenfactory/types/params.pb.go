@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// enforceReserves requires the total supply after a mint to stay at or below the latest attested reserves
+	EnforceReserves bool `protobuf:"varint,1,opt,name=enforceReserves,proto3" json:"enforceReserves,omitempty" yaml:"enforce_reserves"`
+	// roleChangeDelay is how long owner-level role changes are queued before they take effect
+	RoleChangeDelay time.Duration `protobuf:"bytes,2,opt,name=roleChangeDelay,proto3,stdduration" json:"roleChangeDelay" yaml:"role_change_delay"`
+	// feeConversionRate is the amount of minting denom accepted as fees in place of one unit of the
+	// minimum gas price denoms, fees can not be paid in the minting denom while it is zero
+	FeeConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=feeConversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"feeConversionRate" yaml:"fee_conversion_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +71,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEnforceReserves() bool {
+	if m != nil {
+		return m.EnforceReserves
+	}
+	return false
+}
+
+func (m *Params) GetRoleChangeDelay() time.Duration {
+	if m != nil {
+		return m.RoleChangeDelay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "hero.tokenfactory.Params")
 }
@@ -66,18 +92,31 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 165 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x48, 0x2d, 0xca, 0xd7, 0x43, 0x96, 0x97, 0x12,
-	0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58, 0x10, 0x85, 0x4a, 0x7c, 0x5c, 0x6c, 0x01,
-	0x60, 0x8d, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x65, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x7e, 0x71, 0x49, 0x51, 0x62, 0x5e, 0x7a, 0x6a, 0x4e, 0x7e, 0x59, 0xaa, 0x6e, 0x59, 0x6a, 0x5e,
-	0x49, 0x69, 0x51, 0x6a, 0xb1, 0x3e, 0xc8, 0x4a, 0xfd, 0x0a, 0x7d, 0x14, 0x47, 0x95, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xed, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xec, 0xd6,
-	0xb7, 0xb1, 0x00, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbd, 0x8e, 0xda, 0x40,
+	0x14, 0x85, 0x3d, 0x24, 0x42, 0x89, 0x53, 0x20, 0xac, 0x48, 0x31, 0x44, 0xb2, 0x91, 0x15, 0x45,
+	0x34, 0x78, 0xa4, 0xa4, 0x0a, 0xa5, 0x21, 0x5d, 0x8a, 0xc8, 0xe9, 0xd2, 0x58, 0x83, 0xb9, 0x36,
+	0x16, 0xb6, 0x2f, 0x9a, 0x19, 0x5b, 0x71, 0x9e, 0x62, 0x4b, 0xca, 0x7d, 0x90, 0x7d, 0x00, 0x4a,
+	0xca, 0xd5, 0x16, 0xde, 0x15, 0xbc, 0x01, 0x4f, 0xb0, 0xf2, 0xcf, 0xae, 0x58, 0xb6, 0x9a, 0x19,
+	0x9d, 0x73, 0xbf, 0x73, 0x34, 0x57, 0x1d, 0x48, 0x5c, 0x43, 0x1a, 0x30, 0x5f, 0x22, 0x2f, 0xe8,
+	0x86, 0x71, 0x96, 0x08, 0x7b, 0xc3, 0x51, 0xa2, 0xd6, 0x5f, 0x01, 0x47, 0xfb, 0x5c, 0x1f, 0x7e,
+	0x0c, 0x31, 0xc4, 0x5a, 0xa5, 0xd5, 0xad, 0x31, 0x0e, 0x8d, 0x10, 0x31, 0x8c, 0x81, 0xd6, 0xaf,
+	0x45, 0x16, 0xd0, 0x65, 0xc6, 0x99, 0x8c, 0x30, 0x6d, 0x74, 0xeb, 0xa6, 0xa3, 0x76, 0x7f, 0xd7,
+	0x64, 0xed, 0xa7, 0xda, 0x83, 0x34, 0x40, 0xee, 0x83, 0x0b, 0x02, 0x78, 0x0e, 0x42, 0x27, 0x23,
+	0x32, 0x7e, 0xe7, 0x7c, 0x3e, 0x95, 0xe6, 0xa7, 0x82, 0x25, 0xf1, 0xd4, 0x6a, 0x0d, 0x1e, 0x6f,
+	0x1d, 0x96, 0x7b, 0x39, 0xa3, 0x45, 0x6a, 0x8f, 0x63, 0x0c, 0xb3, 0x15, 0x4b, 0x43, 0x98, 0x43,
+	0xcc, 0x0a, 0xbd, 0x33, 0x22, 0xe3, 0x0f, 0xdf, 0x06, 0x76, 0xd3, 0xc5, 0x7e, 0xea, 0x62, 0xcf,
+	0xdb, 0x2e, 0xce, 0x97, 0x5d, 0x69, 0x2a, 0xa7, 0xd2, 0xd4, 0x9b, 0x94, 0x6a, 0xde, 0xf3, 0x6b,
+	0x80, 0xb7, 0xac, 0x08, 0xd6, 0xf6, 0xde, 0x24, 0xee, 0x25, 0x57, 0xfb, 0xaf, 0xf6, 0x03, 0x80,
+	0x19, 0xa6, 0x39, 0x70, 0x11, 0x61, 0xea, 0x32, 0x09, 0xfa, 0x9b, 0x11, 0x19, 0xbf, 0x77, 0x7e,
+	0x55, 0xc4, 0xbb, 0xd2, 0xfc, 0x1a, 0x46, 0x72, 0x95, 0x2d, 0x6c, 0x1f, 0x13, 0xea, 0xa3, 0x48,
+	0x50, 0xb4, 0xc7, 0x44, 0x2c, 0xd7, 0x54, 0x16, 0x1b, 0x10, 0xf6, 0x1c, 0xfc, 0x53, 0x69, 0x0e,
+	0x9b, 0xec, 0x00, 0xc0, 0xf3, 0x9f, 0x89, 0x1e, 0x67, 0x12, 0x2c, 0xf7, 0x75, 0xcc, 0xf4, 0xed,
+	0xf6, 0xda, 0x54, 0x9c, 0x3f, 0xbb, 0x83, 0x41, 0xf6, 0x07, 0x83, 0x3c, 0x1c, 0x0c, 0x72, 0x75,
+	0x34, 0x94, 0xfd, 0xd1, 0x50, 0x6e, 0x8f, 0x86, 0xf2, 0xf7, 0xc7, 0x59, 0xb0, 0x90, 0xbc, 0x2a,
+	0x1d, 0x63, 0x0e, 0x93, 0x1c, 0x52, 0x99, 0x71, 0x10, 0xb4, 0xda, 0x20, 0xfd, 0x47, 0x5f, 0xec,
+	0xb8, 0xee, 0xb3, 0xe8, 0xd6, 0x1f, 0xf4, 0xfd, 0x71, 0x00, 0xd3, 0x83, 0x8e, 0x4f, 0x00, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +139,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeConversionRate.Size()
+		i -= size
+		if _, err := m.FeeConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RoleChangeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RoleChangeDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.EnforceReserves {
+		i--
+		if m.EnforceReserves {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +187,13 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.EnforceReserves {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RoleChangeDelay)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeConversionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -158,6 +232,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceReserves", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceReserves = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RoleChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
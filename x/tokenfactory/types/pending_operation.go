@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = PendingOperation{}
+
+// GetMessage returns the message wrapped by the pendingOperation.
+func (op PendingOperation) GetMessage() (sdk.Msg, error) {
+	msg, ok := op.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("pending operation %d does not contain a message", op.Id)
+	}
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (op PendingOperation) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(op.Msg, &msg)
+}
+
+// HasApproval returns true if the address has approved the pendingOperation.
+func (op PendingOperation) HasApproval(address string) bool {
+	for _, approval := range op.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
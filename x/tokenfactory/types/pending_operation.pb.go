@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/pending_operation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingOperation is a privileged message awaiting approval by the quorum.
+type PendingOperation struct {
+	Id       uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer string     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Msg      *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// members that have approved the operation, including the proposer
+	Approvals []string  `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	ExpiresAt time.Time `protobuf:"bytes,5,opt,name=expiresAt,proto3,stdtime" json:"expiresAt"`
+}
+
+func (m *PendingOperation) Reset()         { *m = PendingOperation{} }
+func (m *PendingOperation) String() string { return proto.CompactTextString(m) }
+func (*PendingOperation) ProtoMessage()    {}
+func (*PendingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbdd03891fb51549, []int{0}
+}
+func (m *PendingOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOperation.Merge(m, src)
+}
+func (m *PendingOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOperation proto.InternalMessageInfo
+
+func (m *PendingOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingOperation) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PendingOperation) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *PendingOperation) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingOperation) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PendingOperation)(nil), "hero.tokenfactory.PendingOperation")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/pending_operation.proto", fileDescriptor_dbdd03891fb51549)
+}
+
+var fileDescriptor_dbdd03891fb51549 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6e, 0xe2, 0x30,
+	0x10, 0x87, 0x63, 0x60, 0x57, 0xc4, 0x2b, 0xad, 0x76, 0x23, 0x0e, 0x69, 0x54, 0x85, 0xa8, 0xaa,
+	0xaa, 0x5c, 0x1a, 0x4b, 0xed, 0xa9, 0x47, 0x78, 0x81, 0x56, 0x69, 0x4f, 0xbd, 0x54, 0x06, 0x06,
+	0x63, 0x95, 0x78, 0x2c, 0xdb, 0x20, 0xf2, 0x16, 0x3c, 0x16, 0x47, 0x8e, 0x3d, 0xb5, 0x15, 0xbc,
+	0x48, 0x45, 0x52, 0x4a, 0xff, 0xdc, 0x3c, 0xfe, 0x7d, 0x9e, 0x6f, 0xe4, 0xa1, 0xa7, 0x0e, 0x1f,
+	0x41, 0x8d, 0xf9, 0xd0, 0xa1, 0x29, 0x99, 0x06, 0x35, 0x92, 0x4a, 0x3c, 0xa0, 0x06, 0xc3, 0x9d,
+	0x44, 0x95, 0x69, 0x83, 0x0e, 0x83, 0xff, 0x13, 0x30, 0x98, 0x7d, 0x46, 0xa3, 0x8e, 0x40, 0x81,
+	0x55, 0xca, 0x76, 0xa7, 0x1a, 0x8c, 0x8e, 0x04, 0xa2, 0x98, 0x02, 0xab, 0xaa, 0xc1, 0x6c, 0xcc,
+	0xb8, 0x2a, 0xdf, 0xa3, 0xee, 0xf7, 0xc8, 0xc9, 0x02, 0xac, 0xe3, 0x85, 0xae, 0x81, 0x93, 0x15,
+	0xa1, 0xff, 0x6e, 0xea, 0x01, 0xae, 0xf7, 0xfe, 0xe0, 0x2f, 0x6d, 0xc8, 0x51, 0x48, 0x12, 0x92,
+	0xb6, 0xf2, 0x86, 0x1c, 0x05, 0x11, 0x6d, 0x6b, 0x83, 0x1a, 0x2d, 0x98, 0xb0, 0x91, 0x90, 0xd4,
+	0xcf, 0x3f, 0xea, 0xe0, 0x8c, 0x36, 0x0b, 0x2b, 0xc2, 0x66, 0x42, 0xd2, 0x3f, 0x17, 0x9d, 0xac,
+	0xf6, 0x65, 0x7b, 0x5f, 0xd6, 0x53, 0x65, 0xbe, 0x03, 0x82, 0x63, 0xea, 0x73, 0xad, 0x0d, 0xce,
+	0xf9, 0xd4, 0x86, 0xad, 0xa4, 0x99, 0xfa, 0xf9, 0xe1, 0x22, 0xe8, 0x53, 0x1f, 0x16, 0x5a, 0x1a,
+	0xb0, 0x3d, 0x17, 0xfe, 0xaa, 0x7a, 0x45, 0x3f, 0x7a, 0xdd, 0xed, 0x67, 0xef, 0xb7, 0x57, 0xcf,
+	0x5d, 0x6f, 0xf9, 0xd2, 0x25, 0xf9, 0xe1, 0x59, 0xff, 0x76, 0xb5, 0x89, 0xc9, 0x7a, 0x13, 0x93,
+	0xd7, 0x4d, 0x4c, 0x96, 0xdb, 0xd8, 0x5b, 0x6f, 0x63, 0xef, 0x69, 0x1b, 0x7b, 0xf7, 0x57, 0x42,
+	0xba, 0xc9, 0x6c, 0x90, 0x0d, 0xb1, 0x60, 0xd6, 0x19, 0xae, 0x04, 0x4c, 0x71, 0x0e, 0xe7, 0x73,
+	0x50, 0x6e, 0x66, 0xc0, 0xb2, 0xdd, 0x4f, 0xb3, 0x05, 0xfb, 0xb2, 0x16, 0x57, 0x6a, 0xb0, 0x83,
+	0xdf, 0x95, 0xfd, 0xf2, 0x6d, 0x00, 0xc1, 0x19, 0xd3, 0x06, 0xb3, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPendingOperation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintPendingOperation(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPendingOperation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintPendingOperation(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPendingOperation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingOperation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingOperation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPendingOperation(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovPendingOperation(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovPendingOperation(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovPendingOperation(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovPendingOperation(uint64(l))
+	return n
+}
+
+func sovPendingOperation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingOperation(x uint64) (n int) {
+	return sovPendingOperation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingOperation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingOperation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingOperation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingOperation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingOperation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingOperation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingOperation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingOperation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingOperation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingOperation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingOperation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingOperation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCancelRoleChange defines the type for a CancelRoleChangeProposal
+	ProposalTypeCancelRoleChange = "CancelRoleChange"
+	// ProposalTypeSetRateLimit defines the type for a SetRateLimitProposal
+	ProposalTypeSetRateLimit = "SetRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+)
+
+var (
+	_ govtypes.Content = &CancelRoleChangeProposal{}
+	_ govtypes.Content = &SetRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelRoleChange)
+	govtypes.RegisterProposalTypeCodec(&CancelRoleChangeProposal{}, "tokenfactory/CancelRoleChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetRateLimit)
+	govtypes.RegisterProposalTypeCodec(&SetRateLimitProposal{}, "tokenfactory/SetRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypes.RegisterProposalTypeCodec(&RemoveRateLimitProposal{}, "tokenfactory/RemoveRateLimitProposal")
+}
+
+// NewCancelRoleChangeProposal creates a proposal cancelling the queued role change with the given id
+func NewCancelRoleChangeProposal(title, description string, id uint64) govtypes.Content {
+	return &CancelRoleChangeProposal{
+		Title:       title,
+		Description: description,
+		Id:          id,
+	}
+}
+
+func (p *CancelRoleChangeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelRoleChangeProposal) ProposalType() string { return ProposalTypeCancelRoleChange }
+
+func (p *CancelRoleChangeProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p CancelRoleChangeProposal) String() string {
+	return fmt.Sprintf(`Cancel Role Change Proposal:
+  Title:       %s
+  Description: %s
+  Id:          %d
+`, p.Title, p.Description, p.Id)
+}
+
+// NewSetRateLimitProposal creates a proposal setting the rate limit of a channel and direction
+func NewSetRateLimitProposal(title, description string, channelId string, direction RateLimitDirection, maxAmount sdk.Int, maxSupplyFraction sdk.Dec, window time.Duration) govtypes.Content {
+	return &SetRateLimitProposal{
+		Title:             title,
+		Description:       description,
+		ChannelId:         channelId,
+		Direction:         direction,
+		MaxAmount:         maxAmount,
+		MaxSupplyFraction: maxSupplyFraction,
+		Window:            window,
+	}
+}
+
+func (p *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetRateLimitProposal) ProposalType() string { return ProposalTypeSetRateLimit }
+
+func (p *SetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateRateLimit(p.ChannelId, p.Direction, p.MaxAmount, p.MaxSupplyFraction, p.Window)
+}
+
+func (p SetRateLimitProposal) String() string {
+	return fmt.Sprintf(`Set Rate Limit Proposal:
+  Title:               %s
+  Description:         %s
+  Channel Id:          %s
+  Direction:           %s
+  Max Amount:          %s
+  Max Supply Fraction: %s
+  Window:              %s
+`, p.Title, p.Description, p.ChannelId, p.Direction, p.MaxAmount, p.MaxSupplyFraction, p.Window)
+}
+
+// NewRemoveRateLimitProposal creates a proposal removing the rate limit of a channel and direction
+func NewRemoveRateLimitProposal(title, description string, channelId string, direction RateLimitDirection) govtypes.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Direction:   direction,
+	}
+}
+
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	return nil
+}
+
+func (p RemoveRateLimitProposal) String() string {
+	return fmt.Sprintf(`Remove Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Channel Id:  %s
+  Direction:   %s
+`, p.Title, p.Description, p.ChannelId, p.Direction)
+}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -1066,6 +1066,94 @@ func (m *QueryAllMinterControllerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryMinterControllerByMinterRequest struct {
+	MinterAddress string `protobuf:"bytes,1,opt,name=minterAddress,proto3" json:"minterAddress,omitempty"`
+}
+
+func (m *QueryMinterControllerByMinterRequest) Reset()         { *m = QueryMinterControllerByMinterRequest{} }
+func (m *QueryMinterControllerByMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterControllerByMinterRequest) ProtoMessage()    {}
+func (*QueryMinterControllerByMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{24}
+}
+func (m *QueryMinterControllerByMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterControllerByMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterControllerByMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterControllerByMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterControllerByMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterControllerByMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterControllerByMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterControllerByMinterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterControllerByMinterRequest proto.InternalMessageInfo
+
+func (m *QueryMinterControllerByMinterRequest) GetMinterAddress() string {
+	if m != nil {
+		return m.MinterAddress
+	}
+	return ""
+}
+
+type QueryMinterControllerByMinterResponse struct {
+	MinterController []MinterController `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
+}
+
+func (m *QueryMinterControllerByMinterResponse) Reset()         { *m = QueryMinterControllerByMinterResponse{} }
+func (m *QueryMinterControllerByMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterControllerByMinterResponse) ProtoMessage()    {}
+func (*QueryMinterControllerByMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{25}
+}
+func (m *QueryMinterControllerByMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterControllerByMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterControllerByMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterControllerByMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterControllerByMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterControllerByMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterControllerByMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterControllerByMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterControllerByMinterResponse proto.InternalMessageInfo
+
+func (m *QueryMinterControllerByMinterResponse) GetMinterController() []MinterController {
+	if m != nil {
+		return m.MinterController
+	}
+	return nil
+}
+
 type QueryGetMintingDenomRequest struct {
 }
 
@@ -1073,7 +1161,7 @@ func (m *QueryGetMintingDenomRequest) Reset()         { *m = QueryGetMintingDeno
 func (m *QueryGetMintingDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintingDenomRequest) ProtoMessage()    {}
 func (*QueryGetMintingDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78516c77a1ba9513, []int{24}
+	return fileDescriptor_78516c77a1ba9513, []int{26}
 }
 func (m *QueryGetMintingDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1198,7 @@ func (m *QueryGetMintingDenomResponse) Reset()         { *m = QueryGetMintingDen
 func (m *QueryGetMintingDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintingDenomResponse) ProtoMessage()    {}
 func (*QueryGetMintingDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78516c77a1ba9513, []int{25}
+	return fileDescriptor_78516c77a1ba9513, []int{27}
 }
 func (m *QueryGetMintingDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,1809 +1234,16083 @@ func (m *QueryGetMintingDenomResponse) GetMintingDenom() MintingDenom {
 	return MintingDenom{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "hero.tokenfactory.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "hero.tokenfactory.QueryParamsResponse")
-	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "hero.tokenfactory.QueryGetBlacklistedRequest")
-	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "hero.tokenfactory.QueryGetBlacklistedResponse")
-	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "hero.tokenfactory.QueryAllBlacklistedRequest")
-	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "hero.tokenfactory.QueryAllBlacklistedResponse")
-	proto.RegisterType((*QueryGetPausedRequest)(nil), "hero.tokenfactory.QueryGetPausedRequest")
-	proto.RegisterType((*QueryGetPausedResponse)(nil), "hero.tokenfactory.QueryGetPausedResponse")
-	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "hero.tokenfactory.QueryGetMasterMinterRequest")
-	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "hero.tokenfactory.QueryGetMasterMinterResponse")
-	proto.RegisterType((*QueryGetMintersRequest)(nil), "hero.tokenfactory.QueryGetMintersRequest")
-	proto.RegisterType((*QueryGetMintersResponse)(nil), "hero.tokenfactory.QueryGetMintersResponse")
-	proto.RegisterType((*QueryAllMintersRequest)(nil), "hero.tokenfactory.QueryAllMintersRequest")
-	proto.RegisterType((*QueryAllMintersResponse)(nil), "hero.tokenfactory.QueryAllMintersResponse")
-	proto.RegisterType((*QueryGetPauserRequest)(nil), "hero.tokenfactory.QueryGetPauserRequest")
-	proto.RegisterType((*QueryGetPauserResponse)(nil), "hero.tokenfactory.QueryGetPauserResponse")
-	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "hero.tokenfactory.QueryGetBlacklisterRequest")
-	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "hero.tokenfactory.QueryGetBlacklisterResponse")
-	proto.RegisterType((*QueryGetOwnerRequest)(nil), "hero.tokenfactory.QueryGetOwnerRequest")
-	proto.RegisterType((*QueryGetOwnerResponse)(nil), "hero.tokenfactory.QueryGetOwnerResponse")
-	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "hero.tokenfactory.QueryGetMinterControllerRequest")
-	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "hero.tokenfactory.QueryGetMinterControllerResponse")
-	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "hero.tokenfactory.QueryAllMinterControllerRequest")
-	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "hero.tokenfactory.QueryAllMinterControllerResponse")
-	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "hero.tokenfactory.QueryGetMintingDenomRequest")
-	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "hero.tokenfactory.QueryGetMintingDenomResponse")
+type QueryGetRedemptionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("tokenfactory/query.proto", fileDescriptor_78516c77a1ba9513) }
-
-var fileDescriptor_78516c77a1ba9513 = []byte{
-	// 1124 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0x36, 0xff, 0x24, 0xfa, 0x4f, 0x2a, 0x68, 0xa7, 0xa1, 0x75, 0xb6, 0xc1, 0x31, 0x43,
-	0x49, 0xdd, 0xa8, 0xdd, 0xa5, 0x2e, 0xe2, 0xa5, 0x27, 0x12, 0x50, 0x2b, 0x0e, 0xa1, 0x69, 0x50,
-	0x2f, 0x5c, 0xc2, 0xda, 0x1e, 0xdc, 0x55, 0xd7, 0x3b, 0xee, 0xec, 0x3a, 0x10, 0x4a, 0x25, 0xc4,
-	0x85, 0x1b, 0x42, 0x02, 0x09, 0x21, 0xa4, 0x7e, 0x02, 0xc4, 0x37, 0xe0, 0xc2, 0xa9, 0xc7, 0x4a,
-	0x5c, 0x38, 0x21, 0x94, 0xf0, 0x41, 0xd0, 0xce, 0x3c, 0xeb, 0x9d, 0xf1, 0xce, 0xbe, 0x18, 0xc2,
-	0xcd, 0x9e, 0xe7, 0xed, 0xf7, 0xcc, 0xfc, 0x9e, 0x17, 0x1b, 0x35, 0x62, 0xf6, 0x80, 0x86, 0x1f,
-	0x7b, 0xbd, 0x98, 0xf1, 0x43, 0xf7, 0xe1, 0x98, 0xf2, 0x43, 0x67, 0xc4, 0x59, 0xcc, 0xf0, 0xd9,
-	0xfb, 0x94, 0x33, 0x47, 0x15, 0xdb, 0x6b, 0x03, 0xc6, 0x06, 0x01, 0x75, 0xbd, 0x91, 0xef, 0x7a,
-	0x61, 0xc8, 0x62, 0x2f, 0xf6, 0x59, 0x18, 0x49, 0x03, 0x7b, 0xb3, 0xc7, 0xa2, 0x21, 0x8b, 0xdc,
-	0xae, 0x17, 0x51, 0xe9, 0xc9, 0x3d, 0xb8, 0xde, 0xa5, 0xb1, 0x77, 0xdd, 0x1d, 0x79, 0x03, 0x3f,
-	0x14, 0xca, 0xa0, 0xbb, 0xaa, 0x85, 0x1d, 0x79, 0xdc, 0x1b, 0xa6, 0x6e, 0x9a, 0x9a, 0xa8, 0x1b,
-	0x78, 0xbd, 0x07, 0x81, 0x1f, 0xc5, 0xb4, 0x5f, 0x60, 0x3a, 0x8e, 0x26, 0xa2, 0x96, 0x26, 0x1a,
-	0x7a, 0x51, 0x4c, 0xf9, 0xfe, 0xd0, 0x0f, 0x63, 0xca, 0x41, 0xc3, 0xd6, 0x35, 0x84, 0x28, 0x2a,
-	0x76, 0xcc, 0x2b, 0x30, 0xa5, 0x72, 0xfd, 0x16, 0xd9, 0x27, 0xe1, 0x44, 0x72, 0xc9, 0x10, 0x70,
-	0xbf, 0xc7, 0xc2, 0x98, 0xb3, 0x20, 0x98, 0x68, 0xb5, 0x72, 0x5a, 0x7e, 0x38, 0xd8, 0xef, 0xd3,
-	0x90, 0x0d, 0x53, 0x04, 0xea, 0xe5, 0xa6, 0xd7, 0xda, 0x63, 0x7e, 0x7a, 0xa1, 0x2b, 0x03, 0x36,
-	0x60, 0xe2, 0xa3, 0x9b, 0x7c, 0x92, 0xa7, 0x64, 0x05, 0xe1, 0xbb, 0xc9, 0x43, 0xec, 0x8a, 0x0b,
-	0xde, 0xa3, 0x0f, 0xc7, 0x34, 0x8a, 0xc9, 0xfb, 0xe8, 0x9c, 0x76, 0x1a, 0x8d, 0x58, 0x18, 0x51,
-	0xfc, 0x06, 0x5a, 0x94, 0x0f, 0xd1, 0xb0, 0x5a, 0x56, 0x7b, 0xb9, 0xb3, 0xea, 0xe4, 0x18, 0xe0,
-	0x48, 0x93, 0xed, 0xff, 0x3d, 0xfd, 0x63, 0x7d, 0x6e, 0x0f, 0xd4, 0xc9, 0xeb, 0xc8, 0x16, 0xfe,
-	0x6e, 0xd3, 0x78, 0x3b, 0x7b, 0x2e, 0x88, 0x86, 0x1b, 0x68, 0xc9, 0xeb, 0xf7, 0x39, 0x8d, 0xa4,
-	0xdf, 0xff, 0xef, 0xa5, 0x5f, 0x09, 0x45, 0x17, 0x8d, 0x76, 0x80, 0xe7, 0x16, 0x5a, 0x56, 0x5e,
-	0x1f, 0x40, 0x35, 0x0d, 0xa0, 0x14, 0x63, 0x40, 0xa6, 0x1a, 0x92, 0x3e, 0xc0, 0xdb, 0x0a, 0x02,
-	0x03, 0xbc, 0x5b, 0x08, 0x65, 0xec, 0x84, 0x20, 0x1b, 0x8e, 0xbc, 0x6d, 0x27, 0xb9, 0x6d, 0x47,
-	0x16, 0x05, 0xdc, 0xb9, 0xb3, 0xeb, 0x0d, 0x28, 0xd8, 0xee, 0x29, 0x96, 0xe4, 0x67, 0x0b, 0xb2,
-	0x99, 0x0e, 0x53, 0x94, 0xcd, 0xfc, 0x3f, 0xca, 0x06, 0xdf, 0xd6, 0xf0, 0x9e, 0x12, 0x78, 0x2f,
-	0x57, 0xe2, 0x95, 0x20, 0x34, 0xc0, 0x17, 0xd0, 0x0b, 0xe9, 0xed, 0xef, 0x8a, 0x22, 0x4a, 0xe9,
-	0x71, 0x17, 0x9d, 0x9f, 0x16, 0xa8, 0x0c, 0x49, 0x4e, 0x4a, 0x19, 0x92, 0x28, 0x64, 0x0c, 0x49,
-	0xbe, 0x91, 0x17, 0xb3, 0x97, 0xde, 0x11, 0x55, 0xb9, 0x23, 0x0a, 0x21, 0x8d, 0xe8, 0xa3, 0x35,
-	0xb3, 0x18, 0xe2, 0xbe, 0x87, 0x4e, 0x0f, 0x95, 0x73, 0x88, 0xbe, 0x6e, 0x88, 0xae, 0x9a, 0x03,
-	0x06, 0xcd, 0x94, 0x74, 0xb2, 0xe4, 0xe4, 0x49, 0x54, 0xcd, 0xd3, 0x7b, 0xe8, 0x42, 0xce, 0x06,
-	0x90, 0xdd, 0x44, 0x4b, 0xd0, 0x44, 0x00, 0x94, 0x6d, 0x02, 0x25, 0x35, 0x00, 0x4f, 0x6a, 0x40,
-	0x3e, 0x02, 0x28, 0x5b, 0x41, 0x30, 0x05, 0xe5, 0xa4, 0x38, 0xf9, 0xc4, 0x02, 0xe4, 0x6a, 0x08,
-	0x13, 0xf2, 0xf9, 0x99, 0x90, 0xff, 0x77, 0x1c, 0xe4, 0x45, 0x1c, 0xe4, 0x39, 0x0e, 0xf2, 0x2a,
-	0x0e, 0x72, 0x8d, 0x83, 0x9c, 0xac, 0x99, 0xba, 0xd4, 0x24, 0xa0, 0xb1, 0x17, 0x71, 0x73, 0xf5,
-	0xf2, 0x5a, 0xbd, 0x88, 0xe7, 0xab, 0x97, 0x93, 0xf3, 0x68, 0x25, 0x0d, 0x73, 0x27, 0x99, 0x12,
-	0x69, 0xf8, 0x9d, 0xec, 0x22, 0xe0, 0x1c, 0x02, 0xbf, 0x86, 0x16, 0xc4, 0x38, 0x81, 0x90, 0x0d,
-	0x43, 0x48, 0x61, 0x00, 0xc1, 0xa4, 0x32, 0xb9, 0x83, 0xd6, 0x75, 0xc6, 0xbe, 0x33, 0x99, 0x38,
-	0x29, 0xc7, 0xae, 0xa2, 0xb3, 0xd9, 0x18, 0xda, 0xd2, 0x88, 0x9f, 0x17, 0x90, 0x43, 0xd4, 0x2a,
-	0x76, 0x08, 0x50, 0xef, 0xa1, 0x33, 0xc3, 0x29, 0x19, 0xa0, 0x7e, 0xb9, 0x90, 0x5a, 0x99, 0x2a,
-	0x24, 0x90, 0x73, 0x41, 0x7c, 0xc8, 0x65, 0xc2, 0xe1, 0x7c, 0x2e, 0x27, 0x55, 0x2f, 0xbf, 0x5a,
-	0x90, 0xa6, 0x31, 0x56, 0x69, 0x9a, 0xf3, 0xff, 0x32, 0xcd, 0x93, 0xab, 0x29, 0xb5, 0xd7, 0xca,
-	0x45, 0xe2, 0xdd, 0x64, 0x8f, 0x30, 0xf5, 0x5a, 0x4d, 0xac, 0xf4, 0x5a, 0xe5, 0xbc, 0xac, 0xd7,
-	0x2a, 0x6a, 0x93, 0x5e, 0xab, 0x9c, 0x75, 0x7e, 0x7c, 0x1e, 0x2d, 0x88, 0x58, 0xf8, 0x33, 0xb4,
-	0x28, 0x37, 0x07, 0xfc, 0x8a, 0xc1, 0x51, 0x7e, 0x45, 0xb1, 0x37, 0xaa, 0xd4, 0x24, 0x5a, 0xf2,
-	0xd2, 0x97, 0xbf, 0xfd, 0xf5, 0xed, 0xa9, 0x8b, 0x78, 0xd5, 0x4d, 0xf4, 0x5d, 0xc3, 0x56, 0x89,
-	0x9f, 0x58, 0x68, 0x59, 0x99, 0xa9, 0xf8, 0x5a, 0x91, 0x6b, 0xe3, 0xfa, 0x62, 0x3b, 0x75, 0xd5,
-	0x01, 0xd1, 0xab, 0x02, 0xd1, 0x26, 0x6e, 0x1b, 0x10, 0x29, 0x73, 0xdc, 0x7d, 0x04, 0xd3, 0xe5,
-	0x31, 0xfe, 0xde, 0x42, 0xcf, 0x29, 0x9e, 0xb6, 0x82, 0xa0, 0x18, 0xa3, 0x71, 0x87, 0x29, 0xc6,
-	0x68, 0xde, 0x45, 0xc8, 0x86, 0xc0, 0xd8, 0xc2, 0xcd, 0x72, 0x8c, 0xf8, 0x0b, 0x2b, 0x79, 0xb7,
-	0x64, 0x82, 0xe3, 0x76, 0xc9, 0x35, 0x68, 0xeb, 0x83, 0x7d, 0xa5, 0x86, 0x66, 0xad, 0xd7, 0x13,
-	0x71, 0x7f, 0xb0, 0xd0, 0x69, 0x75, 0xa8, 0xe3, 0xb2, 0xf7, 0x30, 0xec, 0x16, 0xb6, 0x5b, 0x5b,
-	0x1f, 0x40, 0xb5, 0x05, 0x28, 0x82, 0x5b, 0x06, 0x50, 0xda, 0x4f, 0x0a, 0xfc, 0xb5, 0x85, 0x96,
-	0x60, 0x42, 0xe2, 0xb2, 0xac, 0xf5, 0xe9, 0x6e, 0x6f, 0xd6, 0x51, 0x05, 0x30, 0x57, 0x05, 0x98,
-	0x0d, 0x7c, 0xc9, 0x04, 0x46, 0xea, 0x2a, 0x4c, 0xfa, 0xca, 0x42, 0x08, 0x3c, 0x24, 0x2c, 0xba,
-	0x52, 0x42, 0x8b, 0xba, 0x98, 0xf2, 0x9b, 0x03, 0x21, 0x02, 0xd3, 0x1a, 0xb6, 0x8b, 0x31, 0x65,
-	0xcc, 0xe1, 0xd5, 0xcc, 0xe1, 0xb5, 0x99, 0xc3, 0xeb, 0x33, 0x87, 0xe3, 0xef, 0xb4, 0xba, 0xe7,
-	0x35, 0xeb, 0x9e, 0xcf, 0x56, 0xf7, 0x7c, 0xc6, 0x9a, 0xe2, 0xf8, 0x73, 0xb4, 0x20, 0x06, 0x36,
-	0xbe, 0x5c, 0x12, 0x40, 0xdd, 0x0d, 0xec, 0x76, 0xb5, 0x22, 0x60, 0x68, 0x09, 0x0c, 0x36, 0x6e,
-	0x18, 0x30, 0x88, 0xc5, 0x00, 0xff, 0x62, 0xa1, 0x33, 0xd3, 0x23, 0x09, 0x77, 0x2a, 0x09, 0x99,
-	0x1b, 0xb9, 0xf6, 0x8d, 0x99, 0x6c, 0x00, 0xdf, 0xdb, 0x02, 0xdf, 0x4d, 0xfc, 0x66, 0x21, 0x73,
-	0x94, 0x9f, 0xc6, 0xee, 0xa3, 0xdc, 0x1a, 0xf2, 0x18, 0xff, 0x64, 0xa1, 0x73, 0xd3, 0xee, 0x13,
-	0xaa, 0x77, 0x2a, 0xf9, 0x3b, 0x43, 0x0a, 0x25, 0xd3, 0xbf, 0x46, 0x41, 0x2a, 0x29, 0xc8, 0xee,
-	0xa5, 0x8c, 0xc4, 0xf2, 0xee, 0x95, 0x9f, 0xd6, 0xe5, 0xdd, 0xcb, 0x30, 0xbe, 0xcb, 0xbb, 0x97,
-	0xfa, 0xbf, 0xc2, 0xf6, 0x07, 0x4f, 0x8f, 0x9a, 0xd6, 0xb3, 0xa3, 0xa6, 0xf5, 0xe7, 0x51, 0xd3,
-	0xfa, 0xe6, 0xb8, 0x39, 0xf7, 0xec, 0xb8, 0x39, 0xf7, 0xfb, 0x71, 0x73, 0xee, 0xc3, 0xb7, 0x06,
-	0x7e, 0x7c, 0x7f, 0xdc, 0x75, 0x7a, 0x6c, 0xe8, 0x46, 0x31, 0xf7, 0xc2, 0x01, 0x0d, 0xd8, 0x01,
-	0xbd, 0x76, 0x40, 0xc3, 0x78, 0xcc, 0x69, 0x24, 0x5d, 0x7f, 0xaa, 0x3b, 0x8f, 0x0f, 0x47, 0x34,
-	0xea, 0x2e, 0x8a, 0xff, 0x1d, 0x6e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x6a, 0xcd, 0x02,
-	0x57, 0x12, 0x00, 0x00,
+func (m *QueryGetRedemptionRequest) Reset()         { *m = QueryGetRedemptionRequest{} }
+func (m *QueryGetRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRedemptionRequest) ProtoMessage()    {}
+func (*QueryGetRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{28}
+}
+func (m *QueryGetRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRedemptionRequest.Merge(m, src)
+}
+func (m *QueryGetRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRedemptionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetRedemptionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Blacklisted by index.
-	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+func (m *QueryGetRedemptionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetRedemptionResponse struct {
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetRedemptionResponse) Reset()         { *m = QueryGetRedemptionResponse{} }
+func (m *QueryGetRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRedemptionResponse) ProtoMessage()    {}
+func (*QueryGetRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{29}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRedemptionResponse.Merge(m, src)
+}
+func (m *QueryGetRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRedemptionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
-	out := new(QueryGetBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Blacklisted", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryGetRedemptionResponse) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	return out, nil
+	return Redemption{}
 }
 
-func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
-	out := new(QueryAllBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BlacklistedAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllRedemptionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
-	out := new(QueryGetPausedResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Paused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRedemptionRequest) Reset()         { *m = QueryAllRedemptionRequest{} }
+func (m *QueryAllRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRedemptionRequest) ProtoMessage()    {}
+func (*QueryAllRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{30}
+}
+func (m *QueryAllRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRedemptionRequest.Merge(m, src)
+}
+func (m *QueryAllRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRedemptionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
-	out := new(QueryGetMasterMinterResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MasterMinter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRedemptionRequest proto.InternalMessageInfo
+
+func (m *QueryAllRedemptionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
-	out := new(QueryGetMintersResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Minters", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryAllRedemptionResponse struct {
+	Redemption []Redemption        `protobuf:"bytes,1,rep,name=redemption,proto3" json:"redemption"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRedemptionResponse) Reset()         { *m = QueryAllRedemptionResponse{} }
+func (m *QueryAllRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRedemptionResponse) ProtoMessage()    {}
+func (*QueryAllRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{31}
+}
+func (m *QueryAllRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRedemptionResponse.Merge(m, src)
+}
+func (m *QueryAllRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRedemptionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
-	out := new(QueryAllMintersResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MintersAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryAllRedemptionResponse) GetRedemption() []Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
-	out := new(QueryGetPauserResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Pauser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRedemptionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
-	out := new(QueryGetBlacklisterResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Blacklister", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryRedemptionsByStatusRequest struct {
+	Status     RedemptionStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=hero.tokenfactory.RedemptionStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
-	out := new(QueryGetOwnerResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Owner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRedemptionsByStatusRequest) Reset()         { *m = QueryRedemptionsByStatusRequest{} }
+func (m *QueryRedemptionsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsByStatusRequest) ProtoMessage()    {}
+func (*QueryRedemptionsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{32}
 }
-
-func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
-	out := new(QueryGetMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterController", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRedemptionsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsByStatusRequest.Merge(m, src)
+}
+func (m *QueryRedemptionsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsByStatusRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
-	out := new(QueryAllMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterControllerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionsByStatusRequest) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return RedemptionPending
 }
 
-func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
-	out := new(QueryGetMintingDenomResponse)
-	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MintingDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Blacklisted by index.
-	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+type QueryRedemptionsByStatusResponse struct {
+	Redemption []Redemption        `protobuf:"bytes,1,rep,name=redemption,proto3" json:"redemption"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRedemptionsByStatusResponse) Reset()         { *m = QueryRedemptionsByStatusResponse{} }
+func (m *QueryRedemptionsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsByStatusResponse) ProtoMessage()    {}
+func (*QueryRedemptionsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{33}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRedemptionsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
+func (m *QueryRedemptionsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+func (m *QueryRedemptionsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsByStatusResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+func (m *QueryRedemptionsByStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+func (m *QueryRedemptionsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsByStatusResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+
+var xxx_messageInfo_QueryRedemptionsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionsByStatusResponse) GetRedemption() []Redemption {
+	if m != nil {
+		return m.Redemption
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+
+func (m *QueryRedemptionsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+
+type QueryRedemptionsByHolderRequest struct {
+	Holder     string             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
+
+func (m *QueryRedemptionsByHolderRequest) Reset()         { *m = QueryRedemptionsByHolderRequest{} }
+func (m *QueryRedemptionsByHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsByHolderRequest) ProtoMessage()    {}
+func (*QueryRedemptionsByHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{34}
 }
-func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+func (m *QueryRedemptionsByHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
+func (m *QueryRedemptionsByHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsByHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
+func (m *QueryRedemptionsByHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsByHolderRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+func (m *QueryRedemptionsByHolderRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRedemptionsByHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsByHolderRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryRedemptionsByHolderRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionsByHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklisted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Blacklisted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+func (m *QueryRedemptionsByHolderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlacklistedAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/BlacklistedAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionsByHolderResponse struct {
+	Redemption []Redemption        `protobuf:"bytes,1,rep,name=redemption,proto3" json:"redemption"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Paused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Paused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
+func (m *QueryRedemptionsByHolderResponse) Reset()         { *m = QueryRedemptionsByHolderResponse{} }
+func (m *QueryRedemptionsByHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionsByHolderResponse) ProtoMessage()    {}
+func (*QueryRedemptionsByHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{35}
+}
+func (m *QueryRedemptionsByHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionsByHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionsByHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionsByHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionsByHolderResponse.Merge(m, src)
+}
+func (m *QueryRedemptionsByHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionsByHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionsByHolderResponse.DiscardUnknown(m)
 }
 
-func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMasterMinterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MasterMinter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/MasterMinter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+var xxx_messageInfo_QueryRedemptionsByHolderResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionsByHolderResponse) GetRedemption() []Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryRedemptionsByHolderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Minters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Minters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintersAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/MintersAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetAttesterRequest struct {
 }
 
-func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPauserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pauser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Pauser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+func (m *QueryGetAttesterRequest) Reset()         { *m = QueryGetAttesterRequest{} }
+func (m *QueryGetAttesterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttesterRequest) ProtoMessage()    {}
+func (*QueryGetAttesterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{36}
+}
+func (m *QueryGetAttesterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttesterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttesterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetAttesterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttesterRequest.Merge(m, src)
+}
+func (m *QueryGetAttesterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttesterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttesterRequest.DiscardUnknown(m)
 }
 
-func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklister(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Blacklister",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryGetAttesterRequest proto.InternalMessageInfo
+
+type QueryGetAttesterResponse struct {
+	Attester Attester `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester"`
 }
 
-func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Owner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/Owner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
+func (m *QueryGetAttesterResponse) Reset()         { *m = QueryGetAttesterResponse{} }
+func (m *QueryGetAttesterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttesterResponse) ProtoMessage()    {}
+func (*QueryGetAttesterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{37}
+}
+func (m *QueryGetAttesterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttesterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttesterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetAttesterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttesterResponse.Merge(m, src)
+}
+func (m *QueryGetAttesterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttesterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttesterResponse.DiscardUnknown(m)
 }
 
-func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterController(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/MinterController",
+var xxx_messageInfo_QueryGetAttesterResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttesterResponse) GetAttester() Attester {
+	if m != nil {
+		return m.Attester
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+	return Attester{}
+}
+
+type QueryLatestReserveAttestationRequest struct {
+}
+
+func (m *QueryLatestReserveAttestationRequest) Reset()         { *m = QueryLatestReserveAttestationRequest{} }
+func (m *QueryLatestReserveAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestReserveAttestationRequest) ProtoMessage()    {}
+func (*QueryLatestReserveAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{38}
+}
+func (m *QueryLatestReserveAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestReserveAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestReserveAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLatestReserveAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestReserveAttestationRequest.Merge(m, src)
+}
+func (m *QueryLatestReserveAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestReserveAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestReserveAttestationRequest.DiscardUnknown(m)
 }
 
-func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestReserveAttestationRequest proto.InternalMessageInfo
+
+type QueryLatestReserveAttestationResponse struct {
+	ReserveAttestation ReserveAttestation `protobuf:"bytes,1,opt,name=reserveAttestation,proto3" json:"reserveAttestation"`
+}
+
+func (m *QueryLatestReserveAttestationResponse) Reset()         { *m = QueryLatestReserveAttestationResponse{} }
+func (m *QueryLatestReserveAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestReserveAttestationResponse) ProtoMessage()    {}
+func (*QueryLatestReserveAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{39}
+}
+func (m *QueryLatestReserveAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestReserveAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestReserveAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterControllerAll(ctx, in)
+}
+func (m *QueryLatestReserveAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestReserveAttestationResponse.Merge(m, src)
+}
+func (m *QueryLatestReserveAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestReserveAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestReserveAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestReserveAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryLatestReserveAttestationResponse) GetReserveAttestation() ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestation
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/MinterControllerAll",
+	return ReserveAttestation{}
+}
+
+type QueryAllReserveAttestationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllReserveAttestationRequest) Reset()         { *m = QueryAllReserveAttestationRequest{} }
+func (m *QueryAllReserveAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReserveAttestationRequest) ProtoMessage()    {}
+func (*QueryAllReserveAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{40}
+}
+func (m *QueryAllReserveAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReserveAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReserveAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
+}
+func (m *QueryAllReserveAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReserveAttestationRequest.Merge(m, src)
+}
+func (m *QueryAllReserveAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReserveAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReserveAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllReserveAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryAllReserveAttestationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintingDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryAllReserveAttestationResponse struct {
+	ReserveAttestation []ReserveAttestation `protobuf:"bytes,1,rep,name=reserveAttestation,proto3" json:"reserveAttestation"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllReserveAttestationResponse) Reset()         { *m = QueryAllReserveAttestationResponse{} }
+func (m *QueryAllReserveAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReserveAttestationResponse) ProtoMessage()    {}
+func (*QueryAllReserveAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{41}
+}
+func (m *QueryAllReserveAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReserveAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReserveAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintingDenom(ctx, in)
+}
+func (m *QueryAllReserveAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReserveAttestationResponse.Merge(m, src)
+}
+func (m *QueryAllReserveAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReserveAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReserveAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllReserveAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryAllReserveAttestationResponse) GetReserveAttestation() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestation
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hero.tokenfactory.Query/MintingDenom",
+	return nil
+}
+
+func (m *QueryAllReserveAttestationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+	return nil
+}
+
+type QueryGetSupplyCapRequest struct {
+}
+
+func (m *QueryGetSupplyCapRequest) Reset()         { *m = QueryGetSupplyCapRequest{} }
+func (m *QueryGetSupplyCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSupplyCapRequest) ProtoMessage()    {}
+func (*QueryGetSupplyCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{42}
+}
+func (m *QueryGetSupplyCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSupplyCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSupplyCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetSupplyCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSupplyCapRequest.Merge(m, src)
+}
+func (m *QueryGetSupplyCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSupplyCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSupplyCapRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hero.tokenfactory.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Blacklisted",
-			Handler:    _Query_Blacklisted_Handler,
-		},
-		{
-			MethodName: "BlacklistedAll",
-			Handler:    _Query_BlacklistedAll_Handler,
-		},
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "MasterMinter",
-			Handler:    _Query_MasterMinter_Handler,
-		},
-		{
-			MethodName: "Minters",
-			Handler:    _Query_Minters_Handler,
-		},
-		{
-			MethodName: "MintersAll",
-			Handler:    _Query_MintersAll_Handler,
-		},
-		{
-			MethodName: "Pauser",
-			Handler:    _Query_Pauser_Handler,
-		},
-		{
-			MethodName: "Blacklister",
-			Handler:    _Query_Blacklister_Handler,
-		},
-		{
-			MethodName: "Owner",
-			Handler:    _Query_Owner_Handler,
-		},
-		{
-			MethodName: "MinterController",
-			Handler:    _Query_MinterController_Handler,
-		},
-		{
-			MethodName: "MinterControllerAll",
-			Handler:    _Query_MinterControllerAll_Handler,
-		},
-		{
-			MethodName: "MintingDenom",
-			Handler:    _Query_MintingDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tokenfactory/query.proto",
+var xxx_messageInfo_QueryGetSupplyCapRequest proto.InternalMessageInfo
+
+type QueryGetSupplyCapResponse struct {
+	SupplyCap SupplyCap  `protobuf:"bytes,1,opt,name=supplyCap,proto3" json:"supplyCap"`
+	Supply    types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+	Headroom  types.Coin `protobuf:"bytes,3,opt,name=headroom,proto3" json:"headroom"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetSupplyCapResponse) Reset()         { *m = QueryGetSupplyCapResponse{} }
+func (m *QueryGetSupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSupplyCapResponse) ProtoMessage()    {}
+func (*QueryGetSupplyCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{43}
+}
+func (m *QueryGetSupplyCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSupplyCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryGetSupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSupplyCapResponse.Merge(m, src)
+}
+func (m *QueryGetSupplyCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSupplyCapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetSupplyCapResponse proto.InternalMessageInfo
+
+func (m *QueryGetSupplyCapResponse) GetSupplyCap() SupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return SupplyCap{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetSupplyCapResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetSupplyCapResponse) GetHeadroom() types.Coin {
+	if m != nil {
+		return m.Headroom
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetQuorumRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetQuorumRequest) Reset()         { *m = QueryGetQuorumRequest{} }
+func (m *QueryGetQuorumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQuorumRequest) ProtoMessage()    {}
+func (*QueryGetQuorumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{44}
+}
+func (m *QueryGetQuorumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQuorumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQuorumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetQuorumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQuorumRequest.Merge(m, src)
 }
-
-func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetQuorumRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetQuorumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQuorumRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryGetQuorumRequest proto.InternalMessageInfo
 
-func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetQuorumResponse struct {
+	Quorum Quorum `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum"`
 }
 
-func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetQuorumResponse) Reset()         { *m = QueryGetQuorumResponse{} }
+func (m *QueryGetQuorumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQuorumResponse) ProtoMessage()    {}
+func (*QueryGetQuorumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{45}
+}
+func (m *QueryGetQuorumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQuorumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQuorumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetQuorumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQuorumResponse.Merge(m, src)
+}
+func (m *QueryGetQuorumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQuorumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQuorumResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetQuorumResponse proto.InternalMessageInfo
+
+func (m *QueryGetQuorumResponse) GetQuorum() Quorum {
+	if m != nil {
+		return m.Quorum
 	}
-	return dAtA[:n], nil
+	return Quorum{}
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingOperationRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPendingOperationRequest) Reset()         { *m = QueryGetPendingOperationRequest{} }
+func (m *QueryGetPendingOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOperationRequest) ProtoMessage()    {}
+func (*QueryGetPendingOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{46}
+}
+func (m *QueryGetPendingOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOperationRequest.Merge(m, src)
+}
+func (m *QueryGetPendingOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOperationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingOperationRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingOperationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPendingOperationResponse struct {
+	PendingOperation PendingOperation `protobuf:"bytes,1,opt,name=pendingOperation,proto3" json:"pendingOperation"`
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Blacklisted) > 0 {
-		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPendingOperationResponse) Reset()         { *m = QueryGetPendingOperationResponse{} }
+func (m *QueryGetPendingOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOperationResponse) ProtoMessage()    {}
+func (*QueryGetPendingOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{47}
+}
+func (m *QueryGetPendingOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPendingOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOperationResponse.Merge(m, src)
 }
-
-func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPendingOperationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetPendingOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOperationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingOperationResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingOperationResponse) GetPendingOperation() PendingOperation {
+	if m != nil {
+		return m.PendingOperation
 	}
-	return dAtA[:n], nil
+	return PendingOperation{}
 }
 
-func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllPendingOperationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllPendingOperationRequest) Reset()         { *m = QueryAllPendingOperationRequest{} }
+func (m *QueryAllPendingOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingOperationRequest) ProtoMessage()    {}
+func (*QueryAllPendingOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{48}
+}
+func (m *QueryAllPendingOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllPendingOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingOperationRequest.Merge(m, src)
 }
-
-func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllPendingOperationRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllPendingOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingOperationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllPendingOperationRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingOperationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllPendingOperationResponse struct {
+	PendingOperation []PendingOperation  `protobuf:"bytes,1,rep,name=pendingOperation,proto3" json:"pendingOperation"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllPendingOperationResponse) Reset()         { *m = QueryAllPendingOperationResponse{} }
+func (m *QueryAllPendingOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingOperationResponse) ProtoMessage()    {}
+func (*QueryAllPendingOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{49}
+}
+func (m *QueryAllPendingOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllPendingOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingOperationResponse.Merge(m, src)
 }
-
-func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllPendingOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingOperationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllPendingOperationResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingOperationResponse) GetPendingOperation() []PendingOperation {
+	if m != nil {
+		return m.PendingOperation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllPendingOperationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetRoleChangeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetRoleChangeRequest) Reset()         { *m = QueryGetRoleChangeRequest{} }
+func (m *QueryGetRoleChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRoleChangeRequest) ProtoMessage()    {}
+func (*QueryGetRoleChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{50}
+}
+func (m *QueryGetRoleChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRoleChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRoleChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetRoleChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRoleChangeRequest.Merge(m, src)
+}
+func (m *QueryGetRoleChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRoleChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRoleChangeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRoleChangeRequest proto.InternalMessageInfo
+
+func (m *QueryGetRoleChangeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetRoleChangeResponse struct {
+	RoleChange RoleChange `protobuf:"bytes,1,opt,name=roleChange,proto3" json:"roleChange"`
 }
 
-func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetRoleChangeResponse) Reset()         { *m = QueryGetRoleChangeResponse{} }
+func (m *QueryGetRoleChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRoleChangeResponse) ProtoMessage()    {}
+func (*QueryGetRoleChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{51}
+}
+func (m *QueryGetRoleChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRoleChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRoleChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetRoleChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRoleChangeResponse.Merge(m, src)
+}
+func (m *QueryGetRoleChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRoleChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRoleChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRoleChangeResponse proto.InternalMessageInfo
+
+func (m *QueryGetRoleChangeResponse) GetRoleChange() RoleChange {
+	if m != nil {
+		return m.RoleChange
 	}
-	return dAtA[:n], nil
+	return RoleChange{}
 }
 
-func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllRoleChangeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Minters) > 0 {
-		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllRoleChangeRequest) Reset()         { *m = QueryAllRoleChangeRequest{} }
+func (m *QueryAllRoleChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRoleChangeRequest) ProtoMessage()    {}
+func (*QueryAllRoleChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{52}
+}
+func (m *QueryAllRoleChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRoleChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRoleChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllRoleChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRoleChangeRequest.Merge(m, src)
 }
-
-func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllRoleChangeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllRoleChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRoleChangeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRoleChangeRequest proto.InternalMessageInfo
+
+func (m *QueryAllRoleChangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllRoleChangeResponse struct {
+	RoleChange []RoleChange        `protobuf:"bytes,1,rep,name=roleChange,proto3" json:"roleChange"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllRoleChangeResponse) Reset()         { *m = QueryAllRoleChangeResponse{} }
+func (m *QueryAllRoleChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRoleChangeResponse) ProtoMessage()    {}
+func (*QueryAllRoleChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{53}
+}
+func (m *QueryAllRoleChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRoleChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRoleChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllRoleChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRoleChangeResponse.Merge(m, src)
 }
-
-func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllRoleChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRoleChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRoleChangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllRoleChangeResponse proto.InternalMessageInfo
+
+func (m *QueryAllRoleChangeResponse) GetRoleChange() []RoleChange {
+	if m != nil {
+		return m.RoleChange
+	}
+	return nil
 }
 
-func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllRoleChangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetGuardianRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetGuardianRequest) Reset()         { *m = QueryGetGuardianRequest{} }
+func (m *QueryGetGuardianRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianRequest) ProtoMessage()    {}
+func (*QueryGetGuardianRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{54}
+}
+func (m *QueryGetGuardianRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetGuardianRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianRequest.Merge(m, src)
 }
-
-func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetGuardianRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetGuardianRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetGuardianRequest proto.InternalMessageInfo
+
+func (m *QueryGetGuardianRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetGuardianResponse struct {
+	Guardian Guardian `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian"`
 }
 
-func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetGuardianResponse) Reset()         { *m = QueryGetGuardianResponse{} }
+func (m *QueryGetGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianResponse) ProtoMessage()    {}
+func (*QueryGetGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{55}
+}
+func (m *QueryGetGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianResponse.Merge(m, src)
+}
+func (m *QueryGetGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetGuardianResponse proto.InternalMessageInfo
+
+func (m *QueryGetGuardianResponse) GetGuardian() Guardian {
+	if m != nil {
+		return m.Guardian
 	}
-	return dAtA[:n], nil
+	return Guardian{}
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllGuardianRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ControllerAddress) > 0 {
-		i -= len(m.ControllerAddress)
-		copy(dAtA[i:], m.ControllerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllGuardianRequest) Reset()         { *m = QueryAllGuardianRequest{} }
+func (m *QueryAllGuardianRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGuardianRequest) ProtoMessage()    {}
+func (*QueryAllGuardianRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{56}
+}
+func (m *QueryAllGuardianRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGuardianRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGuardianRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllGuardianRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGuardianRequest.Merge(m, src)
+}
+func (m *QueryAllGuardianRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGuardianRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGuardianRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllGuardianRequest proto.InternalMessageInfo
+
+func (m *QueryAllGuardianRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllGuardianResponse struct {
+	Guardian   []Guardian          `protobuf:"bytes,1,rep,name=guardian,proto3" json:"guardian"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllGuardianResponse) Reset()         { *m = QueryAllGuardianResponse{} }
+func (m *QueryAllGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGuardianResponse) ProtoMessage()    {}
+func (*QueryAllGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{57}
+}
+func (m *QueryAllGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGuardianResponse.Merge(m, src)
+}
+func (m *QueryAllGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGuardianResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllGuardianResponse proto.InternalMessageInfo
+
+func (m *QueryAllGuardianResponse) GetGuardian() []Guardian {
+	if m != nil {
+		return m.Guardian
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllGuardianResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryAddressRolesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressRolesRequest) Reset()         { *m = QueryAddressRolesRequest{} }
+func (m *QueryAddressRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressRolesRequest) ProtoMessage()    {}
+func (*QueryAddressRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{58}
+}
+func (m *QueryAddressRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAddressRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressRolesRequest.Merge(m, src)
+}
+func (m *QueryAddressRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressRolesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressRolesRequest proto.InternalMessageInfo
+
+func (m *QueryAddressRolesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAddressRolesResponse struct {
+	Owner        bool `protobuf:"varint,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	MasterMinter bool `protobuf:"varint,2,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	Pauser       bool `protobuf:"varint,3,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister  bool `protobuf:"varint,4,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Attester     bool `protobuf:"varint,5,opt,name=attester,proto3" json:"attester,omitempty"`
+	Guardian     bool `protobuf:"varint,6,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	QuorumMember bool `protobuf:"varint,7,opt,name=quorumMember,proto3" json:"quorumMember,omitempty"`
+	Minter       bool `protobuf:"varint,8,opt,name=minter,proto3" json:"minter,omitempty"`
+	// remaining mint allowance, only set when the address is a minter
+	Allowance *types.Coin `protobuf:"bytes,9,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// controllers allowed to configure the address as a minter
+	Controllers []string `protobuf:"bytes,10,rep,name=controllers,proto3" json:"controllers,omitempty"`
+	// minter configured by the address when it is a minter controller
+	ControlledMinter string `protobuf:"bytes,11,opt,name=controlledMinter,proto3" json:"controlledMinter,omitempty"`
+	Blacklisted      bool   `protobuf:"varint,12,opt,name=blacklisted,proto3" json:"blacklisted,omitempty"`
+}
+
+func (m *QueryAddressRolesResponse) Reset()         { *m = QueryAddressRolesResponse{} }
+func (m *QueryAddressRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressRolesResponse) ProtoMessage()    {}
+func (*QueryAddressRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{59}
+}
+func (m *QueryAddressRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MinterController) > 0 {
-		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAddressRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAddressRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressRolesResponse.Merge(m, src)
+}
+func (m *QueryAddressRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressRolesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressRolesResponse proto.InternalMessageInfo
+
+func (m *QueryAddressRolesResponse) GetOwner() bool {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAddressRolesResponse) GetMasterMinter() bool {
+	if m != nil {
+		return m.MasterMinter
+	}
+	return false
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAddressRolesResponse) GetPauser() bool {
+	if m != nil {
+		return m.Pauser
+	}
+	return false
 }
 
-func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAddressRolesResponse) GetBlacklister() bool {
+	if m != nil {
+		return m.Blacklister
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAddressRolesResponse) GetAttester() bool {
+	if m != nil {
+		return m.Attester
+	}
+	return false
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAddressRolesResponse) GetGuardian() bool {
+	if m != nil {
+		return m.Guardian
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAddressRolesResponse) GetQuorumMember() bool {
+	if m != nil {
+		return m.QuorumMember
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAddressRolesResponse) GetMinter() bool {
+	if m != nil {
+		return m.Minter
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressRolesResponse) GetAllowance() *types.Coin {
+	if m != nil {
+		return m.Allowance
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressRolesResponse) GetControllers() []string {
+	if m != nil {
+		return m.Controllers
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *QueryGetBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressRolesResponse) GetControlledMinter() string {
+	if m != nil {
+		return m.ControlledMinter
 	}
-	var l int
-	_ = l
-	l = m.Blacklisted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAllBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAddressRolesResponse) GetBlacklisted() bool {
+	if m != nil {
+		return m.Blacklisted
 	}
-	return n
+	return false
 }
 
-func (m *QueryAllBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Blacklisted) > 0 {
-		for _, e := range m.Blacklisted {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetMinterStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryGetPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetMinterStatsRequest) Reset()         { *m = QueryGetMinterStatsRequest{} }
+func (m *QueryGetMinterStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterStatsRequest) ProtoMessage()    {}
+func (*QueryGetMinterStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{60}
 }
-
-func (m *QueryGetPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMinterStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMinterStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Paused.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryGetMinterStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterStatsRequest.Merge(m, src)
+}
+func (m *QueryGetMinterStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMinterStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMasterMinterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetMinterStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetMinterStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryGetMasterMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MasterMinter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryGetMinterStatsResponse struct {
+	MinterStats MinterStats `protobuf:"bytes,1,opt,name=minterStats,proto3" json:"minterStats"`
 }
 
-func (m *QueryGetMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetMinterStatsResponse) Reset()         { *m = QueryGetMinterStatsResponse{} }
+func (m *QueryGetMinterStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterStatsResponse) ProtoMessage()    {}
+func (*QueryGetMinterStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{61}
+}
+func (m *QueryGetMinterStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMinterStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetMinterStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterStatsResponse.Merge(m, src)
+}
+func (m *QueryGetMinterStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMinterStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetMinterStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMinterStatsResponse) GetMinterStats() MinterStats {
+	if m != nil {
+		return m.MinterStats
 	}
-	var l int
-	_ = l
-	l = m.Minters.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return MinterStats{}
 }
 
-func (m *QueryAllMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAllMinterStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Minters) > 0 {
-		for _, e := range m.Minters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllMinterStatsRequest) Reset()         { *m = QueryAllMinterStatsRequest{} }
+func (m *QueryAllMinterStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMinterStatsRequest) ProtoMessage()    {}
+func (*QueryAllMinterStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{62}
+}
+func (m *QueryAllMinterStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMinterStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMinterStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryGetPauserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllMinterStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMinterStatsRequest.Merge(m, src)
+}
+func (m *QueryAllMinterStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMinterStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMinterStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllMinterStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllMinterStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Pauser.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetBlacklisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryAllMinterStatsResponse struct {
+	MinterStats []MinterStats       `protobuf:"bytes,1,rep,name=minterStats,proto3" json:"minterStats"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlacklisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMinterStatsResponse) Reset()         { *m = QueryAllMinterStatsResponse{} }
+func (m *QueryAllMinterStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMinterStatsResponse) ProtoMessage()    {}
+func (*QueryAllMinterStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{63}
+}
+func (m *QueryAllMinterStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMinterStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMinterStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Blacklister.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryAllMinterStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMinterStatsResponse.Merge(m, src)
+}
+func (m *QueryAllMinterStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMinterStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMinterStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllMinterStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllMinterStatsResponse) GetMinterStats() []MinterStats {
+	if m != nil {
+		return m.MinterStats
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryGetOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMinterStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Owner.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ControllerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetMintingTotalsRequest struct {
 }
 
-func (m *QueryGetMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMintingTotalsRequest) Reset()         { *m = QueryGetMintingTotalsRequest{} }
+func (m *QueryGetMintingTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintingTotalsRequest) ProtoMessage()    {}
+func (*QueryGetMintingTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{64}
+}
+func (m *QueryGetMintingTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintingTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintingTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.MinterController.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryGetMintingTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintingTotalsRequest.Merge(m, src)
+}
+func (m *QueryGetMintingTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintingTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintingTotalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetMintingTotalsRequest proto.InternalMessageInfo
+
+type QueryGetMintingTotalsResponse struct {
+	MintingTotals MintingTotals `protobuf:"bytes,1,opt,name=mintingTotals,proto3" json:"mintingTotals"`
+}
+
+func (m *QueryGetMintingTotalsResponse) Reset()         { *m = QueryGetMintingTotalsResponse{} }
+func (m *QueryGetMintingTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintingTotalsResponse) ProtoMessage()    {}
+func (*QueryGetMintingTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{65}
+}
+func (m *QueryGetMintingTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintingTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintingTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetMintingTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintingTotalsResponse.Merge(m, src)
+}
+func (m *QueryGetMintingTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintingTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintingTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMintingTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMintingTotalsResponse) GetMintingTotals() MintingTotals {
+	if m != nil {
+		return m.MintingTotals
 	}
-	return n
+	return MintingTotals{}
 }
 
-func (m *QueryAllMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryCheckTransferRequest struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// amount as a coin string, e.g. 100uusdc
+	Amount string       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Path   TransferPath `protobuf:"varint,4,opt,name=path,proto3,enum=hero.tokenfactory.TransferPath" json:"path,omitempty"`
+	// source channel of an IBC transfer
+	ChannelId string `protobuf:"bytes,5,opt,name=channelId,proto3" json:"channelId,omitempty"`
+}
+
+func (m *QueryCheckTransferRequest) Reset()         { *m = QueryCheckTransferRequest{} }
+func (m *QueryCheckTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTransferRequest) ProtoMessage()    {}
+func (*QueryCheckTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{66}
+}
+func (m *QueryCheckTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.MinterController) > 0 {
-		for _, e := range m.MinterController {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCheckTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTransferRequest.Merge(m, src)
+}
+func (m *QueryCheckTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckTransferRequest proto.InternalMessageInfo
+
+func (m *QueryCheckTransferRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *QueryCheckTransferRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *QueryCheckTransferRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryCheckTransferRequest) GetPath() TransferPath {
+	if m != nil {
+		return m.Path
+	}
+	return TransferPathBank
+}
+
+func (m *QueryCheckTransferRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryCheckTransferResponse struct {
+	Allowed bool        `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  CheckReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hero.tokenfactory.CheckReason" json:"reason,omitempty"`
+	Message string      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *QueryCheckTransferResponse) Reset()         { *m = QueryCheckTransferResponse{} }
+func (m *QueryCheckTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTransferResponse) ProtoMessage()    {}
+func (*QueryCheckTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{67}
+}
+func (m *QueryCheckTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTransferResponse.Merge(m, src)
+}
+func (m *QueryCheckTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckTransferResponse proto.InternalMessageInfo
+
+func (m *QueryCheckTransferResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryCheckTransferResponse) GetReason() CheckReason {
+	if m != nil {
+		return m.Reason
+	}
+	return CheckReasonOk
+}
+
+func (m *QueryCheckTransferResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type QueryCheckMintRequest struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	To     string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// amount as a coin string, e.g. 100uusdc
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryCheckMintRequest) Reset()         { *m = QueryCheckMintRequest{} }
+func (m *QueryCheckMintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckMintRequest) ProtoMessage()    {}
+func (*QueryCheckMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{68}
+}
+func (m *QueryCheckMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckMintRequest.Merge(m, src)
+}
+func (m *QueryCheckMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckMintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckMintRequest proto.InternalMessageInfo
+
+func (m *QueryCheckMintRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *QueryCheckMintRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *QueryCheckMintRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryCheckMintResponse struct {
+	Allowed bool        `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  CheckReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hero.tokenfactory.CheckReason" json:"reason,omitempty"`
+	Message string      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *QueryCheckMintResponse) Reset()         { *m = QueryCheckMintResponse{} }
+func (m *QueryCheckMintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckMintResponse) ProtoMessage()    {}
+func (*QueryCheckMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{69}
+}
+func (m *QueryCheckMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckMintResponse.Merge(m, src)
+}
+func (m *QueryCheckMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckMintResponse proto.InternalMessageInfo
+
+func (m *QueryCheckMintResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryCheckMintResponse) GetReason() CheckReason {
+	if m != nil {
+		return m.Reason
+	}
+	return CheckReasonOk
+}
+
+func (m *QueryCheckMintResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type QueryGetAuthorizationStateRequest struct {
+	Authorizer string `protobuf:"bytes,1,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	Nonce      string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryGetAuthorizationStateRequest) Reset()         { *m = QueryGetAuthorizationStateRequest{} }
+func (m *QueryGetAuthorizationStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuthorizationStateRequest) ProtoMessage()    {}
+func (*QueryGetAuthorizationStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{70}
+}
+func (m *QueryGetAuthorizationStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuthorizationStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuthorizationStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAuthorizationStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuthorizationStateRequest.Merge(m, src)
+}
+func (m *QueryGetAuthorizationStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuthorizationStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuthorizationStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAuthorizationStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetAuthorizationStateRequest) GetAuthorizer() string {
+	if m != nil {
+		return m.Authorizer
+	}
+	return ""
+}
+
+func (m *QueryGetAuthorizationStateRequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+type QueryGetAuthorizationStateResponse struct {
+	AuthorizationState AuthorizationState `protobuf:"bytes,1,opt,name=authorizationState,proto3" json:"authorizationState"`
+}
+
+func (m *QueryGetAuthorizationStateResponse) Reset()         { *m = QueryGetAuthorizationStateResponse{} }
+func (m *QueryGetAuthorizationStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuthorizationStateResponse) ProtoMessage()    {}
+func (*QueryGetAuthorizationStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{71}
+}
+func (m *QueryGetAuthorizationStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuthorizationStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuthorizationStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAuthorizationStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuthorizationStateResponse.Merge(m, src)
+}
+func (m *QueryGetAuthorizationStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuthorizationStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuthorizationStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAuthorizationStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetAuthorizationStateResponse) GetAuthorizationState() AuthorizationState {
+	if m != nil {
+		return m.AuthorizationState
+	}
+	return AuthorizationState{}
+}
+
+type QueryAllAuthorizationStateRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAuthorizationStateRequest) Reset()         { *m = QueryAllAuthorizationStateRequest{} }
+func (m *QueryAllAuthorizationStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuthorizationStateRequest) ProtoMessage()    {}
+func (*QueryAllAuthorizationStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{72}
+}
+func (m *QueryAllAuthorizationStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAuthorizationStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuthorizationStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAuthorizationStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuthorizationStateRequest.Merge(m, src)
+}
+func (m *QueryAllAuthorizationStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAuthorizationStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuthorizationStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAuthorizationStateRequest proto.InternalMessageInfo
+
+func (m *QueryAllAuthorizationStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAuthorizationStateResponse struct {
+	AuthorizationState []AuthorizationState `protobuf:"bytes,1,rep,name=authorizationState,proto3" json:"authorizationState"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAuthorizationStateResponse) Reset()         { *m = QueryAllAuthorizationStateResponse{} }
+func (m *QueryAllAuthorizationStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuthorizationStateResponse) ProtoMessage()    {}
+func (*QueryAllAuthorizationStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{73}
+}
+func (m *QueryAllAuthorizationStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAuthorizationStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuthorizationStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAuthorizationStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuthorizationStateResponse.Merge(m, src)
+}
+func (m *QueryAllAuthorizationStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAuthorizationStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuthorizationStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAuthorizationStateResponse proto.InternalMessageInfo
+
+func (m *QueryAllAuthorizationStateResponse) GetAuthorizationState() []AuthorizationState {
+	if m != nil {
+		return m.AuthorizationState
+	}
+	return nil
+}
+
+func (m *QueryAllAuthorizationStateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetRateLimitRequest struct {
+	ChannelId string             `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Direction RateLimitDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=hero.tokenfactory.RateLimitDirection" json:"direction,omitempty"`
+}
+
+func (m *QueryGetRateLimitRequest) Reset()         { *m = QueryGetRateLimitRequest{} }
+func (m *QueryGetRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRateLimitRequest) ProtoMessage()    {}
+func (*QueryGetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{74}
+}
+func (m *QueryGetRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRateLimitRequest.Merge(m, src)
+}
+func (m *QueryGetRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryGetRateLimitRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetRateLimitRequest) GetDirection() RateLimitDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return RateLimitInflow
+}
+
+type QueryGetRateLimitResponse struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rateLimit,proto3" json:"rateLimit"`
+	// quota of the current window
+	Quota types.Coin `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+	// amount moved in the current window
+	Flow types.Coin `protobuf:"bytes,3,opt,name=flow,proto3" json:"flow"`
+	// amount that can still move before the window ends
+	Remaining types.Coin `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining"`
+}
+
+func (m *QueryGetRateLimitResponse) Reset()         { *m = QueryGetRateLimitResponse{} }
+func (m *QueryGetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRateLimitResponse) ProtoMessage()    {}
+func (*QueryGetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{75}
+}
+func (m *QueryGetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRateLimitResponse.Merge(m, src)
+}
+func (m *QueryGetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetRateLimitResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+func (m *QueryGetRateLimitResponse) GetQuota() types.Coin {
+	if m != nil {
+		return m.Quota
+	}
+	return types.Coin{}
+}
+
+func (m *QueryGetRateLimitResponse) GetFlow() types.Coin {
+	if m != nil {
+		return m.Flow
+	}
+	return types.Coin{}
+}
+
+func (m *QueryGetRateLimitResponse) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
+}
+
+type QueryAllRateLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRateLimitRequest) Reset()         { *m = QueryAllRateLimitRequest{} }
+func (m *QueryAllRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitRequest) ProtoMessage()    {}
+func (*QueryAllRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{76}
+}
+func (m *QueryAllRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitRequest.Merge(m, src)
+}
+func (m *QueryAllRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRateLimitResponse struct {
+	RateLimit  []RateLimit         `protobuf:"bytes,1,rep,name=rateLimit,proto3" json:"rateLimit"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRateLimitResponse) Reset()         { *m = QueryAllRateLimitResponse{} }
+func (m *QueryAllRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitResponse) ProtoMessage()    {}
+func (*QueryAllRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{77}
+}
+func (m *QueryAllRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitResponse.Merge(m, src)
+}
+func (m *QueryAllRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitResponse) GetRateLimit() []RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *QueryAllRateLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetAllowedChannelRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+}
+
+func (m *QueryGetAllowedChannelRequest) Reset()         { *m = QueryGetAllowedChannelRequest{} }
+func (m *QueryGetAllowedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedChannelRequest) ProtoMessage()    {}
+func (*QueryGetAllowedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{78}
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedChannelRequest.Merge(m, src)
+}
+func (m *QueryGetAllowedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAllowedChannelRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllowedChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetAllowedChannelResponse struct {
+	AllowedChannel AllowedChannel `protobuf:"bytes,1,opt,name=allowedChannel,proto3" json:"allowedChannel"`
+}
+
+func (m *QueryGetAllowedChannelResponse) Reset()         { *m = QueryGetAllowedChannelResponse{} }
+func (m *QueryGetAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowedChannelResponse) ProtoMessage()    {}
+func (*QueryGetAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{79}
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowedChannelResponse.Merge(m, src)
+}
+func (m *QueryGetAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowedChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAllowedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllowedChannelResponse) GetAllowedChannel() AllowedChannel {
+	if m != nil {
+		return m.AllowedChannel
+	}
+	return AllowedChannel{}
+}
+
+type QueryAllAllowedChannelRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAllowedChannelRequest) Reset()         { *m = QueryAllAllowedChannelRequest{} }
+func (m *QueryAllAllowedChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedChannelRequest) ProtoMessage()    {}
+func (*QueryAllAllowedChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{80}
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedChannelRequest.Merge(m, src)
+}
+func (m *QueryAllAllowedChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAllowedChannelRequest proto.InternalMessageInfo
+
+func (m *QueryAllAllowedChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAllowedChannelResponse struct {
+	AllowedChannel []AllowedChannel    `protobuf:"bytes,1,rep,name=allowedChannel,proto3" json:"allowedChannel"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAllowedChannelResponse) Reset()         { *m = QueryAllAllowedChannelResponse{} }
+func (m *QueryAllAllowedChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowedChannelResponse) ProtoMessage()    {}
+func (*QueryAllAllowedChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{81}
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowedChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowedChannelResponse.Merge(m, src)
+}
+func (m *QueryAllAllowedChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowedChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowedChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAllowedChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllAllowedChannelResponse) GetAllowedChannel() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannel
+	}
+	return nil
+}
+
+func (m *QueryAllAllowedChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetBlacklistSyncChannelRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+}
+
+func (m *QueryGetBlacklistSyncChannelRequest) Reset()         { *m = QueryGetBlacklistSyncChannelRequest{} }
+func (m *QueryGetBlacklistSyncChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlacklistSyncChannelRequest) ProtoMessage()    {}
+func (*QueryGetBlacklistSyncChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{82}
+}
+func (m *QueryGetBlacklistSyncChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlacklistSyncChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlacklistSyncChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlacklistSyncChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlacklistSyncChannelRequest.Merge(m, src)
+}
+func (m *QueryGetBlacklistSyncChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlacklistSyncChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlacklistSyncChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlacklistSyncChannelRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlacklistSyncChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetBlacklistSyncChannelResponse struct {
+	BlacklistSyncChannel BlacklistSyncChannel `protobuf:"bytes,1,opt,name=blacklistSyncChannel,proto3" json:"blacklistSyncChannel"`
+}
+
+func (m *QueryGetBlacklistSyncChannelResponse) Reset()         { *m = QueryGetBlacklistSyncChannelResponse{} }
+func (m *QueryGetBlacklistSyncChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlacklistSyncChannelResponse) ProtoMessage()    {}
+func (*QueryGetBlacklistSyncChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{83}
+}
+func (m *QueryGetBlacklistSyncChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlacklistSyncChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlacklistSyncChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlacklistSyncChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlacklistSyncChannelResponse.Merge(m, src)
+}
+func (m *QueryGetBlacklistSyncChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlacklistSyncChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlacklistSyncChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlacklistSyncChannelResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlacklistSyncChannelResponse) GetBlacklistSyncChannel() BlacklistSyncChannel {
+	if m != nil {
+		return m.BlacklistSyncChannel
+	}
+	return BlacklistSyncChannel{}
+}
+
+type QueryAllBlacklistSyncChannelRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBlacklistSyncChannelRequest) Reset()         { *m = QueryAllBlacklistSyncChannelRequest{} }
+func (m *QueryAllBlacklistSyncChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlacklistSyncChannelRequest) ProtoMessage()    {}
+func (*QueryAllBlacklistSyncChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{84}
+}
+func (m *QueryAllBlacklistSyncChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlacklistSyncChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlacklistSyncChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBlacklistSyncChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlacklistSyncChannelRequest.Merge(m, src)
+}
+func (m *QueryAllBlacklistSyncChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlacklistSyncChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlacklistSyncChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBlacklistSyncChannelRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlacklistSyncChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllBlacklistSyncChannelResponse struct {
+	BlacklistSyncChannel []BlacklistSyncChannel `protobuf:"bytes,1,rep,name=blacklistSyncChannel,proto3" json:"blacklistSyncChannel"`
+	Pagination           *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) Reset()         { *m = QueryAllBlacklistSyncChannelResponse{} }
+func (m *QueryAllBlacklistSyncChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlacklistSyncChannelResponse) ProtoMessage()    {}
+func (*QueryAllBlacklistSyncChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{85}
+}
+func (m *QueryAllBlacklistSyncChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlacklistSyncChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlacklistSyncChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBlacklistSyncChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlacklistSyncChannelResponse.Merge(m, src)
+}
+func (m *QueryAllBlacklistSyncChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlacklistSyncChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlacklistSyncChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBlacklistSyncChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllBlacklistSyncChannelResponse) GetBlacklistSyncChannel() []BlacklistSyncChannel {
+	if m != nil {
+		return m.BlacklistSyncChannel
+	}
+	return nil
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetBridgeRouteRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+}
+
+func (m *QueryGetBridgeRouteRequest) Reset()         { *m = QueryGetBridgeRouteRequest{} }
+func (m *QueryGetBridgeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeRouteRequest) ProtoMessage()    {}
+func (*QueryGetBridgeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{86}
+}
+func (m *QueryGetBridgeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeRouteRequest.Merge(m, src)
+}
+func (m *QueryGetBridgeRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeRouteRequest proto.InternalMessageInfo
+
+func (m *QueryGetBridgeRouteRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetBridgeRouteResponse struct {
+	BridgeRoute BridgeRoute `protobuf:"bytes,1,opt,name=bridgeRoute,proto3" json:"bridgeRoute"`
+}
+
+func (m *QueryGetBridgeRouteResponse) Reset()         { *m = QueryGetBridgeRouteResponse{} }
+func (m *QueryGetBridgeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBridgeRouteResponse) ProtoMessage()    {}
+func (*QueryGetBridgeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{87}
+}
+func (m *QueryGetBridgeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBridgeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBridgeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBridgeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBridgeRouteResponse.Merge(m, src)
+}
+func (m *QueryGetBridgeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBridgeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBridgeRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBridgeRouteResponse proto.InternalMessageInfo
+
+func (m *QueryGetBridgeRouteResponse) GetBridgeRoute() BridgeRoute {
+	if m != nil {
+		return m.BridgeRoute
+	}
+	return BridgeRoute{}
+}
+
+type QueryAllBridgeRouteRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBridgeRouteRequest) Reset()         { *m = QueryAllBridgeRouteRequest{} }
+func (m *QueryAllBridgeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBridgeRouteRequest) ProtoMessage()    {}
+func (*QueryAllBridgeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{88}
+}
+func (m *QueryAllBridgeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBridgeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBridgeRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBridgeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBridgeRouteRequest.Merge(m, src)
+}
+func (m *QueryAllBridgeRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBridgeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBridgeRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBridgeRouteRequest proto.InternalMessageInfo
+
+func (m *QueryAllBridgeRouteRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllBridgeRouteResponse struct {
+	BridgeRoute []BridgeRoute       `protobuf:"bytes,1,rep,name=bridgeRoute,proto3" json:"bridgeRoute"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBridgeRouteResponse) Reset()         { *m = QueryAllBridgeRouteResponse{} }
+func (m *QueryAllBridgeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBridgeRouteResponse) ProtoMessage()    {}
+func (*QueryAllBridgeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{89}
+}
+func (m *QueryAllBridgeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBridgeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBridgeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBridgeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBridgeRouteResponse.Merge(m, src)
+}
+func (m *QueryAllBridgeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBridgeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBridgeRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBridgeRouteResponse proto.InternalMessageInfo
+
+func (m *QueryAllBridgeRouteResponse) GetBridgeRoute() []BridgeRoute {
+	if m != nil {
+		return m.BridgeRoute
+	}
+	return nil
+}
+
+func (m *QueryAllBridgeRouteResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBridgeMinterRequest struct {
+}
+
+func (m *QueryBridgeMinterRequest) Reset()         { *m = QueryBridgeMinterRequest{} }
+func (m *QueryBridgeMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeMinterRequest) ProtoMessage()    {}
+func (*QueryBridgeMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{90}
+}
+func (m *QueryBridgeMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeMinterRequest.Merge(m, src)
+}
+func (m *QueryBridgeMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeMinterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeMinterRequest proto.InternalMessageInfo
+
+type QueryBridgeMinterResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBridgeMinterResponse) Reset()         { *m = QueryBridgeMinterResponse{} }
+func (m *QueryBridgeMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeMinterResponse) ProtoMessage()    {}
+func (*QueryBridgeMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78516c77a1ba9513, []int{91}
+}
+func (m *QueryBridgeMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeMinterResponse.Merge(m, src)
+}
+func (m *QueryBridgeMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeMinterResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeMinterResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hero.tokenfactory.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hero.tokenfactory.QueryParamsResponse")
+	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "hero.tokenfactory.QueryGetBlacklistedRequest")
+	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "hero.tokenfactory.QueryGetBlacklistedResponse")
+	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "hero.tokenfactory.QueryAllBlacklistedRequest")
+	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "hero.tokenfactory.QueryAllBlacklistedResponse")
+	proto.RegisterType((*QueryGetPausedRequest)(nil), "hero.tokenfactory.QueryGetPausedRequest")
+	proto.RegisterType((*QueryGetPausedResponse)(nil), "hero.tokenfactory.QueryGetPausedResponse")
+	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "hero.tokenfactory.QueryGetMasterMinterRequest")
+	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "hero.tokenfactory.QueryGetMasterMinterResponse")
+	proto.RegisterType((*QueryGetMintersRequest)(nil), "hero.tokenfactory.QueryGetMintersRequest")
+	proto.RegisterType((*QueryGetMintersResponse)(nil), "hero.tokenfactory.QueryGetMintersResponse")
+	proto.RegisterType((*QueryAllMintersRequest)(nil), "hero.tokenfactory.QueryAllMintersRequest")
+	proto.RegisterType((*QueryAllMintersResponse)(nil), "hero.tokenfactory.QueryAllMintersResponse")
+	proto.RegisterType((*QueryGetPauserRequest)(nil), "hero.tokenfactory.QueryGetPauserRequest")
+	proto.RegisterType((*QueryGetPauserResponse)(nil), "hero.tokenfactory.QueryGetPauserResponse")
+	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "hero.tokenfactory.QueryGetBlacklisterRequest")
+	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "hero.tokenfactory.QueryGetBlacklisterResponse")
+	proto.RegisterType((*QueryGetOwnerRequest)(nil), "hero.tokenfactory.QueryGetOwnerRequest")
+	proto.RegisterType((*QueryGetOwnerResponse)(nil), "hero.tokenfactory.QueryGetOwnerResponse")
+	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "hero.tokenfactory.QueryGetMinterControllerRequest")
+	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "hero.tokenfactory.QueryGetMinterControllerResponse")
+	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "hero.tokenfactory.QueryAllMinterControllerRequest")
+	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "hero.tokenfactory.QueryAllMinterControllerResponse")
+	proto.RegisterType((*QueryMinterControllerByMinterRequest)(nil), "hero.tokenfactory.QueryMinterControllerByMinterRequest")
+	proto.RegisterType((*QueryMinterControllerByMinterResponse)(nil), "hero.tokenfactory.QueryMinterControllerByMinterResponse")
+	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "hero.tokenfactory.QueryGetMintingDenomRequest")
+	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "hero.tokenfactory.QueryGetMintingDenomResponse")
+	proto.RegisterType((*QueryGetRedemptionRequest)(nil), "hero.tokenfactory.QueryGetRedemptionRequest")
+	proto.RegisterType((*QueryGetRedemptionResponse)(nil), "hero.tokenfactory.QueryGetRedemptionResponse")
+	proto.RegisterType((*QueryAllRedemptionRequest)(nil), "hero.tokenfactory.QueryAllRedemptionRequest")
+	proto.RegisterType((*QueryAllRedemptionResponse)(nil), "hero.tokenfactory.QueryAllRedemptionResponse")
+	proto.RegisterType((*QueryRedemptionsByStatusRequest)(nil), "hero.tokenfactory.QueryRedemptionsByStatusRequest")
+	proto.RegisterType((*QueryRedemptionsByStatusResponse)(nil), "hero.tokenfactory.QueryRedemptionsByStatusResponse")
+	proto.RegisterType((*QueryRedemptionsByHolderRequest)(nil), "hero.tokenfactory.QueryRedemptionsByHolderRequest")
+	proto.RegisterType((*QueryRedemptionsByHolderResponse)(nil), "hero.tokenfactory.QueryRedemptionsByHolderResponse")
+	proto.RegisterType((*QueryGetAttesterRequest)(nil), "hero.tokenfactory.QueryGetAttesterRequest")
+	proto.RegisterType((*QueryGetAttesterResponse)(nil), "hero.tokenfactory.QueryGetAttesterResponse")
+	proto.RegisterType((*QueryLatestReserveAttestationRequest)(nil), "hero.tokenfactory.QueryLatestReserveAttestationRequest")
+	proto.RegisterType((*QueryLatestReserveAttestationResponse)(nil), "hero.tokenfactory.QueryLatestReserveAttestationResponse")
+	proto.RegisterType((*QueryAllReserveAttestationRequest)(nil), "hero.tokenfactory.QueryAllReserveAttestationRequest")
+	proto.RegisterType((*QueryAllReserveAttestationResponse)(nil), "hero.tokenfactory.QueryAllReserveAttestationResponse")
+	proto.RegisterType((*QueryGetSupplyCapRequest)(nil), "hero.tokenfactory.QueryGetSupplyCapRequest")
+	proto.RegisterType((*QueryGetSupplyCapResponse)(nil), "hero.tokenfactory.QueryGetSupplyCapResponse")
+	proto.RegisterType((*QueryGetQuorumRequest)(nil), "hero.tokenfactory.QueryGetQuorumRequest")
+	proto.RegisterType((*QueryGetQuorumResponse)(nil), "hero.tokenfactory.QueryGetQuorumResponse")
+	proto.RegisterType((*QueryGetPendingOperationRequest)(nil), "hero.tokenfactory.QueryGetPendingOperationRequest")
+	proto.RegisterType((*QueryGetPendingOperationResponse)(nil), "hero.tokenfactory.QueryGetPendingOperationResponse")
+	proto.RegisterType((*QueryAllPendingOperationRequest)(nil), "hero.tokenfactory.QueryAllPendingOperationRequest")
+	proto.RegisterType((*QueryAllPendingOperationResponse)(nil), "hero.tokenfactory.QueryAllPendingOperationResponse")
+	proto.RegisterType((*QueryGetRoleChangeRequest)(nil), "hero.tokenfactory.QueryGetRoleChangeRequest")
+	proto.RegisterType((*QueryGetRoleChangeResponse)(nil), "hero.tokenfactory.QueryGetRoleChangeResponse")
+	proto.RegisterType((*QueryAllRoleChangeRequest)(nil), "hero.tokenfactory.QueryAllRoleChangeRequest")
+	proto.RegisterType((*QueryAllRoleChangeResponse)(nil), "hero.tokenfactory.QueryAllRoleChangeResponse")
+	proto.RegisterType((*QueryGetGuardianRequest)(nil), "hero.tokenfactory.QueryGetGuardianRequest")
+	proto.RegisterType((*QueryGetGuardianResponse)(nil), "hero.tokenfactory.QueryGetGuardianResponse")
+	proto.RegisterType((*QueryAllGuardianRequest)(nil), "hero.tokenfactory.QueryAllGuardianRequest")
+	proto.RegisterType((*QueryAllGuardianResponse)(nil), "hero.tokenfactory.QueryAllGuardianResponse")
+	proto.RegisterType((*QueryAddressRolesRequest)(nil), "hero.tokenfactory.QueryAddressRolesRequest")
+	proto.RegisterType((*QueryAddressRolesResponse)(nil), "hero.tokenfactory.QueryAddressRolesResponse")
+	proto.RegisterType((*QueryGetMinterStatsRequest)(nil), "hero.tokenfactory.QueryGetMinterStatsRequest")
+	proto.RegisterType((*QueryGetMinterStatsResponse)(nil), "hero.tokenfactory.QueryGetMinterStatsResponse")
+	proto.RegisterType((*QueryAllMinterStatsRequest)(nil), "hero.tokenfactory.QueryAllMinterStatsRequest")
+	proto.RegisterType((*QueryAllMinterStatsResponse)(nil), "hero.tokenfactory.QueryAllMinterStatsResponse")
+	proto.RegisterType((*QueryGetMintingTotalsRequest)(nil), "hero.tokenfactory.QueryGetMintingTotalsRequest")
+	proto.RegisterType((*QueryGetMintingTotalsResponse)(nil), "hero.tokenfactory.QueryGetMintingTotalsResponse")
+	proto.RegisterType((*QueryCheckTransferRequest)(nil), "hero.tokenfactory.QueryCheckTransferRequest")
+	proto.RegisterType((*QueryCheckTransferResponse)(nil), "hero.tokenfactory.QueryCheckTransferResponse")
+	proto.RegisterType((*QueryCheckMintRequest)(nil), "hero.tokenfactory.QueryCheckMintRequest")
+	proto.RegisterType((*QueryCheckMintResponse)(nil), "hero.tokenfactory.QueryCheckMintResponse")
+	proto.RegisterType((*QueryGetAuthorizationStateRequest)(nil), "hero.tokenfactory.QueryGetAuthorizationStateRequest")
+	proto.RegisterType((*QueryGetAuthorizationStateResponse)(nil), "hero.tokenfactory.QueryGetAuthorizationStateResponse")
+	proto.RegisterType((*QueryAllAuthorizationStateRequest)(nil), "hero.tokenfactory.QueryAllAuthorizationStateRequest")
+	proto.RegisterType((*QueryAllAuthorizationStateResponse)(nil), "hero.tokenfactory.QueryAllAuthorizationStateResponse")
+	proto.RegisterType((*QueryGetRateLimitRequest)(nil), "hero.tokenfactory.QueryGetRateLimitRequest")
+	proto.RegisterType((*QueryGetRateLimitResponse)(nil), "hero.tokenfactory.QueryGetRateLimitResponse")
+	proto.RegisterType((*QueryAllRateLimitRequest)(nil), "hero.tokenfactory.QueryAllRateLimitRequest")
+	proto.RegisterType((*QueryAllRateLimitResponse)(nil), "hero.tokenfactory.QueryAllRateLimitResponse")
+	proto.RegisterType((*QueryGetAllowedChannelRequest)(nil), "hero.tokenfactory.QueryGetAllowedChannelRequest")
+	proto.RegisterType((*QueryGetAllowedChannelResponse)(nil), "hero.tokenfactory.QueryGetAllowedChannelResponse")
+	proto.RegisterType((*QueryAllAllowedChannelRequest)(nil), "hero.tokenfactory.QueryAllAllowedChannelRequest")
+	proto.RegisterType((*QueryAllAllowedChannelResponse)(nil), "hero.tokenfactory.QueryAllAllowedChannelResponse")
+	proto.RegisterType((*QueryGetBlacklistSyncChannelRequest)(nil), "hero.tokenfactory.QueryGetBlacklistSyncChannelRequest")
+	proto.RegisterType((*QueryGetBlacklistSyncChannelResponse)(nil), "hero.tokenfactory.QueryGetBlacklistSyncChannelResponse")
+	proto.RegisterType((*QueryAllBlacklistSyncChannelRequest)(nil), "hero.tokenfactory.QueryAllBlacklistSyncChannelRequest")
+	proto.RegisterType((*QueryAllBlacklistSyncChannelResponse)(nil), "hero.tokenfactory.QueryAllBlacklistSyncChannelResponse")
+	proto.RegisterType((*QueryGetBridgeRouteRequest)(nil), "hero.tokenfactory.QueryGetBridgeRouteRequest")
+	proto.RegisterType((*QueryGetBridgeRouteResponse)(nil), "hero.tokenfactory.QueryGetBridgeRouteResponse")
+	proto.RegisterType((*QueryAllBridgeRouteRequest)(nil), "hero.tokenfactory.QueryAllBridgeRouteRequest")
+	proto.RegisterType((*QueryAllBridgeRouteResponse)(nil), "hero.tokenfactory.QueryAllBridgeRouteResponse")
+	proto.RegisterType((*QueryBridgeMinterRequest)(nil), "hero.tokenfactory.QueryBridgeMinterRequest")
+	proto.RegisterType((*QueryBridgeMinterResponse)(nil), "hero.tokenfactory.QueryBridgeMinterResponse")
+}
+
+func init() { proto.RegisterFile("tokenfactory/query.proto", fileDescriptor_78516c77a1ba9513) }
+
+var fileDescriptor_78516c77a1ba9513 = []byte{
+	// 3508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0xdd, 0x6f, 0x1b, 0xc7,
+	0xb5, 0xf7, 0x8a, 0xb6, 0x6c, 0x1e, 0xcb, 0x82, 0x33, 0x71, 0x6c, 0x6a, 0x6d, 0x51, 0xf2, 0xda,
+	0x92, 0x25, 0x5b, 0x22, 0x63, 0xcb, 0x5f, 0xd7, 0xb9, 0x0e, 0x22, 0x2b, 0x70, 0x6e, 0x00, 0xfb,
+	0xda, 0xa1, 0x13, 0x14, 0x69, 0x1f, 0xd4, 0x15, 0xb9, 0xa6, 0x08, 0x2f, 0xb9, 0xf4, 0xee, 0xd2,
+	0xae, 0xa2, 0xb2, 0x68, 0x8a, 0x02, 0x4d, 0x51, 0xb4, 0x08, 0x9a, 0xf4, 0xeb, 0x21, 0x29, 0x5a,
+	0xb4, 0x79, 0x28, 0xd2, 0xa0, 0x0f, 0xed, 0x4b, 0x81, 0xbc, 0x14, 0x45, 0x11, 0xf4, 0x29, 0x68,
+	0x50, 0xa0, 0x4f, 0x45, 0x91, 0xf4, 0x0f, 0x29, 0x76, 0x3e, 0x76, 0x67, 0x76, 0x67, 0x87, 0x43,
+	0x85, 0x42, 0xf3, 0x64, 0xef, 0xcc, 0x39, 0x73, 0x7e, 0xe7, 0xcc, 0x99, 0x99, 0x33, 0x67, 0x0e,
+	0x05, 0xa5, 0xd0, 0x7b, 0xe0, 0x74, 0xee, 0xdb, 0xf5, 0xd0, 0xf3, 0xb7, 0xaa, 0x0f, 0x7b, 0x8e,
+	0xbf, 0x55, 0xe9, 0xfa, 0x5e, 0xe8, 0xa1, 0x27, 0x36, 0x1d, 0xdf, 0xab, 0xf0, 0xdd, 0xe6, 0x89,
+	0xa6, 0xe7, 0x35, 0x5d, 0xa7, 0x6a, 0x77, 0x5b, 0x55, 0xbb, 0xd3, 0xf1, 0x42, 0x3b, 0x6c, 0x79,
+	0x9d, 0x80, 0x30, 0x98, 0x67, 0xeb, 0x5e, 0xd0, 0xf6, 0x82, 0xea, 0x86, 0x1d, 0x38, 0x64, 0xa4,
+	0xea, 0xa3, 0xf3, 0x1b, 0x4e, 0x68, 0x9f, 0xaf, 0x76, 0xed, 0x66, 0xab, 0x83, 0x89, 0x29, 0xed,
+	0x94, 0x20, 0xb6, 0x6b, 0xfb, 0x76, 0x9b, 0x0d, 0x53, 0x16, 0xba, 0x36, 0x5c, 0xbb, 0xfe, 0xc0,
+	0x6d, 0x05, 0xa1, 0xd3, 0xc8, 0x61, 0xed, 0x05, 0x71, 0xd7, 0xac, 0xd0, 0xd5, 0xb6, 0x83, 0xd0,
+	0xf1, 0xd7, 0xdb, 0xad, 0x4e, 0xe8, 0xf8, 0x94, 0xc2, 0x14, 0x29, 0x70, 0x57, 0x90, 0x3f, 0xb0,
+	0x3f, 0x00, 0x13, 0xeb, 0x17, 0xad, 0xe8, 0x3d, 0xee, 0xc4, 0x3d, 0xa7, 0x25, 0x02, 0xd7, 0xeb,
+	0x5e, 0x27, 0xf4, 0x3d, 0xd7, 0x75, 0x7c, 0x39, 0xf0, 0x56, 0x27, 0x6c, 0x75, 0x9a, 0xeb, 0x0d,
+	0xa7, 0xe3, 0xb5, 0x29, 0xc5, 0xb4, 0x40, 0xe1, 0x3b, 0x0d, 0xa7, 0xdd, 0xe5, 0xec, 0x79, 0x5c,
+	0xe8, 0xb6, 0xc3, 0xd0, 0xe1, 0xd0, 0xcd, 0xa7, 0x78, 0x03, 0xc7, 0x7f, 0xe4, 0xac, 0x13, 0x22,
+	0x7e, 0x52, 0x44, 0x19, 0x41, 0xaf, 0xdb, 0x75, 0xb7, 0xd6, 0xeb, 0x76, 0x57, 0x6a, 0x9f, 0x87,
+	0x3d, 0xcf, 0xef, 0xb5, 0xa5, 0x5a, 0x76, 0x9d, 0x4e, 0x23, 0xc2, 0xef, 0x75, 0x1d, 0x9f, 0x1f,
+	0x5f, 0xb4, 0xa2, 0xef, 0xb9, 0xce, 0x7a, 0x7d, 0xd3, 0xee, 0x34, 0x1d, 0xa9, 0x12, 0xcd, 0x9e,
+	0xed, 0x37, 0x5a, 0x36, 0x63, 0x9e, 0x91, 0x19, 0x32, 0xc2, 0x1f, 0x48, 0xe7, 0xa0, 0xbe, 0xe9,
+	0xd4, 0x1f, 0xd0, 0x9e, 0x45, 0xa1, 0x27, 0xf4, 0xed, 0x4e, 0x70, 0xdf, 0xf1, 0xd7, 0xed, 0x5e,
+	0xb8, 0xe9, 0xf9, 0xad, 0xd7, 0xf2, 0x4d, 0xe0, 0xdb, 0xa1, 0xb3, 0xee, 0xb6, 0xda, 0xad, 0x90,
+	0x76, 0x5b, 0x42, 0xb7, 0xed, 0xba, 0xde, 0x63, 0xa7, 0x81, 0x95, 0xe8, 0x38, 0xae, 0x54, 0x5a,
+	0xec, 0x2b, 0xeb, 0xc1, 0x56, 0xa7, 0x9e, 0x22, 0x15, 0x75, 0xda, 0xf0, 0x5b, 0x8d, 0xa6, 0xb3,
+	0xee, 0x7b, 0xbd, 0x90, 0x59, 0xa4, 0xcc, 0x2f, 0x29, 0xb6, 0x98, 0xea, 0x5e, 0x8b, 0xc1, 0x3d,
+	0xd2, 0xf4, 0x9a, 0x1e, 0xfe, 0x6f, 0x35, 0xfa, 0x1f, 0x69, 0xb5, 0x8e, 0x00, 0x7a, 0x29, 0x5a,
+	0x7e, 0x77, 0xf1, 0xb2, 0xaa, 0x39, 0x0f, 0x7b, 0x4e, 0x10, 0x5a, 0xff, 0x0f, 0x4f, 0x0a, 0xad,
+	0x41, 0xd7, 0xeb, 0x04, 0x0e, 0xba, 0x02, 0xe3, 0x64, 0xf9, 0x95, 0x8c, 0x59, 0x63, 0xe1, 0xe0,
+	0x85, 0xa9, 0x4a, 0x66, 0xdd, 0x57, 0x08, 0xcb, 0x8d, 0xbd, 0x1f, 0xfd, 0x73, 0x66, 0x4f, 0x8d,
+	0x92, 0x5b, 0x97, 0xc1, 0xc4, 0xe3, 0xbd, 0xe0, 0x84, 0x37, 0x92, 0x45, 0x4a, 0xa5, 0xa1, 0x12,
+	0xec, 0xb7, 0x1b, 0x0d, 0xdf, 0x09, 0xc8, 0xb8, 0xc5, 0x1a, 0xfb, 0xb4, 0x1c, 0x38, 0x2e, 0xe5,
+	0xa3, 0x78, 0x6e, 0xc2, 0x41, 0x6e, 0xcd, 0x53, 0x50, 0x65, 0x09, 0x28, 0x8e, 0x99, 0x22, 0xe3,
+	0x19, 0xad, 0x06, 0x85, 0xb7, 0xea, 0xba, 0x12, 0x78, 0x37, 0x01, 0x92, 0x3d, 0x89, 0x0a, 0x99,
+	0xaf, 0x10, 0x6b, 0x57, 0x22, 0x6b, 0x57, 0xc8, 0x56, 0x48, 0x6d, 0x5e, 0xb9, 0x6b, 0x37, 0x1d,
+	0xca, 0x5b, 0xe3, 0x38, 0xad, 0x0f, 0x0c, 0x38, 0x2e, 0x15, 0x93, 0xa7, 0x4d, 0x61, 0x47, 0xda,
+	0xa0, 0x17, 0x04, 0xbc, 0x63, 0x18, 0xef, 0x99, 0x81, 0x78, 0x09, 0x08, 0x01, 0xf0, 0x31, 0x78,
+	0x8a, 0x59, 0xff, 0x2e, 0xde, 0x3a, 0x99, 0x7b, 0xbc, 0x04, 0x47, 0xd3, 0x1d, 0xbc, 0x87, 0x44,
+	0x2d, 0x4a, 0x0f, 0xe9, 0x05, 0x31, 0x72, 0x4a, 0x6e, 0x4d, 0x27, 0x33, 0x7d, 0x1b, 0xef, 0xc5,
+	0xb7, 0xf1, 0xaa, 0x65, 0x12, 0x5b, 0x70, 0x42, 0xde, 0x4d, 0xe5, 0xbe, 0x08, 0x13, 0x6d, 0xae,
+	0x9d, 0x4a, 0x9f, 0x91, 0x48, 0xe7, 0xd9, 0x29, 0x06, 0x81, 0xd5, 0xba, 0x90, 0x28, 0x47, 0x5a,
+	0x82, 0xc1, 0x7e, 0xfa, 0x0a, 0x1c, 0xcb, 0xf0, 0x50, 0x64, 0xd7, 0x60, 0x3f, 0x3d, 0x3a, 0x28,
+	0x28, 0x53, 0x06, 0x8a, 0x50, 0x50, 0x3c, 0x8c, 0xc1, 0xfa, 0x2a, 0x85, 0xb2, 0xea, 0xba, 0x29,
+	0x28, 0xa3, 0xf2, 0xc9, 0x77, 0x0d, 0x38, 0x96, 0x11, 0x21, 0x43, 0x5e, 0x18, 0x0a, 0xf9, 0xee,
+	0xf9, 0xa0, 0x9f, 0xe7, 0x83, 0x7e, 0xc6, 0x07, 0xfd, 0x41, 0x3e, 0xe8, 0x0b, 0x3e, 0xe8, 0x5b,
+	0x27, 0x64, 0xbb, 0x54, 0x2c, 0x50, 0xba, 0x17, 0xf9, 0xf2, 0xd5, 0xeb, 0x6b, 0xed, 0x45, 0x7e,
+	0x76, 0xf5, 0xfa, 0xd6, 0x51, 0x38, 0xc2, 0xc4, 0xdc, 0x79, 0xdc, 0x49, 0xc4, 0xdf, 0x86, 0xa7,
+	0x52, 0xed, 0x54, 0xf0, 0x45, 0xd8, 0x87, 0x83, 0x08, 0x2a, 0xb2, 0x24, 0x11, 0x89, 0x19, 0xa8,
+	0x30, 0x42, 0x6c, 0xdd, 0x81, 0x19, 0xd1, 0x63, 0xd7, 0xe2, 0x38, 0x83, 0xf9, 0xd8, 0x12, 0x3c,
+	0x91, 0x04, 0x1f, 0xab, 0x82, 0xe3, 0x67, 0x3b, 0xac, 0x2d, 0x98, 0xcd, 0x1f, 0x90, 0x42, 0x7d,
+	0x05, 0x0e, 0xb7, 0x53, 0x7d, 0x14, 0xf5, 0xa9, 0x5c, 0xd7, 0x4a, 0x48, 0xa9, 0x02, 0x99, 0x21,
+	0xac, 0x16, 0xcc, 0x88, 0x3e, 0x9c, 0xd5, 0x65, 0x54, 0xeb, 0xe5, 0x4f, 0x06, 0xcc, 0xe6, 0xcb,
+	0x52, 0xaa, 0x59, 0xf8, 0x9c, 0x6a, 0x8e, 0x6e, 0x4d, 0xdd, 0x82, 0xd3, 0x58, 0x87, 0x8c, 0xe4,
+	0x2d, 0x61, 0xd3, 0x45, 0xa7, 0xe1, 0x10, 0x01, 0x21, 0x4e, 0xbe, 0xd8, 0x68, 0x7d, 0x03, 0xe6,
+	0x06, 0x8c, 0xb6, 0xab, 0x66, 0x11, 0x4e, 0x0e, 0x12, 0x0c, 0x3f, 0x1f, 0xc5, 0xc2, 0xb2, 0x93,
+	0x43, 0xe8, 0xe6, 0x4e, 0x0e, 0xae, 0x5d, 0x75, 0x72, 0x70, 0x64, 0xf1, 0xc9, 0xc1, 0xb5, 0x59,
+	0xe7, 0x60, 0x8a, 0x89, 0xaa, 0xc5, 0x41, 0x37, 0x33, 0xe6, 0x24, 0x8c, 0xb5, 0xc8, 0xa9, 0xb8,
+	0xb7, 0x36, 0xd6, 0x6a, 0x58, 0x36, 0x98, 0x32, 0x62, 0x8a, 0x6a, 0x0d, 0x20, 0x89, 0xdb, 0x29,
+	0xa6, 0x69, 0x09, 0xa6, 0x84, 0x95, 0x22, 0xe2, 0xd8, 0xac, 0x3a, 0xc5, 0xb3, 0xea, 0xba, 0x59,
+	0x3c, 0xa3, 0x5a, 0x11, 0xbf, 0x31, 0xc0, 0x94, 0x49, 0xc9, 0x51, 0xa4, 0xb0, 0x03, 0x45, 0x46,
+	0xe7, 0xf9, 0xef, 0x19, 0x74, 0xab, 0x48, 0xc4, 0x05, 0x37, 0xb6, 0xee, 0x85, 0x76, 0xd8, 0x8b,
+	0x8f, 0xd6, 0x67, 0x60, 0x3c, 0xc0, 0x0d, 0xd8, 0x28, 0x93, 0x52, 0xe7, 0x4c, 0xd8, 0x29, 0x2f,
+	0x65, 0x41, 0x37, 0x25, 0x48, 0x77, 0x62, 0xd5, 0xdf, 0xb1, 0x7d, 0x46, 0x0a, 0xf4, 0x0b, 0x69,
+	0xdb, 0xd7, 0xa5, 0xb6, 0xfd, 0x3f, 0xcf, 0x6d, 0x24, 0x3b, 0xca, 0x51, 0x18, 0xdf, 0xc4, 0x0d,
+	0x74, 0x2b, 0xa1, 0x5f, 0xbb, 0x6c, 0x36, 0x86, 0xe1, 0x0b, 0x69, 0xb6, 0xa9, 0x24, 0x74, 0x5c,
+	0xa5, 0x57, 0x71, 0xb6, 0x75, 0xbd, 0x0a, 0xa5, 0x6c, 0x17, 0x55, 0xe2, 0x3a, 0x1c, 0x60, 0x37,
+	0x77, 0xba, 0x78, 0x8f, 0x4b, 0x54, 0x60, 0x6c, 0x54, 0x81, 0x98, 0xc5, 0x9a, 0xa7, 0x47, 0xc0,
+	0x2d, 0x3b, 0x6a, 0xa8, 0x91, 0x6b, 0xfe, 0x6a, 0x72, 0xcb, 0x67, 0x10, 0xbe, 0x6d, 0xc0, 0xdc,
+	0x00, 0x42, 0x0a, 0xe8, 0x2b, 0x80, 0xfc, 0x4c, 0x2f, 0x85, 0x36, 0x27, 0xb5, 0x6e, 0x9a, 0x98,
+	0x82, 0x94, 0x0c, 0x63, 0x3d, 0x80, 0x93, 0xc9, 0x1e, 0x93, 0x83, 0x75, 0x64, 0x3b, 0xda, 0x5f,
+	0x0d, 0xb0, 0x54, 0xd2, 0x06, 0x28, 0x5c, 0x18, 0x81, 0xc2, 0xa3, 0x73, 0x2f, 0x33, 0xf1, 0xa1,
+	0x7b, 0x38, 0x49, 0xb3, 0x66, 0x77, 0xd9, 0xe4, 0x7e, 0x62, 0xc0, 0x94, 0xa4, 0x93, 0xea, 0xf7,
+	0x1c, 0x14, 0x03, 0xd6, 0x48, 0xad, 0x79, 0x42, 0xa2, 0x56, 0xcc, 0x48, 0xb5, 0x49, 0x98, 0xa2,
+	0x40, 0x9c, 0x7c, 0x50, 0x05, 0xa6, 0x04, 0x05, 0x18, 0xf4, 0x35, 0xaf, 0xc5, 0x2c, 0x41, 0xc9,
+	0xd1, 0x33, 0x70, 0x60, 0xd3, 0xb1, 0x1b, 0xbe, 0xe7, 0xb5, 0x4b, 0x05, 0x3d, 0xd6, 0x98, 0x81,
+	0xbf, 0x31, 0xbc, 0x84, 0xf3, 0x4e, 0x92, 0x1b, 0x03, 0xeb, 0x48, 0x6e, 0x0c, 0x24, 0x45, 0xa5,
+	0xb8, 0x31, 0x10, 0x16, 0x06, 0x94, 0x90, 0x5b, 0xe7, 0x93, 0x28, 0xfa, 0x2e, 0x49, 0x64, 0xdd,
+	0x61, 0x79, 0xac, 0xbc, 0x73, 0x9f, 0x8b, 0x93, 0xb3, 0x2c, 0x49, 0xa4, 0xd4, 0x4d, 0xf5, 0x29,
+	0xe2, 0xe4, 0xf4, 0x30, 0x2c, 0x52, 0x4a, 0x0f, 0xc1, 0xc7, 0xc9, 0x79, 0x68, 0x77, 0x23, 0x4e,
+	0x1e, 0x52, 0xcd, 0xc2, 0xe7, 0x54, 0x73, 0x74, 0x6b, 0x87, 0x8f, 0xe7, 0x3c, 0xd7, 0x59, 0xc3,
+	0xf9, 0x47, 0x9d, 0x78, 0x8e, 0x23, 0xe6, 0xce, 0x9c, 0xb8, 0x55, 0x15, 0xcf, 0xc5, 0x44, 0xf1,
+	0x99, 0x13, 0xb7, 0x08, 0xf1, 0x5c, 0x06, 0xcf, 0xae, 0xc4, 0x73, 0x83, 0x15, 0x29, 0xec, 0x40,
+	0x91, 0xd1, 0xcd, 0xd0, 0x4a, 0x72, 0x78, 0xbe, 0x40, 0x53, 0xc0, 0x83, 0x93, 0x35, 0xdc, 0xb1,
+	0x9a, 0x30, 0x25, 0xc7, 0x2a, 0xcb, 0x25, 0x2b, 0x8e, 0x55, 0xc6, 0xc6, 0xf6, 0x1e, 0xc6, 0x62,
+	0xd9, 0x49, 0x36, 0x25, 0x8d, 0x67, 0x54, 0xf3, 0xf3, 0x4b, 0x03, 0x4a, 0x59, 0x19, 0x52, 0xf8,
+	0x85, 0x21, 0xe1, 0x8f, 0x6e, 0x5e, 0x2e, 0x32, 0x8c, 0xc4, 0xe4, 0x91, 0x33, 0x68, 0x64, 0xd1,
+	0xde, 0x2f, 0x30, 0x07, 0x17, 0xd8, 0xa8, 0x6e, 0x47, 0xf8, 0x3c, 0xc7, 0x01, 0x9a, 0xc7, 0x40,
+	0x56, 0x2a, 0xf1, 0x37, 0x86, 0x3b, 0x85, 0xb6, 0x28, 0xea, 0xa4, 0x09, 0xa1, 0x02, 0xee, 0xa5,
+	0x5f, 0x68, 0x56, 0x4c, 0xd9, 0xec, 0xc5, 0x9d, 0x7c, 0x13, 0x32, 0xb9, 0x28, 0x6b, 0x1f, 0xee,
+	0x8e, 0xbf, 0xa3, 0xbe, 0xd8, 0xd6, 0xe3, 0xa4, 0x2f, 0x36, 0xa4, 0x05, 0x13, 0xe4, 0x84, 0xb8,
+	0xed, 0xb4, 0x37, 0x1c, 0xbf, 0xb4, 0x9f, 0xa0, 0xe2, 0xdb, 0x22, 0x54, 0xe4, 0x2e, 0x5b, 0x3a,
+	0x40, 0x50, 0x91, 0x2f, 0x74, 0x05, 0x8a, 0xf8, 0xb1, 0xc0, 0xee, 0xd4, 0x9d, 0x52, 0x71, 0xc0,
+	0xe9, 0x57, 0x4b, 0x68, 0x23, 0x75, 0x92, 0xb4, 0x4c, 0x50, 0x82, 0xd9, 0xc2, 0x42, 0xb1, 0xc6,
+	0x37, 0xa1, 0xb3, 0x70, 0x38, 0xfe, 0x6c, 0x50, 0x83, 0x1d, 0xc4, 0x73, 0x90, 0x69, 0x17, 0x8d,
+	0xd3, 0x28, 0x4d, 0xa4, 0x8d, 0xd3, 0xe0, 0x93, 0xfa, 0x84, 0x27, 0xba, 0x9e, 0x04, 0x43, 0x25,
+	0xf5, 0x05, 0xbe, 0x24, 0x91, 0xd6, 0x4e, 0x9a, 0x15, 0x89, 0x34, 0x8e, 0x99, 0x25, 0xd2, 0x38,
+	0x46, 0x3e, 0xa9, 0x2f, 0x81, 0xb7, 0x1b, 0x49, 0x7d, 0x2d, 0x6d, 0x0a, 0x3b, 0xd2, 0x66, 0x74,
+	0x4b, 0xb3, 0x9c, 0xc9, 0x87, 0xbc, 0xec, 0x85, 0xb6, 0x1b, 0x3f, 0xfd, 0xb4, 0x61, 0x3a, 0xa7,
+	0x9f, 0x6a, 0x74, 0x8b, 0x64, 0x85, 0xe2, 0x0e, 0x6a, 0xbc, 0xd9, 0xfc, 0x8c, 0x09, 0xa1, 0xa3,
+	0x5a, 0x89, 0xcc, 0xd1, 0x71, 0x43, 0xd6, 0xfc, 0x5a, 0xf4, 0x08, 0xf7, 0x32, 0x7d, 0x6f, 0x63,
+	0xb3, 0x84, 0x60, 0xef, 0x7d, 0x9f, 0x26, 0x65, 0x8a, 0x35, 0xfc, 0xff, 0xe8, 0xe0, 0x0d, 0x3d,
+	0x6c, 0x81, 0x62, 0x6d, 0x2c, 0xf4, 0xa2, 0x75, 0x64, 0xb7, 0xbd, 0x5e, 0x27, 0xc4, 0xab, 0xbb,
+	0x58, 0xa3, 0x5f, 0x68, 0x05, 0xf6, 0x76, 0xed, 0x70, 0x13, 0x2f, 0xeb, 0x49, 0x69, 0x42, 0x87,
+	0x49, 0xbb, 0x6b, 0x87, 0x9b, 0x35, 0x4c, 0x8c, 0x4e, 0x40, 0x91, 0x3e, 0xbb, 0xbd, 0xd8, 0xc0,
+	0x2b, 0xbe, 0x58, 0x4b, 0x1a, 0xac, 0x37, 0xd8, 0xd9, 0x98, 0x02, 0x4b, 0x2d, 0x13, 0xb9, 0x3c,
+	0x79, 0xe6, 0xa3, 0x7b, 0x14, 0xfb, 0x44, 0x97, 0x61, 0xdc, 0x77, 0xec, 0x80, 0xce, 0xdc, 0xa4,
+	0xd4, 0x01, 0xf0, 0x98, 0x35, 0x4c, 0x55, 0xa3, 0xd4, 0xd1, 0x88, 0x6d, 0x27, 0x08, 0xec, 0xa6,
+	0x43, 0x95, 0x63, 0x9f, 0xd6, 0x97, 0x68, 0x94, 0x8b, 0xb9, 0x22, 0x3b, 0x73, 0x57, 0xec, 0x76,
+	0xf2, 0x06, 0x52, 0x8c, 0xb7, 0x15, 0x4d, 0xb3, 0x45, 0x37, 0xbe, 0xa3, 0xe9, 0x91, 0xff, 0x0b,
+	0xfa, 0xbd, 0x0a, 0x27, 0xe3, 0xbb, 0x2f, 0xff, 0xf6, 0x1a, 0xad, 0x86, 0x38, 0xe6, 0x29, 0x03,
+	0xb0, 0x87, 0xd9, 0x58, 0x5f, 0xae, 0x25, 0x3a, 0x32, 0x3a, 0x5e, 0xb4, 0x8d, 0x12, 0xb5, 0xc9,
+	0x87, 0xf5, 0x3a, 0xbb, 0xdf, 0xe5, 0x8c, 0x9d, 0xdc, 0xef, 0xec, 0x4c, 0xaf, 0xe2, 0x42, 0x9b,
+	0x1d, 0x8a, 0xdd, 0xef, 0xb2, 0xc3, 0xf0, 0x17, 0xda, 0x7c, 0xf5, 0x76, 0xe3, 0x42, 0xbb, 0x03,
+	0x85, 0x0b, 0x23, 0x50, 0x78, 0x74, 0xfb, 0x57, 0x3f, 0x89, 0xde, 0x6a, 0x76, 0xe8, 0xdc, 0x8a,
+	0x5e, 0xdc, 0x99, 0xc1, 0x84, 0xd5, 0x6b, 0xa4, 0x56, 0x2f, 0x5a, 0x83, 0x62, 0xa3, 0xe5, 0x3b,
+	0xf5, 0x18, 0xc1, 0xa4, 0xfc, 0x9e, 0xce, 0x46, 0x7d, 0x9e, 0x11, 0xd7, 0x12, 0x3e, 0xeb, 0x8d,
+	0x31, 0xee, 0x52, 0x90, 0xc8, 0x4f, 0xee, 0xcc, 0x3e, 0x6b, 0x54, 0xdc, 0x99, 0x63, 0x46, 0x76,
+	0x67, 0x8e, 0x99, 0xd0, 0x25, 0xd8, 0xf7, 0xb0, 0xe7, 0x85, 0xb6, 0xee, 0x95, 0x99, 0x50, 0x47,
+	0x9b, 0xdd, 0x7d, 0xd7, 0x7b, 0xac, 0x7b, 0x5b, 0xc6, 0xc4, 0xe8, 0x3a, 0x14, 0x7d, 0xa7, 0x6d,
+	0xb7, 0x3a, 0xad, 0x4e, 0xb3, 0xb4, 0x57, 0x8f, 0x33, 0xe1, 0xb0, 0x36, 0x92, 0x40, 0x34, 0x33,
+	0x13, 0xa3, 0x72, 0xdd, 0xf7, 0x0c, 0x98, 0x92, 0x08, 0x91, 0x9b, 0xbb, 0x30, 0xbc, 0xb9, 0x47,
+	0xe6, 0x96, 0xd7, 0x93, 0x63, 0x73, 0x95, 0x6c, 0x8a, 0x6b, 0xc4, 0xf1, 0xb4, 0x7c, 0xd3, 0x7a,
+	0x08, 0xe5, 0x3c, 0x76, 0xaa, 0xeb, 0x1d, 0x98, 0xb4, 0x85, 0x1e, 0x6a, 0xd5, 0x93, 0xb2, 0x95,
+	0x29, 0x10, 0x52, 0xad, 0x53, 0xec, 0x56, 0x13, 0xa6, 0xe3, 0x4d, 0x41, 0x8a, 0x78, 0x54, 0x73,
+	0xf8, 0x47, 0x03, 0xca, 0x79, 0x92, 0x14, 0xca, 0x15, 0x3e, 0x87, 0x72, 0xa3, 0x9b, 0xd7, 0x35,
+	0x38, 0x95, 0x79, 0xf5, 0xbd, 0xb7, 0xd5, 0xa9, 0x0f, 0x35, 0xbb, 0xdf, 0x35, 0xe0, 0xb4, 0x7a,
+	0x14, 0x6a, 0x07, 0x1b, 0x8e, 0x6c, 0x48, 0xfa, 0xa9, 0xf1, 0xcf, 0xa8, 0x5e, 0x93, 0x39, 0x72,
+	0x6a, 0x13, 0xe9, 0x50, 0x56, 0x1b, 0x4e, 0x65, 0x8a, 0x50, 0x24, 0x0a, 0x8d, 0x6a, 0xf2, 0xff,
+	0xc6, 0x54, 0xcf, 0x95, 0x37, 0x50, 0xf5, 0xc2, 0x88, 0x54, 0x1f, 0x9d, 0x53, 0x5c, 0xe3, 0x0a,
+	0x05, 0x70, 0x21, 0x56, 0xcd, 0xeb, 0x85, 0x8e, 0x9e, 0x2f, 0xf0, 0x65, 0x04, 0x3c, 0x2f, 0x57,
+	0x46, 0x90, 0x34, 0xab, 0xca, 0x08, 0x12, 0xaa, 0xb8, 0x8c, 0x20, 0x69, 0x12, 0x4a, 0x9a, 0xb2,
+	0x10, 0x77, 0xa5, 0xa4, 0x49, 0x47, 0x9b, 0xc2, 0x8e, 0xb4, 0x19, 0x7d, 0x3a, 0x9c, 0xc8, 0x13,
+	0x6b, 0x8c, 0x2e, 0xc1, 0x94, 0xa4, 0x8f, 0x8b, 0x7d, 0xa5, 0xd7, 0xd9, 0x0b, 0xef, 0x5c, 0x83,
+	0x7d, 0x98, 0x0f, 0xbd, 0x06, 0xe3, 0xa4, 0xfa, 0x0d, 0xcd, 0x49, 0x13, 0xc8, 0xe9, 0x32, 0x3b,
+	0x73, 0x7e, 0x10, 0x19, 0x11, 0x6e, 0x9d, 0xfc, 0xd6, 0x27, 0xff, 0x7e, 0x6b, 0xec, 0x38, 0x9a,
+	0xaa, 0x46, 0xf4, 0x55, 0x49, 0x3d, 0x2c, 0x7a, 0xd7, 0x80, 0x83, 0x5c, 0x5d, 0x18, 0x5a, 0xce,
+	0x1b, 0x5a, 0x5a, 0x82, 0x67, 0x56, 0x74, 0xc9, 0x29, 0xa2, 0xa7, 0x31, 0xa2, 0xb3, 0x68, 0x41,
+	0x82, 0x88, 0xcb, 0x11, 0x54, 0xb7, 0xa9, 0x95, 0xfa, 0xe8, 0x27, 0x06, 0x4c, 0x72, 0x23, 0xad,
+	0xba, 0x6e, 0x3e, 0x46, 0x69, 0x1d, 0x9e, 0x59, 0xd1, 0x25, 0xa7, 0x18, 0xe7, 0x31, 0xc6, 0x59,
+	0x54, 0x56, 0x63, 0x44, 0xdf, 0x34, 0xa2, 0x79, 0xeb, 0x05, 0x4e, 0x03, 0x2d, 0x28, 0xcc, 0x20,
+	0x94, 0xc0, 0x99, 0x8b, 0x1a, 0x94, 0x5a, 0xb3, 0x87, 0xe5, 0xfe, 0xcc, 0x80, 0x09, 0xbe, 0x30,
+	0x0d, 0xa9, 0xe6, 0x43, 0x52, 0x1f, 0x67, 0x56, 0xb5, 0xe9, 0x29, 0xa8, 0x05, 0x0c, 0xca, 0x42,
+	0xb3, 0x12, 0x50, 0x42, 0x31, 0x34, 0xfa, 0x81, 0x01, 0xfb, 0x6f, 0xd3, 0xb2, 0x2e, 0x95, 0xd6,
+	0x62, 0x85, 0x9a, 0x79, 0x56, 0x87, 0x94, 0x82, 0x59, 0xc2, 0x60, 0xe6, 0xd1, 0x69, 0x19, 0x18,
+	0x42, 0xcb, 0x79, 0xd2, 0x77, 0x0c, 0x00, 0x3a, 0x42, 0xe4, 0x45, 0x8b, 0x0a, 0xb7, 0xd0, 0xc5,
+	0x94, 0xad, 0x7e, 0xb3, 0x2c, 0x8c, 0xe9, 0x04, 0x32, 0xf3, 0x31, 0x25, 0x9e, 0xe3, 0x0f, 0xf6,
+	0x1c, 0x5f, 0xdb, 0x73, 0x7c, 0x7d, 0xcf, 0xf1, 0xd1, 0xdb, 0xc2, 0xba, 0xf7, 0x35, 0xd7, 0xbd,
+	0x3f, 0xdc, 0xba, 0xf7, 0x87, 0x5c, 0x53, 0x3e, 0xfa, 0x3a, 0xec, 0xc3, 0x45, 0x67, 0xe8, 0x8c,
+	0x42, 0x00, 0x5f, 0xdf, 0x66, 0x2e, 0x0c, 0x26, 0xa4, 0x18, 0x66, 0x31, 0x06, 0x13, 0x95, 0x24,
+	0x18, 0x48, 0x52, 0xf8, 0x43, 0x03, 0x0e, 0xa7, 0xeb, 0x87, 0xd0, 0x85, 0x81, 0x0e, 0x99, 0x29,
+	0x1b, 0x33, 0x57, 0x86, 0xe2, 0xa1, 0xf8, 0x9e, 0xc3, 0xf8, 0xae, 0xa1, 0xab, 0xb9, 0x9e, 0xc3,
+	0x15, 0xf5, 0x57, 0xb7, 0x33, 0xa5, 0x74, 0x7d, 0xf4, 0xbe, 0x01, 0x4f, 0xa6, 0x87, 0x8f, 0x5c,
+	0xfd, 0xc2, 0x40, 0xff, 0x1d, 0x42, 0x05, 0x45, 0x05, 0x9b, 0xc6, 0x82, 0xe4, 0x54, 0x40, 0x7f,
+	0x37, 0xa0, 0x94, 0x57, 0xfd, 0x85, 0xae, 0xe4, 0xc9, 0x1f, 0x50, 0x7d, 0x66, 0x5e, 0x1d, 0x9e,
+	0x91, 0xa2, 0xbf, 0x89, 0xd1, 0x3f, 0x87, 0x9e, 0xd5, 0x41, 0xbf, 0xbe, 0xb1, 0x45, 0x77, 0xba,
+	0xea, 0xb6, 0x50, 0xd8, 0xd6, 0x27, 0xbb, 0x32, 0x57, 0xe0, 0xa5, 0xde, 0x95, 0xb3, 0xb5, 0x67,
+	0x66, 0x55, 0x9b, 0x5e, 0x67, 0x57, 0xe6, 0x7f, 0xe9, 0x81, 0x7e, 0x64, 0x00, 0x24, 0x05, 0x2a,
+	0x68, 0x49, 0x21, 0x29, 0x53, 0xfb, 0x65, 0x2e, 0x6b, 0x52, 0x53, 0x54, 0x67, 0x31, 0xaa, 0xd3,
+	0xc8, 0x92, 0xa0, 0x4a, 0x4a, 0x62, 0xaa, 0xdb, 0xad, 0x46, 0x1f, 0xbd, 0x65, 0xc0, 0xa1, 0x64,
+	0x88, 0xc8, 0x69, 0x97, 0x14, 0x0e, 0x38, 0x04, 0x34, 0x69, 0x79, 0x99, 0x35, 0x87, 0xa1, 0xcd,
+	0xa0, 0x69, 0x25, 0x34, 0xf4, 0x07, 0x03, 0x9e, 0x94, 0x54, 0x52, 0xe5, 0x2f, 0xa8, 0xfc, 0xfa,
+	0x30, 0x73, 0x65, 0x28, 0x1e, 0x8a, 0xf3, 0x12, 0xc6, 0x59, 0x45, 0xcb, 0x6a, 0x13, 0x92, 0x2a,
+	0xb2, 0xea, 0x36, 0xf9, 0xb7, 0x9f, 0xc5, 0x4d, 0x4a, 0x99, 0x34, 0x71, 0x0b, 0xb5, 0x57, 0xe6,
+	0xca, 0x50, 0x3c, 0xc3, 0xe1, 0x26, 0x65, 0x5c, 0xd5, 0x6d, 0xf2, 0x6f, 0x1f, 0xbd, 0x61, 0xc0,
+	0x01, 0x56, 0x7b, 0x84, 0x54, 0x91, 0x40, 0xaa, 0xe4, 0xc9, 0x3c, 0xa7, 0x45, 0x4b, 0xc1, 0x9d,
+	0xc2, 0xe0, 0xa6, 0xd1, 0x71, 0x09, 0xb8, 0xf8, 0x99, 0xee, 0xcf, 0x06, 0x94, 0xf2, 0x8a, 0x97,
+	0xf2, 0x37, 0xa7, 0x01, 0x75, 0x51, 0xe6, 0xd5, 0xe1, 0x19, 0xb5, 0x2c, 0x9a, 0xf9, 0xb9, 0x55,
+	0xd5, 0xc5, 0x03, 0xa2, 0xdf, 0x1b, 0xf0, 0x54, 0x76, 0xd4, 0x68, 0x7d, 0x5d, 0x54, 0xae, 0x98,
+	0x3c, 0x05, 0x2e, 0x0d, 0xc9, 0x45, 0xd1, 0x57, 0x30, 0xfa, 0x05, 0x34, 0xaf, 0x87, 0x1e, 0x7d,
+	0xdf, 0x80, 0x62, 0x5c, 0x21, 0x84, 0x54, 0xb3, 0x9b, 0xae, 0x4e, 0x32, 0x97, 0xf4, 0x88, 0x35,
+	0x36, 0x82, 0xe4, 0xd7, 0x69, 0x38, 0x62, 0x23, 0x95, 0x3c, 0xca, 0x88, 0x4d, 0x28, 0x1c, 0x32,
+	0x17, 0x35, 0x28, 0x35, 0x22, 0x36, 0xf2, 0xfa, 0x8b, 0x3e, 0x30, 0xe0, 0x70, 0xba, 0x96, 0x45,
+	0x19, 0x9c, 0xe4, 0xd4, 0xea, 0x98, 0x2b, 0x43, 0xf1, 0x50, 0x80, 0xe7, 0x31, 0xc0, 0x73, 0x68,
+	0x51, 0x16, 0x52, 0xa6, 0x7f, 0x8b, 0x47, 0xb6, 0xf4, 0x28, 0x1a, 0x49, 0x8f, 0x37, 0x28, 0x1a,
+	0x19, 0x1a, 0xb3, 0xa2, 0x4e, 0x48, 0x19, 0x8d, 0x64, 0x30, 0xa3, 0x1f, 0x47, 0x27, 0x63, 0x52,
+	0x6b, 0xa2, 0x3c, 0x19, 0xd3, 0x55, 0x34, 0xe6, 0xb2, 0x26, 0x35, 0x45, 0x76, 0x0e, 0x23, 0x9b,
+	0x43, 0xa7, 0x64, 0xcb, 0x21, 0xf9, 0xcd, 0x22, 0xb1, 0xe3, 0xdb, 0xd1, 0xd1, 0x18, 0x8f, 0x31,
+	0xf0, 0x68, 0xd4, 0xc7, 0x26, 0xad, 0xd4, 0x51, 0x86, 0xea, 0x1c, 0x36, 0xf4, 0x43, 0x03, 0x0e,
+	0xb0, 0x8a, 0x10, 0xe5, 0x5e, 0x9d, 0xaa, 0x68, 0x31, 0xcf, 0x69, 0xd1, 0x52, 0x34, 0xcb, 0x18,
+	0xcd, 0x19, 0x34, 0x27, 0x41, 0xc3, 0xca, 0x26, 0xb8, 0x3b, 0xde, 0xf7, 0x0c, 0x38, 0xc8, 0xc6,
+	0x88, 0x2c, 0xa5, 0xba, 0xb9, 0x69, 0xe3, 0x92, 0x54, 0xcc, 0x28, 0xcf, 0x10, 0x86, 0x0b, 0xbd,
+	0x63, 0xc0, 0x04, 0x5f, 0x93, 0x92, 0xbf, 0x91, 0x49, 0x0a, 0x5e, 0xcc, 0x25, 0x3d, 0x62, 0x0a,
+	0xe8, 0x02, 0x06, 0xb4, 0x84, 0xce, 0xca, 0x0e, 0x35, 0xc2, 0xb0, 0x1e, 0x4d, 0x1f, 0x7f, 0x23,
+	0xfe, 0xb9, 0x01, 0x07, 0xb9, 0xfa, 0x01, 0xe5, 0x25, 0x30, 0x5b, 0x0b, 0x61, 0x56, 0x74, 0xc9,
+	0x35, 0xf6, 0x10, 0xfe, 0xc7, 0xb6, 0x1c, 0xc2, 0x9f, 0x1a, 0x30, 0xc9, 0x0d, 0x35, 0x28, 0xfb,
+	0x33, 0x0c, 0x48, 0x79, 0xe1, 0x85, 0x75, 0x06, 0x83, 0x3c, 0x89, 0x66, 0x06, 0x80, 0x8c, 0x26,
+	0xf7, 0x90, 0x50, 0xa8, 0x80, 0x34, 0xc2, 0x76, 0xa1, 0x66, 0xc2, 0x7c, 0x5a, 0x9f, 0x81, 0xa2,
+	0x5b, 0xc4, 0xe8, 0x4e, 0xa1, 0x93, 0x8a, 0x40, 0x3f, 0x24, 0x68, 0xde, 0x37, 0xe0, 0x90, 0x50,
+	0x6f, 0x90, 0xbf, 0x6d, 0xc8, 0x6a, 0x28, 0xcc, 0x65, 0x4d, 0x6a, 0x8a, 0xec, 0x59, 0x8c, 0xec,
+	0x2a, 0xba, 0x2c, 0x41, 0x86, 0x7f, 0x28, 0xbd, 0xce, 0x7e, 0x14, 0x5d, 0xdd, 0x8e, 0xea, 0x31,
+	0xfa, 0xd5, 0xed, 0xd0, 0xeb, 0x57, 0xb7, 0x49, 0xf9, 0x40, 0x3f, 0x4a, 0x44, 0x16, 0xe3, 0xd2,
+	0x81, 0xfc, 0x43, 0x36, 0x5d, 0xb7, 0x60, 0x2e, 0x6a, 0x50, 0x52, 0x88, 0xff, 0x8b, 0x21, 0x5e,
+	0x46, 0x17, 0x73, 0x21, 0x46, 0x26, 0x64, 0xd7, 0xb9, 0x34, 0xc0, 0xbf, 0x18, 0x80, 0xb2, 0x4f,
+	0xd7, 0xe8, 0xa2, 0x2a, 0xf2, 0xcc, 0x7b, 0xa2, 0x37, 0x2f, 0x0d, 0xc9, 0x45, 0x35, 0x58, 0xc3,
+	0x1a, 0x5c, 0x47, 0xcf, 0xc8, 0x16, 0x39, 0xcf, 0x86, 0x7d, 0xd4, 0xa9, 0x6e, 0xb3, 0x46, 0xac,
+	0x0e, 0x2e, 0x63, 0xe8, 0xe3, 0x90, 0x30, 0x2b, 0x63, 0x50, 0x48, 0xb8, 0x03, 0x5d, 0x94, 0x65,
+	0x03, 0xca, 0x90, 0x50, 0xa2, 0x0b, 0xfa, 0xb5, 0x01, 0xc5, 0xf8, 0x45, 0x56, 0x19, 0x12, 0xa6,
+	0x5f, 0x95, 0xcd, 0x25, 0x3d, 0x62, 0x0a, 0xec, 0x3a, 0x06, 0x76, 0x05, 0x5d, 0x92, 0x1d, 0x80,
+	0xf1, 0xaf, 0xf5, 0xab, 0xdb, 0xf1, 0xdb, 0x4c, 0xbf, 0xba, 0x1d, 0x3f, 0xf4, 0xf7, 0xd1, 0x9b,
+	0x06, 0x4c, 0xc4, 0x83, 0x46, 0x56, 0x55, 0x9d, 0x2b, 0xfa, 0x50, 0x65, 0x0f, 0xd9, 0xea, 0x6b,
+	0x6c, 0x0c, 0x15, 0xfd, 0xd6, 0x80, 0x49, 0xf1, 0xf9, 0x13, 0xa9, 0xb6, 0x1e, 0xe9, 0xb3, 0xae,
+	0x79, 0x7e, 0x08, 0x0e, 0x0a, 0xef, 0x32, 0x86, 0xf7, 0x34, 0xaa, 0xc8, 0xa6, 0x58, 0xfc, 0xc3,
+	0x06, 0xbc, 0x39, 0xd1, 0xaf, 0x0c, 0x78, 0x42, 0x1c, 0x72, 0xd5, 0x55, 0x40, 0xce, 0x7b, 0x89,
+	0x36, 0xcf, 0x0f, 0xc1, 0xa1, 0x91, 0xb3, 0x48, 0x41, 0x8e, 0x76, 0x84, 0x23, 0xb2, 0xc7, 0x44,
+	0x74, 0x59, 0x27, 0x3b, 0x9a, 0x7d, 0x3c, 0x35, 0xaf, 0x0c, 0xcd, 0xa7, 0xe1, 0xb2, 0xf2, 0xbf,
+	0x0e, 0x21, 0xd8, 0xfb, 0x43, 0x03, 0x8e, 0xc9, 0xc6, 0x5f, 0x75, 0x15, 0xba, 0xa8, 0x1f, 0x82,
+	0xcd, 0x2b, 0x43, 0xf3, 0x69, 0x44, 0x09, 0x72, 0x5d, 0xd0, 0x2f, 0xa2, 0x64, 0x36, 0xf7, 0xec,
+	0xa7, 0x4c, 0x66, 0x67, 0x5e, 0x35, 0xcd, 0x8a, 0x2e, 0x39, 0x45, 0xb8, 0x82, 0x11, 0x2e, 0xa3,
+	0x73, 0x32, 0x84, 0xdc, 0x1f, 0xd8, 0x10, 0x6c, 0x1c, 0x45, 0x32, 0xdc, 0x60, 0x03, 0xdf, 0xb1,
+	0x86, 0x80, 0x29, 0x7f, 0x44, 0x55, 0x46, 0x32, 0x3c, 0xcc, 0x28, 0xf7, 0x36, 0xc1, 0x3f, 0x5e,
+	0xe6, 0xef, 0x58, 0x92, 0xe7, 0x4f, 0x73, 0x49, 0x8f, 0x58, 0x23, 0x53, 0x49, 0x41, 0x91, 0xe3,
+	0xf7, 0xc6, 0xbd, 0x8f, 0x3e, 0x2d, 0x1b, 0x1f, 0x7f, 0x5a, 0x36, 0xfe, 0xf5, 0x69, 0xd9, 0x78,
+	0xf3, 0xb3, 0xf2, 0x9e, 0x8f, 0x3f, 0x2b, 0xef, 0xf9, 0xc7, 0x67, 0xe5, 0x3d, 0x5f, 0xfe, 0x9f,
+	0x66, 0x2b, 0xdc, 0xec, 0x6d, 0x54, 0xea, 0x5e, 0xbb, 0x1a, 0x84, 0x7e, 0x74, 0x1b, 0x71, 0xbd,
+	0x47, 0xce, 0xf2, 0x23, 0xa7, 0x13, 0xf6, 0x7c, 0x27, 0x20, 0x43, 0x7f, 0x4d, 0x1c, 0x3c, 0xdc,
+	0xea, 0x3a, 0xc1, 0xc6, 0x38, 0xfe, 0xeb, 0x25, 0x2b, 0xff, 0x19, 0x00, 0xba, 0xc3, 0xc4, 0x85,
+	0x93, 0x48, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Blacklisted by index.
+	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
+	// Queries a Paused by index.
+	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
+	// Queries a MinterController by index.
+	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
+	// Queries the MinterController items that control a minter.
+	MinterControllerByMinter(ctx context.Context, in *QueryMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryMinterControllerByMinterResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+	// Queries a Redemption by id.
+	Redemption(ctx context.Context, in *QueryGetRedemptionRequest, opts ...grpc.CallOption) (*QueryGetRedemptionResponse, error)
+	// Queries a list of Redemption items.
+	RedemptionAll(ctx context.Context, in *QueryAllRedemptionRequest, opts ...grpc.CallOption) (*QueryAllRedemptionResponse, error)
+	// Queries a list of Redemption items with a given status.
+	RedemptionsByStatus(ctx context.Context, in *QueryRedemptionsByStatusRequest, opts ...grpc.CallOption) (*QueryRedemptionsByStatusResponse, error)
+	// Queries a list of Redemption items requested by a given holder.
+	RedemptionsByHolder(ctx context.Context, in *QueryRedemptionsByHolderRequest, opts ...grpc.CallOption) (*QueryRedemptionsByHolderResponse, error)
+	// Queries a Attester by index.
+	Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error)
+	// Queries the latest ReserveAttestation.
+	LatestReserveAttestation(ctx context.Context, in *QueryLatestReserveAttestationRequest, opts ...grpc.CallOption) (*QueryLatestReserveAttestationResponse, error)
+	// Queries the history of ReserveAttestation items.
+	ReserveAttestationAll(ctx context.Context, in *QueryAllReserveAttestationRequest, opts ...grpc.CallOption) (*QueryAllReserveAttestationResponse, error)
+	// Queries the SupplyCap along with the current supply and the remaining headroom.
+	SupplyCap(ctx context.Context, in *QueryGetSupplyCapRequest, opts ...grpc.CallOption) (*QueryGetSupplyCapResponse, error)
+	// Queries the Quorum.
+	Quorum(ctx context.Context, in *QueryGetQuorumRequest, opts ...grpc.CallOption) (*QueryGetQuorumResponse, error)
+	// Queries a PendingOperation by id.
+	PendingOperation(ctx context.Context, in *QueryGetPendingOperationRequest, opts ...grpc.CallOption) (*QueryGetPendingOperationResponse, error)
+	// Queries a list of PendingOperation items.
+	PendingOperationAll(ctx context.Context, in *QueryAllPendingOperationRequest, opts ...grpc.CallOption) (*QueryAllPendingOperationResponse, error)
+	// Queries a queued RoleChange by id.
+	RoleChange(ctx context.Context, in *QueryGetRoleChangeRequest, opts ...grpc.CallOption) (*QueryGetRoleChangeResponse, error)
+	// Queries a list of queued RoleChange items.
+	RoleChangeAll(ctx context.Context, in *QueryAllRoleChangeRequest, opts ...grpc.CallOption) (*QueryAllRoleChangeResponse, error)
+	// Queries a Guardian by index.
+	Guardian(ctx context.Context, in *QueryGetGuardianRequest, opts ...grpc.CallOption) (*QueryGetGuardianResponse, error)
+	// Queries a list of Guardian items.
+	GuardianAll(ctx context.Context, in *QueryAllGuardianRequest, opts ...grpc.CallOption) (*QueryAllGuardianResponse, error)
+	// Queries every tokenfactory role held by an address.
+	AddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error)
+	// Queries the lifetime MinterStats of a minter.
+	MinterStats(ctx context.Context, in *QueryGetMinterStatsRequest, opts ...grpc.CallOption) (*QueryGetMinterStatsResponse, error)
+	// Queries a list of MinterStats items.
+	MinterStatsAll(ctx context.Context, in *QueryAllMinterStatsRequest, opts ...grpc.CallOption) (*QueryAllMinterStatsResponse, error)
+	// Queries the lifetime MintingTotals across all minters.
+	MintingTotals(ctx context.Context, in *QueryGetMintingTotalsRequest, opts ...grpc.CallOption) (*QueryGetMintingTotalsResponse, error)
+	// Checks whether a transfer would be accepted without signing a transaction.
+	CheckTransfer(ctx context.Context, in *QueryCheckTransferRequest, opts ...grpc.CallOption) (*QueryCheckTransferResponse, error)
+	// Checks whether a mint would be accepted without signing a transaction.
+	CheckMint(ctx context.Context, in *QueryCheckMintRequest, opts ...grpc.CallOption) (*QueryCheckMintResponse, error)
+	// Queries the AuthorizationState of a nonce of an authorizer.
+	AuthorizationState(ctx context.Context, in *QueryGetAuthorizationStateRequest, opts ...grpc.CallOption) (*QueryGetAuthorizationStateResponse, error)
+	// Queries a list of AuthorizationState items.
+	AuthorizationStateAll(ctx context.Context, in *QueryAllAuthorizationStateRequest, opts ...grpc.CallOption) (*QueryAllAuthorizationStateResponse, error)
+	// Queries the RateLimit of a channel and direction along with its usage in the current window.
+	RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error)
+	// Queries a BlacklistSyncChannel by index.
+	BlacklistSyncChannel(ctx context.Context, in *QueryGetBlacklistSyncChannelRequest, opts ...grpc.CallOption) (*QueryGetBlacklistSyncChannelResponse, error)
+	// Queries a list of BlacklistSyncChannel items.
+	BlacklistSyncChannelAll(ctx context.Context, in *QueryAllBlacklistSyncChannelRequest, opts ...grpc.CallOption) (*QueryAllBlacklistSyncChannelResponse, error)
+	// Queries a BridgeRoute by index.
+	BridgeRoute(ctx context.Context, in *QueryGetBridgeRouteRequest, opts ...grpc.CallOption) (*QueryGetBridgeRouteResponse, error)
+	// Queries a list of BridgeRoute items.
+	BridgeRouteAll(ctx context.Context, in *QueryAllBridgeRouteRequest, opts ...grpc.CallOption) (*QueryAllBridgeRouteResponse, error)
+	// Queries the address whose minter allowance bridge transfers are minted under.
+	BridgeMinter(ctx context.Context, in *QueryBridgeMinterRequest, opts ...grpc.CallOption) (*QueryBridgeMinterResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
+	out := new(QueryGetBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Blacklisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
+	out := new(QueryAllBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BlacklistedAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
+	out := new(QueryGetPausedResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
+	out := new(QueryGetMasterMinterResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MasterMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
+	out := new(QueryGetMintersResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Minters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
+	out := new(QueryAllMintersResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MintersAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
+	out := new(QueryGetPauserResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Pauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
+	out := new(QueryGetBlacklisterResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Blacklister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
+	out := new(QueryGetOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Owner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
+	out := new(QueryGetMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
+	out := new(QueryAllMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterControllerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerByMinter(ctx context.Context, in *QueryMinterControllerByMinterRequest, opts ...grpc.CallOption) (*QueryMinterControllerByMinterResponse, error) {
+	out := new(QueryMinterControllerByMinterResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterControllerByMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
+	out := new(QueryGetMintingDenomResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MintingDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Redemption(ctx context.Context, in *QueryGetRedemptionRequest, opts ...grpc.CallOption) (*QueryGetRedemptionResponse, error) {
+	out := new(QueryGetRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Redemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionAll(ctx context.Context, in *QueryAllRedemptionRequest, opts ...grpc.CallOption) (*QueryAllRedemptionResponse, error) {
+	out := new(QueryAllRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RedemptionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionsByStatus(ctx context.Context, in *QueryRedemptionsByStatusRequest, opts ...grpc.CallOption) (*QueryRedemptionsByStatusResponse, error) {
+	out := new(QueryRedemptionsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RedemptionsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionsByHolder(ctx context.Context, in *QueryRedemptionsByHolderRequest, opts ...grpc.CallOption) (*QueryRedemptionsByHolderResponse, error) {
+	out := new(QueryRedemptionsByHolderResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RedemptionsByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error) {
+	out := new(QueryGetAttesterResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Attester", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestReserveAttestation(ctx context.Context, in *QueryLatestReserveAttestationRequest, opts ...grpc.CallOption) (*QueryLatestReserveAttestationResponse, error) {
+	out := new(QueryLatestReserveAttestationResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/LatestReserveAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveAttestationAll(ctx context.Context, in *QueryAllReserveAttestationRequest, opts ...grpc.CallOption) (*QueryAllReserveAttestationResponse, error) {
+	out := new(QueryAllReserveAttestationResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/ReserveAttestationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyCap(ctx context.Context, in *QueryGetSupplyCapRequest, opts ...grpc.CallOption) (*QueryGetSupplyCapResponse, error) {
+	out := new(QueryGetSupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/SupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Quorum(ctx context.Context, in *QueryGetQuorumRequest, opts ...grpc.CallOption) (*QueryGetQuorumResponse, error) {
+	out := new(QueryGetQuorumResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Quorum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOperation(ctx context.Context, in *QueryGetPendingOperationRequest, opts ...grpc.CallOption) (*QueryGetPendingOperationResponse, error) {
+	out := new(QueryGetPendingOperationResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/PendingOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOperationAll(ctx context.Context, in *QueryAllPendingOperationRequest, opts ...grpc.CallOption) (*QueryAllPendingOperationResponse, error) {
+	out := new(QueryAllPendingOperationResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/PendingOperationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleChange(ctx context.Context, in *QueryGetRoleChangeRequest, opts ...grpc.CallOption) (*QueryGetRoleChangeResponse, error) {
+	out := new(QueryGetRoleChangeResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RoleChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleChangeAll(ctx context.Context, in *QueryAllRoleChangeRequest, opts ...grpc.CallOption) (*QueryAllRoleChangeResponse, error) {
+	out := new(QueryAllRoleChangeResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RoleChangeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Guardian(ctx context.Context, in *QueryGetGuardianRequest, opts ...grpc.CallOption) (*QueryGetGuardianResponse, error) {
+	out := new(QueryGetGuardianResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/Guardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianAll(ctx context.Context, in *QueryAllGuardianRequest, opts ...grpc.CallOption) (*QueryAllGuardianResponse, error) {
+	out := new(QueryAllGuardianResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/GuardianAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressRoles(ctx context.Context, in *QueryAddressRolesRequest, opts ...grpc.CallOption) (*QueryAddressRolesResponse, error) {
+	out := new(QueryAddressRolesResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/AddressRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterStats(ctx context.Context, in *QueryGetMinterStatsRequest, opts ...grpc.CallOption) (*QueryGetMinterStatsResponse, error) {
+	out := new(QueryGetMinterStatsResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterStatsAll(ctx context.Context, in *QueryAllMinterStatsRequest, opts ...grpc.CallOption) (*QueryAllMinterStatsResponse, error) {
+	out := new(QueryAllMinterStatsResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MinterStatsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingTotals(ctx context.Context, in *QueryGetMintingTotalsRequest, opts ...grpc.CallOption) (*QueryGetMintingTotalsResponse, error) {
+	out := new(QueryGetMintingTotalsResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/MintingTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckTransfer(ctx context.Context, in *QueryCheckTransferRequest, opts ...grpc.CallOption) (*QueryCheckTransferResponse, error) {
+	out := new(QueryCheckTransferResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/CheckTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckMint(ctx context.Context, in *QueryCheckMintRequest, opts ...grpc.CallOption) (*QueryCheckMintResponse, error) {
+	out := new(QueryCheckMintResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/CheckMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizationState(ctx context.Context, in *QueryGetAuthorizationStateRequest, opts ...grpc.CallOption) (*QueryGetAuthorizationStateResponse, error) {
+	out := new(QueryGetAuthorizationStateResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/AuthorizationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizationStateAll(ctx context.Context, in *QueryAllAuthorizationStateRequest, opts ...grpc.CallOption) (*QueryAllAuthorizationStateResponse, error) {
+	out := new(QueryAllAuthorizationStateResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/AuthorizationStateAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error) {
+	out := new(QueryGetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error) {
+	out := new(QueryAllRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/RateLimitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannel(ctx context.Context, in *QueryGetAllowedChannelRequest, opts ...grpc.CallOption) (*QueryGetAllowedChannelResponse, error) {
+	out := new(QueryGetAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/AllowedChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannelAll(ctx context.Context, in *QueryAllAllowedChannelRequest, opts ...grpc.CallOption) (*QueryAllAllowedChannelResponse, error) {
+	out := new(QueryAllAllowedChannelResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/AllowedChannelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlacklistSyncChannel(ctx context.Context, in *QueryGetBlacklistSyncChannelRequest, opts ...grpc.CallOption) (*QueryGetBlacklistSyncChannelResponse, error) {
+	out := new(QueryGetBlacklistSyncChannelResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BlacklistSyncChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlacklistSyncChannelAll(ctx context.Context, in *QueryAllBlacklistSyncChannelRequest, opts ...grpc.CallOption) (*QueryAllBlacklistSyncChannelResponse, error) {
+	out := new(QueryAllBlacklistSyncChannelResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BlacklistSyncChannelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRoute(ctx context.Context, in *QueryGetBridgeRouteRequest, opts ...grpc.CallOption) (*QueryGetBridgeRouteResponse, error) {
+	out := new(QueryGetBridgeRouteResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BridgeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRouteAll(ctx context.Context, in *QueryAllBridgeRouteRequest, opts ...grpc.CallOption) (*QueryAllBridgeRouteResponse, error) {
+	out := new(QueryAllBridgeRouteResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BridgeRouteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeMinter(ctx context.Context, in *QueryBridgeMinterRequest, opts ...grpc.CallOption) (*QueryBridgeMinterResponse, error) {
+	out := new(QueryBridgeMinterResponse)
+	err := c.cc.Invoke(ctx, "/hero.tokenfactory.Query/BridgeMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Blacklisted by index.
+	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
+	// Queries a Paused by index.
+	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
+	// Queries a MinterController by index.
+	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
+	// Queries the MinterController items that control a minter.
+	MinterControllerByMinter(context.Context, *QueryMinterControllerByMinterRequest) (*QueryMinterControllerByMinterResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+	// Queries a Redemption by id.
+	Redemption(context.Context, *QueryGetRedemptionRequest) (*QueryGetRedemptionResponse, error)
+	// Queries a list of Redemption items.
+	RedemptionAll(context.Context, *QueryAllRedemptionRequest) (*QueryAllRedemptionResponse, error)
+	// Queries a list of Redemption items with a given status.
+	RedemptionsByStatus(context.Context, *QueryRedemptionsByStatusRequest) (*QueryRedemptionsByStatusResponse, error)
+	// Queries a list of Redemption items requested by a given holder.
+	RedemptionsByHolder(context.Context, *QueryRedemptionsByHolderRequest) (*QueryRedemptionsByHolderResponse, error)
+	// Queries a Attester by index.
+	Attester(context.Context, *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error)
+	// Queries the latest ReserveAttestation.
+	LatestReserveAttestation(context.Context, *QueryLatestReserveAttestationRequest) (*QueryLatestReserveAttestationResponse, error)
+	// Queries the history of ReserveAttestation items.
+	ReserveAttestationAll(context.Context, *QueryAllReserveAttestationRequest) (*QueryAllReserveAttestationResponse, error)
+	// Queries the SupplyCap along with the current supply and the remaining headroom.
+	SupplyCap(context.Context, *QueryGetSupplyCapRequest) (*QueryGetSupplyCapResponse, error)
+	// Queries the Quorum.
+	Quorum(context.Context, *QueryGetQuorumRequest) (*QueryGetQuorumResponse, error)
+	// Queries a PendingOperation by id.
+	PendingOperation(context.Context, *QueryGetPendingOperationRequest) (*QueryGetPendingOperationResponse, error)
+	// Queries a list of PendingOperation items.
+	PendingOperationAll(context.Context, *QueryAllPendingOperationRequest) (*QueryAllPendingOperationResponse, error)
+	// Queries a queued RoleChange by id.
+	RoleChange(context.Context, *QueryGetRoleChangeRequest) (*QueryGetRoleChangeResponse, error)
+	// Queries a list of queued RoleChange items.
+	RoleChangeAll(context.Context, *QueryAllRoleChangeRequest) (*QueryAllRoleChangeResponse, error)
+	// Queries a Guardian by index.
+	Guardian(context.Context, *QueryGetGuardianRequest) (*QueryGetGuardianResponse, error)
+	// Queries a list of Guardian items.
+	GuardianAll(context.Context, *QueryAllGuardianRequest) (*QueryAllGuardianResponse, error)
+	// Queries every tokenfactory role held by an address.
+	AddressRoles(context.Context, *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error)
+	// Queries the lifetime MinterStats of a minter.
+	MinterStats(context.Context, *QueryGetMinterStatsRequest) (*QueryGetMinterStatsResponse, error)
+	// Queries a list of MinterStats items.
+	MinterStatsAll(context.Context, *QueryAllMinterStatsRequest) (*QueryAllMinterStatsResponse, error)
+	// Queries the lifetime MintingTotals across all minters.
+	MintingTotals(context.Context, *QueryGetMintingTotalsRequest) (*QueryGetMintingTotalsResponse, error)
+	// Checks whether a transfer would be accepted without signing a transaction.
+	CheckTransfer(context.Context, *QueryCheckTransferRequest) (*QueryCheckTransferResponse, error)
+	// Checks whether a mint would be accepted without signing a transaction.
+	CheckMint(context.Context, *QueryCheckMintRequest) (*QueryCheckMintResponse, error)
+	// Queries the AuthorizationState of a nonce of an authorizer.
+	AuthorizationState(context.Context, *QueryGetAuthorizationStateRequest) (*QueryGetAuthorizationStateResponse, error)
+	// Queries a list of AuthorizationState items.
+	AuthorizationStateAll(context.Context, *QueryAllAuthorizationStateRequest) (*QueryAllAuthorizationStateResponse, error)
+	// Queries the RateLimit of a channel and direction along with its usage in the current window.
+	RateLimit(context.Context, *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(context.Context, *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error)
+	// Queries an AllowedChannel by index.
+	AllowedChannel(context.Context, *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error)
+	// Queries a list of AllowedChannel items.
+	AllowedChannelAll(context.Context, *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error)
+	// Queries a BlacklistSyncChannel by index.
+	BlacklistSyncChannel(context.Context, *QueryGetBlacklistSyncChannelRequest) (*QueryGetBlacklistSyncChannelResponse, error)
+	// Queries a list of BlacklistSyncChannel items.
+	BlacklistSyncChannelAll(context.Context, *QueryAllBlacklistSyncChannelRequest) (*QueryAllBlacklistSyncChannelResponse, error)
+	// Queries a BridgeRoute by index.
+	BridgeRoute(context.Context, *QueryGetBridgeRouteRequest) (*QueryGetBridgeRouteResponse, error)
+	// Queries a list of BridgeRoute items.
+	BridgeRouteAll(context.Context, *QueryAllBridgeRouteRequest) (*QueryAllBridgeRouteResponse, error)
+	// Queries the address whose minter allowance bridge transfers are minted under.
+	BridgeMinter(context.Context, *QueryBridgeMinterRequest) (*QueryBridgeMinterResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+}
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+}
+func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+}
+func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+}
+func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
+}
+func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+}
+func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
+}
+func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
+}
+func (*UnimplementedQueryServer) MinterControllerByMinter(ctx context.Context, req *QueryMinterControllerByMinterRequest) (*QueryMinterControllerByMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerByMinter not implemented")
+}
+func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+}
+func (*UnimplementedQueryServer) Redemption(ctx context.Context, req *QueryGetRedemptionRequest) (*QueryGetRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionAll(ctx context.Context, req *QueryAllRedemptionRequest) (*QueryAllRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionAll not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionsByStatus(ctx context.Context, req *QueryRedemptionsByStatusRequest) (*QueryRedemptionsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionsByStatus not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionsByHolder(ctx context.Context, req *QueryRedemptionsByHolderRequest) (*QueryRedemptionsByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionsByHolder not implemented")
+}
+func (*UnimplementedQueryServer) Attester(ctx context.Context, req *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attester not implemented")
+}
+func (*UnimplementedQueryServer) LatestReserveAttestation(ctx context.Context, req *QueryLatestReserveAttestationRequest) (*QueryLatestReserveAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestReserveAttestation not implemented")
+}
+func (*UnimplementedQueryServer) ReserveAttestationAll(ctx context.Context, req *QueryAllReserveAttestationRequest) (*QueryAllReserveAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveAttestationAll not implemented")
+}
+func (*UnimplementedQueryServer) SupplyCap(ctx context.Context, req *QueryGetSupplyCapRequest) (*QueryGetSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyCap not implemented")
+}
+func (*UnimplementedQueryServer) Quorum(ctx context.Context, req *QueryGetQuorumRequest) (*QueryGetQuorumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quorum not implemented")
+}
+func (*UnimplementedQueryServer) PendingOperation(ctx context.Context, req *QueryGetPendingOperationRequest) (*QueryGetPendingOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOperation not implemented")
+}
+func (*UnimplementedQueryServer) PendingOperationAll(ctx context.Context, req *QueryAllPendingOperationRequest) (*QueryAllPendingOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOperationAll not implemented")
+}
+func (*UnimplementedQueryServer) RoleChange(ctx context.Context, req *QueryGetRoleChangeRequest) (*QueryGetRoleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleChange not implemented")
+}
+func (*UnimplementedQueryServer) RoleChangeAll(ctx context.Context, req *QueryAllRoleChangeRequest) (*QueryAllRoleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleChangeAll not implemented")
+}
+func (*UnimplementedQueryServer) Guardian(ctx context.Context, req *QueryGetGuardianRequest) (*QueryGetGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardian not implemented")
+}
+func (*UnimplementedQueryServer) GuardianAll(ctx context.Context, req *QueryAllGuardianRequest) (*QueryAllGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianAll not implemented")
+}
+func (*UnimplementedQueryServer) AddressRoles(ctx context.Context, req *QueryAddressRolesRequest) (*QueryAddressRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressRoles not implemented")
+}
+func (*UnimplementedQueryServer) MinterStats(ctx context.Context, req *QueryGetMinterStatsRequest) (*QueryGetMinterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterStats not implemented")
+}
+func (*UnimplementedQueryServer) MinterStatsAll(ctx context.Context, req *QueryAllMinterStatsRequest) (*QueryAllMinterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterStatsAll not implemented")
+}
+func (*UnimplementedQueryServer) MintingTotals(ctx context.Context, req *QueryGetMintingTotalsRequest) (*QueryGetMintingTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingTotals not implemented")
+}
+func (*UnimplementedQueryServer) CheckTransfer(ctx context.Context, req *QueryCheckTransferRequest) (*QueryCheckTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTransfer not implemented")
+}
+func (*UnimplementedQueryServer) CheckMint(ctx context.Context, req *QueryCheckMintRequest) (*QueryCheckMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMint not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizationState(ctx context.Context, req *QueryGetAuthorizationStateRequest) (*QueryGetAuthorizationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizationState not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizationStateAll(ctx context.Context, req *QueryAllAuthorizationStateRequest) (*QueryAllAuthorizationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizationStateAll not implemented")
+}
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitAll(ctx context.Context, req *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannel(ctx context.Context, req *QueryGetAllowedChannelRequest) (*QueryGetAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannel not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannelAll(ctx context.Context, req *QueryAllAllowedChannelRequest) (*QueryAllAllowedChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannelAll not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistSyncChannel(ctx context.Context, req *QueryGetBlacklistSyncChannelRequest) (*QueryGetBlacklistSyncChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistSyncChannel not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistSyncChannelAll(ctx context.Context, req *QueryAllBlacklistSyncChannelRequest) (*QueryAllBlacklistSyncChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistSyncChannelAll not implemented")
+}
+func (*UnimplementedQueryServer) BridgeRoute(ctx context.Context, req *QueryGetBridgeRouteRequest) (*QueryGetBridgeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoute not implemented")
+}
+func (*UnimplementedQueryServer) BridgeRouteAll(ctx context.Context, req *QueryAllBridgeRouteRequest) (*QueryAllBridgeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRouteAll not implemented")
+}
+func (*UnimplementedQueryServer) BridgeMinter(ctx context.Context, req *QueryBridgeMinterRequest) (*QueryBridgeMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeMinter not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Blacklisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistedAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BlacklistedAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMasterMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MasterMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Minters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintersAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MintersAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Pauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Blacklister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Owner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MinterController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MinterControllerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerByMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterControllerByMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MinterControllerByMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerByMinter(ctx, req.(*QueryMinterControllerByMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintingDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MintingDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Redemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryGetRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RedemptionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionAll(ctx, req.(*QueryAllRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RedemptionsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionsByStatus(ctx, req.(*QueryRedemptionsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionsByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionsByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionsByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RedemptionsByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionsByHolder(ctx, req.(*QueryRedemptionsByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Attester",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attester(ctx, req.(*QueryGetAttesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestReserveAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestReserveAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestReserveAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/LatestReserveAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestReserveAttestation(ctx, req.(*QueryLatestReserveAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveAttestationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllReserveAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveAttestationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/ReserveAttestationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveAttestationAll(ctx, req.(*QueryAllReserveAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/SupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyCap(ctx, req.(*QueryGetSupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Quorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQuorumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Quorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Quorum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Quorum(ctx, req.(*QueryGetQuorumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/PendingOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOperation(ctx, req.(*QueryGetPendingOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOperationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOperationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/PendingOperationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOperationAll(ctx, req.(*QueryAllPendingOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRoleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RoleChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleChange(ctx, req.(*QueryGetRoleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleChangeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRoleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleChangeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RoleChangeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleChangeAll(ctx, req.(*QueryAllRoleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Guardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Guardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/Guardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Guardian(ctx, req.(*QueryGetGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/GuardianAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianAll(ctx, req.(*QueryAllGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/AddressRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressRoles(ctx, req.(*QueryAddressRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MinterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterStats(ctx, req.(*QueryGetMinterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterStatsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMinterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterStatsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MinterStatsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterStatsAll(ctx, req.(*QueryAllMinterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintingTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/MintingTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingTotals(ctx, req.(*QueryGetMintingTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/CheckTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckTransfer(ctx, req.(*QueryCheckTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/CheckMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckMint(ctx, req.(*QueryCheckMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuthorizationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/AuthorizationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizationState(ctx, req.(*QueryGetAuthorizationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizationStateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAuthorizationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizationStateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/AuthorizationStateAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizationStateAll(ctx, req.(*QueryAllAuthorizationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryGetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/RateLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitAll(ctx, req.(*QueryAllRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/AllowedChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannel(ctx, req.(*QueryGetAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowedChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/AllowedChannelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannelAll(ctx, req.(*QueryAllAllowedChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistSyncChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklistSyncChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistSyncChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BlacklistSyncChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistSyncChannel(ctx, req.(*QueryGetBlacklistSyncChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistSyncChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistSyncChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistSyncChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BlacklistSyncChannelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistSyncChannelAll(ctx, req.(*QueryAllBlacklistSyncChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBridgeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BridgeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoute(ctx, req.(*QueryGetBridgeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRouteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBridgeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRouteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BridgeRouteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRouteAll(ctx, req.(*QueryAllBridgeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hero.tokenfactory.Query/BridgeMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeMinter(ctx, req.(*QueryBridgeMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hero.tokenfactory.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Blacklisted",
+			Handler:    _Query_Blacklisted_Handler,
+		},
+		{
+			MethodName: "BlacklistedAll",
+			Handler:    _Query_BlacklistedAll_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "MasterMinter",
+			Handler:    _Query_MasterMinter_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "MintersAll",
+			Handler:    _Query_MintersAll_Handler,
+		},
+		{
+			MethodName: "Pauser",
+			Handler:    _Query_Pauser_Handler,
+		},
+		{
+			MethodName: "Blacklister",
+			Handler:    _Query_Blacklister_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "MinterController",
+			Handler:    _Query_MinterController_Handler,
+		},
+		{
+			MethodName: "MinterControllerAll",
+			Handler:    _Query_MinterControllerAll_Handler,
+		},
+		{
+			MethodName: "MinterControllerByMinter",
+			Handler:    _Query_MinterControllerByMinter_Handler,
+		},
+		{
+			MethodName: "MintingDenom",
+			Handler:    _Query_MintingDenom_Handler,
+		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+		{
+			MethodName: "RedemptionAll",
+			Handler:    _Query_RedemptionAll_Handler,
+		},
+		{
+			MethodName: "RedemptionsByStatus",
+			Handler:    _Query_RedemptionsByStatus_Handler,
+		},
+		{
+			MethodName: "RedemptionsByHolder",
+			Handler:    _Query_RedemptionsByHolder_Handler,
+		},
+		{
+			MethodName: "Attester",
+			Handler:    _Query_Attester_Handler,
+		},
+		{
+			MethodName: "LatestReserveAttestation",
+			Handler:    _Query_LatestReserveAttestation_Handler,
+		},
+		{
+			MethodName: "ReserveAttestationAll",
+			Handler:    _Query_ReserveAttestationAll_Handler,
+		},
+		{
+			MethodName: "SupplyCap",
+			Handler:    _Query_SupplyCap_Handler,
+		},
+		{
+			MethodName: "Quorum",
+			Handler:    _Query_Quorum_Handler,
+		},
+		{
+			MethodName: "PendingOperation",
+			Handler:    _Query_PendingOperation_Handler,
+		},
+		{
+			MethodName: "PendingOperationAll",
+			Handler:    _Query_PendingOperationAll_Handler,
+		},
+		{
+			MethodName: "RoleChange",
+			Handler:    _Query_RoleChange_Handler,
+		},
+		{
+			MethodName: "RoleChangeAll",
+			Handler:    _Query_RoleChangeAll_Handler,
+		},
+		{
+			MethodName: "Guardian",
+			Handler:    _Query_Guardian_Handler,
+		},
+		{
+			MethodName: "GuardianAll",
+			Handler:    _Query_GuardianAll_Handler,
+		},
+		{
+			MethodName: "AddressRoles",
+			Handler:    _Query_AddressRoles_Handler,
+		},
+		{
+			MethodName: "MinterStats",
+			Handler:    _Query_MinterStats_Handler,
+		},
+		{
+			MethodName: "MinterStatsAll",
+			Handler:    _Query_MinterStatsAll_Handler,
+		},
+		{
+			MethodName: "MintingTotals",
+			Handler:    _Query_MintingTotals_Handler,
+		},
+		{
+			MethodName: "CheckTransfer",
+			Handler:    _Query_CheckTransfer_Handler,
+		},
+		{
+			MethodName: "CheckMint",
+			Handler:    _Query_CheckMint_Handler,
+		},
+		{
+			MethodName: "AuthorizationState",
+			Handler:    _Query_AuthorizationState_Handler,
+		},
+		{
+			MethodName: "AuthorizationStateAll",
+			Handler:    _Query_AuthorizationStateAll_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimitAll",
+			Handler:    _Query_RateLimitAll_Handler,
+		},
+		{
+			MethodName: "AllowedChannel",
+			Handler:    _Query_AllowedChannel_Handler,
+		},
+		{
+			MethodName: "AllowedChannelAll",
+			Handler:    _Query_AllowedChannelAll_Handler,
+		},
+		{
+			MethodName: "BlacklistSyncChannel",
+			Handler:    _Query_BlacklistSyncChannel_Handler,
+		},
+		{
+			MethodName: "BlacklistSyncChannelAll",
+			Handler:    _Query_BlacklistSyncChannelAll_Handler,
+		},
+		{
+			MethodName: "BridgeRoute",
+			Handler:    _Query_BridgeRoute_Handler,
+		},
+		{
+			MethodName: "BridgeRouteAll",
+			Handler:    _Query_BridgeRouteAll_Handler,
+		},
+		{
+			MethodName: "BridgeMinter",
+			Handler:    _Query_BridgeMinter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tokenfactory/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blacklisted) > 0 {
+		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControllerAddress) > 0 {
+		i -= len(m.ControllerAddress)
+		copy(dAtA[i:], m.ControllerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterControllerByMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterControllerByMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterControllerByMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinterAddress) > 0 {
+		i -= len(m.MinterAddress)
+		copy(dAtA[i:], m.MinterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterControllerByMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterControllerByMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterControllerByMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemption) > 0 {
+		for iNdEx := len(m.Redemption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemption) > 0 {
+		for iNdEx := len(m.Redemption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsByHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsByHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsByHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionsByHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionsByHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionsByHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemption) > 0 {
+		for iNdEx := len(m.Redemption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttesterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttesterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttesterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attester.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestReserveAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestReserveAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestReserveAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestReserveAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestReserveAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestReserveAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReserveAttestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReserveAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReserveAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReserveAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReserveAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReserveAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReserveAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReserveAttestation) > 0 {
+		for iNdEx := len(m.ReserveAttestation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSupplyCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSupplyCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSupplyCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Headroom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQuorumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQuorumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQuorumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQuorumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQuorumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQuorumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingOperation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingOperation) > 0 {
+		for iNdEx := len(m.PendingOperation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOperation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRoleChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRoleChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRoleChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRoleChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRoleChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRoleChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RoleChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRoleChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRoleChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRoleChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRoleChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRoleChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRoleChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoleChange) > 0 {
+		for iNdEx := len(m.RoleChange) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleChange[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Guardian.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Guardian) > 0 {
+		for iNdEx := len(m.Guardian) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Guardian[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blacklisted {
+		i--
+		if m.Blacklisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ControlledMinter) > 0 {
+		i -= len(m.ControlledMinter)
+		copy(dAtA[i:], m.ControlledMinter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControlledMinter)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Controllers) > 0 {
+		for iNdEx := len(m.Controllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controllers[iNdEx])
+			copy(dAtA[i:], m.Controllers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Controllers[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Minter {
+		i--
+		if m.Minter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QuorumMember {
+		i--
+		if m.QuorumMember {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Guardian {
+		i--
+		if m.Guardian {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attester {
+		i--
+		if m.Attester {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Blacklister {
+		i--
+		if m.Blacklister {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pauser {
+		i--
+		if m.Pauser {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MasterMinter {
+		i--
+		if m.MasterMinter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Owner {
+		i--
+		if m.Owner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinterStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterStats) > 0 {
+		for iNdEx := len(m.MinterStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintingTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Path != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Path))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizationStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizationStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizationStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizer) > 0 {
+		i -= len(m.Authorizer)
+		copy(dAtA[i:], m.Authorizer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authorizer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizationStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizationStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizationStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorizationState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizationStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizationStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizationStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizationStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizationStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizationStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthorizationState) > 0 {
+		for iNdEx := len(m.AuthorizationState) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizationState[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimit) > 0 {
+		for iNdEx := len(m.RateLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowedChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowedChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowedChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedChannel) > 0 {
+		for iNdEx := len(m.AllowedChannel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistSyncChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistSyncChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistSyncChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistSyncChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistSyncChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistSyncChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlacklistSyncChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistSyncChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistSyncChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistSyncChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlacklistSyncChannel) > 0 {
+		for iNdEx := len(m.BlacklistSyncChannel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistSyncChannel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBridgeRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBridgeRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBridgeRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBridgeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBridgeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBridgeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeRoute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBridgeRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBridgeRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBridgeRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBridgeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBridgeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBridgeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeRoute) > 0 {
+		for iNdEx := len(m.BridgeRoute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeRoute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blacklisted) > 0 {
+		for _, e := range m.Blacklisted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paused.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMasterMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetMasterMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MasterMinter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPauserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pauser.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBlacklisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetBlacklisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklister.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Owner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ControllerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinterController.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterControllerByMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterControllerByMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMintingDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetMintingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemption) > 0 {
+		for _, e := range m.Redemption {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemption) > 0 {
+		for _, e := range m.Redemption {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsByHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionsByHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemption) > 0 {
+		for _, e := range m.Redemption {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttesterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetAttesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attester.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestReserveAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestReserveAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReserveAttestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllReserveAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllReserveAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReserveAttestation) > 0 {
+		for _, e := range m.ReserveAttestation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSupplyCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetSupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetQuorumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetQuorumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPendingOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingOperation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingOperation) > 0 {
+		for _, e := range m.PendingOperation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRoleChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetRoleChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoleChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRoleChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRoleChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleChange) > 0 {
+		for _, e := range m.RoleChange {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Guardian.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGuardianRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		for _, e := range m.Guardian {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Owner {
+		n += 2
+	}
+	if m.MasterMinter {
+		n += 2
+	}
+	if m.Pauser {
+		n += 2
+	}
+	if m.Blacklister {
+		n += 2
+	}
+	if m.Attester {
+		n += 2
+	}
+	if m.Guardian {
+		n += 2
+	}
+	if m.QuorumMember {
+		n += 2
+	}
+	if m.Minter {
+		n += 2
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Controllers) > 0 {
+		for _, s := range m.Controllers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ControlledMinter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Blacklisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetMinterStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinterStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMinterStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMinterStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterStats) > 0 {
+		for _, e := range m.MinterStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintingTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetMintingTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingTotals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Path != 0 {
+		n += 1 + sovQuery(uint64(m.Path))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuthorizationStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authorizer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuthorizationStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorizationState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAuthorizationStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAuthorizationStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizationState) > 0 {
+		for _, e := range m.AuthorizationState {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *QueryGetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimit) > 0 {
+		for _, e := range m.RateLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAllowedChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAllowedChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannel) > 0 {
+		for _, e := range m.AllowedChannel {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklistSyncChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklistSyncChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlacklistSyncChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBlacklistSyncChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistSyncChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlacklistSyncChannel) > 0 {
+		for _, e := range m.BlacklistSyncChannel {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBridgeRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBridgeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BridgeRoute.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBridgeRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBridgeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeRoute) > 0 {
+		for _, e := range m.BridgeRoute {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
+			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minters{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterControllerByMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterControllerByMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterControllerByMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterControllerByMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterControllerByMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterControllerByMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemption = append(m.Redemption, Redemption{})
+			if err := m.Redemption[len(m.Redemption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemption = append(m.Redemption, Redemption{})
+			if err := m.Redemption[len(m.Redemption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsByHolderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsByHolderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsByHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionsByHolderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionsByHolderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionsByHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemption = append(m.Redemption, Redemption{})
+			if err := m.Redemption[len(m.Redemption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttesterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttesterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestReserveAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestReserveAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestReserveAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestReserveAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestReserveAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestReserveAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveAttestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReserveAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReserveAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReserveAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReserveAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReserveAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReserveAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestation = append(m.ReserveAttestation, ReserveAttestation{})
+			if err := m.ReserveAttestation[len(m.ReserveAttestation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSupplyCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSupplyCapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSupplyCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSupplyCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSupplyCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQuorumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQuorumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQuorumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQuorumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQuorumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQuorumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOperation = append(m.PendingOperation, PendingOperation{})
+			if err := m.PendingOperation[len(m.PendingOperation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRoleChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRoleChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRoleChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRoleChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRoleChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRoleChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoleChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRoleChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRoleChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRoleChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRoleChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRoleChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRoleChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleChange = append(m.RoleChange, RoleChange{})
+			if err := m.RoleChange[len(m.RoleChange)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Guardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = append(m.Guardian, Guardian{})
+			if err := m.Guardian[len(m.Guardian)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Owner = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MasterMinter = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pauser = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blacklister = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attester = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Guardian = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMember", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumMember = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Minter = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Coin{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controllers = append(m.Controllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlledMinter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlledMinter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blacklisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinterStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterStats = append(m.MinterStats, MinterStats{})
+			if err := m.MinterStats[len(m.MinterStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			m.Path = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Path |= TransferPath(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= CheckReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetMintingDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetMintingDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MintingDenom.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckMintRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2971,12 +17333,108 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckMintRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2998,7 +17456,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3021,17 +17479,56 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= CheckReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3041,24 +17538,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3081,7 +17577,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAuthorizationStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,19 +17596,51 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuthorizationStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuthorizationStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3140,7 +17668,7 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3163,7 +17691,7 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAuthorizationStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3186,15 +17714,15 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAuthorizationStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAuthorizationStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3221,7 +17749,7 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthorizationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3246,7 +17774,7 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizationStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3269,10 +17797,10 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizationStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizationStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3332,7 +17860,7 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizationStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,15 +17883,15 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizationStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizationStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3390,8 +17918,8 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
-			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuthorizationState = append(m.AuthorizationState, AuthorizationState{})
+			if err := m.AuthorizationState[len(m.AuthorizationState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3452,7 +17980,7 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3475,12 +18003,63 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= RateLimitDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3502,7 +18081,7 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3525,15 +18104,15 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3560,7 +18139,106 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3585,7 +18263,7 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,12 +18286,48 @@ func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3635,7 +18349,7 @@ func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3654,19 +18368,53 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimit = append(m.RateLimit, RateLimit{})
+			if err := m.RateLimit[len(m.RateLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3693,7 +18441,10 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3718,7 +18469,7 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3741,15 +18492,15 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3777,7 +18528,7 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3800,7 +18551,7 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowedChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3823,15 +18574,15 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowedChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3858,7 +18609,7 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3883,7 +18634,7 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowedChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3906,10 +18657,10 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowedChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowedChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3969,7 +18720,7 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowedChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3992,15 +18743,15 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowedChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowedChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4027,8 +18778,8 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minters = append(m.Minters, Minters{})
-			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedChannel = append(m.AllowedChannel, AllowedChannel{})
+			if err := m.AllowedChannel[len(m.AllowedChannel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4089,7 +18840,7 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlacklistSyncChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,12 +18863,44 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlacklistSyncChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlacklistSyncChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4139,7 +18922,7 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlacklistSyncChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,15 +18945,15 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlacklistSyncChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlacklistSyncChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistSyncChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4197,7 +18980,7 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlacklistSyncChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4222,57 +19005,7 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlacklistSyncChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4295,15 +19028,15 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlacklistSyncChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlacklistSyncChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4330,7 +19063,10 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4355,7 +19091,7 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlacklistSyncChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4378,65 +19114,49 @@ func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlacklistSyncChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlacklistSyncChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistSyncChannel", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlacklistSyncChannel = append(m.BlacklistSyncChannel, BlacklistSyncChannel{})
+			if err := m.BlacklistSyncChannel[len(m.BlacklistSyncChannel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4463,7 +19183,10 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4488,7 +19211,7 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4511,15 +19234,15 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4547,7 +19270,7 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4570,7 +19293,7 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBridgeRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4593,15 +19316,15 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBridgeRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBridgeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRoute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4628,7 +19351,7 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BridgeRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4653,7 +19376,7 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBridgeRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4676,10 +19399,10 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBridgeRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBridgeRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4739,7 +19462,7 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBridgeRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4762,15 +19485,15 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBridgeRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBridgeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRoute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4797,8 +19520,8 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterController = append(m.MinterController, MinterController{})
-			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeRoute = append(m.BridgeRoute, BridgeRoute{})
+			if err := m.BridgeRoute[len(m.BridgeRoute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4859,7 +19582,7 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeMinterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4882,10 +19605,10 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeMinterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4909,7 +19632,7 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeMinterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4932,17 +19655,17 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeMinterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4952,24 +19675,23 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,22 @@
+package types
+
+// IsMember returns true if the address belongs to the quorum member set.
+func (q Quorum) IsMember(address string) bool {
+	for _, member := range q.Members {
+		if member == address {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals given by current quorum members.
+func (q Quorum) CountApprovals(approvals []string) uint64 {
+	var count uint64
+	for _, approval := range approvals {
+		if q.IsMember(approval) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/quorum.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Quorum is the set of members that must approve privileged operations before they are executed.
+type Quorum struct {
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// number of member approvals required to execute an operation
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// how long an operation stays pending before it expires
+	Expiry time.Duration `protobuf:"bytes,3,opt,name=expiry,proto3,stdduration" json:"expiry"`
+	// mints above this amount require approval, mints are never gated when unset
+	MintThreshold *types.Coin `protobuf:"bytes,4,opt,name=mintThreshold,proto3" json:"mintThreshold,omitempty"`
+}
+
+func (m *Quorum) Reset()         { *m = Quorum{} }
+func (m *Quorum) String() string { return proto.CompactTextString(m) }
+func (*Quorum) ProtoMessage()    {}
+func (*Quorum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652abe9ba547dfc8, []int{0}
+}
+func (m *Quorum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quorum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quorum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quorum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quorum.Merge(m, src)
+}
+func (m *Quorum) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quorum) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quorum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quorum proto.InternalMessageInfo
+
+func (m *Quorum) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Quorum) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Quorum) GetExpiry() time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Quorum) GetMintThreshold() *types.Coin {
+	if m != nil {
+		return m.MintThreshold
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Quorum)(nil), "hero.tokenfactory.Quorum")
+}
+
+func init() { proto.RegisterFile("tokenfactory/quorum.proto", fileDescriptor_652abe9ba547dfc8) }
+
+var fileDescriptor_652abe9ba547dfc8 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4f, 0x02, 0x31,
+	0x18, 0xc6, 0xaf, 0x42, 0x50, 0xce, 0x38, 0x78, 0x71, 0x38, 0x88, 0x29, 0xc4, 0x89, 0xc5, 0x36,
+	0xe8, 0x64, 0x1c, 0x4c, 0xd0, 0x2f, 0xe0, 0xe9, 0xe4, 0x76, 0x77, 0xbc, 0xdc, 0x35, 0xd2, 0xbe,
+	0xd8, 0x3f, 0x04, 0xbe, 0x85, 0xa3, 0x9f, 0xc6, 0x99, 0x91, 0xd1, 0x49, 0x0d, 0x7c, 0x11, 0xc3,
+	0x71, 0xa7, 0xb2, 0xf5, 0xed, 0xef, 0xe9, 0xd3, 0x5f, 0x5a, 0xbf, 0x65, 0xf1, 0x19, 0xd4, 0x28,
+	0x4e, 0x2d, 0xea, 0x39, 0x7f, 0x71, 0xa8, 0x9d, 0x64, 0x13, 0x8d, 0x16, 0x83, 0xe3, 0x1c, 0x34,
+	0xb2, 0xff, 0xbc, 0x7d, 0x92, 0x61, 0x86, 0x05, 0xe5, 0x9b, 0xd5, 0x36, 0xd8, 0xa6, 0x19, 0x62,
+	0x36, 0x06, 0x5e, 0x4c, 0x89, 0x1b, 0xf1, 0xa1, 0xd3, 0xb1, 0x15, 0xa8, 0x2a, 0x9e, 0xa2, 0x91,
+	0x68, 0x78, 0x12, 0x1b, 0xe0, 0xd3, 0x7e, 0x02, 0x36, 0xee, 0xf3, 0x14, 0x45, 0xc9, 0xcf, 0xde,
+	0x89, 0xdf, 0xb8, 0x2f, 0x6e, 0x0e, 0x42, 0x7f, 0x5f, 0x82, 0x4c, 0x40, 0x9b, 0x90, 0x74, 0x6b,
+	0xbd, 0x66, 0x54, 0x8d, 0xc1, 0xa9, 0xdf, 0xb4, 0xb9, 0x06, 0x93, 0xe3, 0x78, 0x18, 0xee, 0x75,
+	0x49, 0xaf, 0x1e, 0xfd, 0x6d, 0x04, 0xd7, 0x7e, 0x03, 0x66, 0x13, 0xa1, 0xe7, 0x61, 0xad, 0x4b,
+	0x7a, 0x87, 0x17, 0x2d, 0xb6, 0x75, 0x62, 0x95, 0x13, 0xbb, 0x2b, 0x9d, 0x06, 0x07, 0x8b, 0xcf,
+	0x8e, 0xf7, 0xf6, 0xd5, 0x21, 0x51, 0x79, 0x24, 0xb8, 0xf1, 0x8f, 0xa4, 0x50, 0xf6, 0xf1, 0xb7,
+	0xbe, 0x5e, 0x76, 0x6c, 0xbd, 0xd9, 0xc6, 0x9b, 0x95, 0xde, 0xec, 0x16, 0x85, 0x8a, 0x76, 0xf3,
+	0x83, 0x87, 0xc5, 0x8a, 0x92, 0xe5, 0x8a, 0x92, 0xef, 0x15, 0x25, 0xaf, 0x6b, 0xea, 0x2d, 0xd7,
+	0xd4, 0xfb, 0x58, 0x53, 0xef, 0xe9, 0x2a, 0x13, 0x36, 0x77, 0x09, 0x4b, 0x51, 0x72, 0x63, 0x75,
+	0xac, 0x32, 0x18, 0xe3, 0x14, 0xce, 0xa7, 0xa0, 0xac, 0xd3, 0x60, 0xf8, 0xe6, 0x8d, 0xf9, 0x8c,
+	0xef, 0xfc, 0x82, 0x9d, 0x4f, 0xc0, 0x24, 0x8d, 0x42, 0xfd, 0xf2, 0x67, 0x00, 0xe5, 0xe9, 0x21,
+	0xe4, 0xa2, 0x01, 0x00, 0x00,
+}
+
+func (m *Quorum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintThreshold != nil {
+		{
+			size, err := m.MintThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuorum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuorum(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Threshold != 0 {
+		i = encodeVarintQuorum(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuorum(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuorum(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuorum(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Quorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuorum(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuorum(uint64(m.Threshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Expiry)
+	n += 1 + l + sovQuorum(uint64(l))
+	if m.MintThreshold != nil {
+		l = m.MintThreshold.Size()
+		n += 1 + l + sovQuorum(uint64(l))
+	}
+	return n
+}
+
+func sovQuorum(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuorum(x uint64) (n int) {
+	return sovQuorum(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Quorum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuorum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quorum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quorum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintThreshold == nil {
+				m.MintThreshold = &types.Coin{}
+			}
+			if err := m.MintThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuorum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuorum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuorum(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuorum
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuorum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuorum
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuorum
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuorum
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuorum        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuorum          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuorum = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ParseRateLimitDirection parses the inflow and outflow CLI arguments
+func ParseRateLimitDirection(direction string) (RateLimitDirection, error) {
+	switch direction {
+	case "inflow":
+		return RateLimitInflow, nil
+	case "outflow":
+		return RateLimitOutflow, nil
+	default:
+		return RateLimitInflow, fmt.Errorf("invalid rate limit direction %s, expected inflow or outflow", direction)
+	}
+}
+
+// ValidateRateLimit checks the configuration of a rate limit shared by the messages, the proposals and the genesis
+func ValidateRateLimit(channelId string, direction RateLimitDirection, maxAmount sdk.Int, maxSupplyFraction sdk.Dec, window time.Duration) error {
+	if err := host.ChannelIdentifierValidator(channelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", err)
+	}
+	if _, ok := RateLimitDirection_name[int32(direction)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid rate limit direction %d", direction)
+	}
+	if maxAmount.IsNil() || maxAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max amount must not be negative")
+	}
+	if maxSupplyFraction.IsNil() || maxSupplyFraction.IsNegative() || maxSupplyFraction.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max supply fraction must be between 0 and 1")
+	}
+	if maxAmount.IsZero() && maxSupplyFraction.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max amount or max supply fraction must be set")
+	}
+	if window <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "window must be positive")
+	}
+	return nil
+}
+
+// Validate performs the stateless checks of a stored rate limit
+func (r RateLimit) Validate() error {
+	if err := ValidateRateLimit(r.ChannelId, r.Direction, r.MaxAmount, r.MaxSupplyFraction, r.Window); err != nil {
+		return err
+	}
+	if !r.Flow.IsNil() && r.Flow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "flow must not be negative")
+	}
+	return nil
+}
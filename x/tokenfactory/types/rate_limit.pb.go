@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateLimitDirection enumerates which flow of the minting denom through a channel is limited.
+type RateLimitDirection int32
+
+const (
+	// tokens received from the counterparty chain
+	RateLimitInflow RateLimitDirection = 0
+	// tokens sent to the counterparty chain
+	RateLimitOutflow RateLimitDirection = 1
+)
+
+var RateLimitDirection_name = map[int32]string{
+	0: "RATE_LIMIT_DIRECTION_INFLOW",
+	1: "RATE_LIMIT_DIRECTION_OUTFLOW",
+}
+
+var RateLimitDirection_value = map[string]int32{
+	"RATE_LIMIT_DIRECTION_INFLOW":  0,
+	"RATE_LIMIT_DIRECTION_OUTFLOW": 1,
+}
+
+func (x RateLimitDirection) String() string {
+	return proto.EnumName(RateLimitDirection_name, int32(x))
+}
+
+func (RateLimitDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74901fb871667bbe, []int{0}
+}
+
+// RateLimit caps the amount of the minting denom moving through an IBC channel in one
+// direction during a window. When both quotas are set the lower one applies.
+type RateLimit struct {
+	ChannelId string             `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Direction RateLimitDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=hero.tokenfactory.RateLimitDirection" json:"direction,omitempty"`
+	// absolute quota per window, zero for none
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxAmount"`
+	// quota per window as a fraction of the minting denom supply, e.g. 0.05 for 5%, zero for none
+	MaxSupplyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maxSupplyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxSupplyFraction"`
+	Window            time.Duration                          `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+	// amount that moved through the channel since the window started
+	Flow        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=flow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"flow"`
+	WindowStart time.Time                              `protobuf:"bytes,7,opt,name=windowStart,proto3,stdtime" json:"windowStart"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74901fb871667bbe, []int{0}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimit) GetDirection() RateLimitDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return RateLimitInflow
+}
+
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimit) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("hero.tokenfactory.RateLimitDirection", RateLimitDirection_name, RateLimitDirection_value)
+	proto.RegisterType((*RateLimit)(nil), "hero.tokenfactory.RateLimit")
+}
+
+func init() { proto.RegisterFile("tokenfactory/rate_limit.proto", fileDescriptor_74901fb871667bbe) }
+
+var fileDescriptor_74901fb871667bbe = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x33, 0xb6, 0xae, 0x66, 0x0a, 0xda, 0x8e, 0x3d, 0xc4, 0x58, 0xb3, 0x41, 0x50, 0x16,
+	0xa1, 0x13, 0xa8, 0x22, 0x88, 0xa7, 0x6e, 0xb7, 0x0b, 0x81, 0xb5, 0x0b, 0xd9, 0x88, 0x20, 0xc2,
+	0x32, 0x9b, 0xcc, 0x66, 0x43, 0x93, 0x99, 0x30, 0x99, 0x74, 0x77, 0xbf, 0x41, 0xe9, 0xa9, 0x47,
+	0x2f, 0x3d, 0xf9, 0x65, 0x7a, 0xec, 0x51, 0x3c, 0x54, 0xd9, 0xfd, 0x22, 0x92, 0x64, 0xff, 0xe9,
+	0x7a, 0xd1, 0x53, 0xfe, 0xbc, 0xef, 0xef, 0x79, 0x9f, 0x3c, 0x6f, 0x06, 0x3e, 0x95, 0xfc, 0x94,
+	0xb2, 0x3e, 0xf1, 0x24, 0x17, 0x63, 0x4b, 0x10, 0x49, 0xbb, 0x51, 0x18, 0x87, 0x12, 0x27, 0x82,
+	0x4b, 0x8e, 0x76, 0x06, 0x54, 0x70, 0xbc, 0xda, 0xa3, 0xef, 0x06, 0x3c, 0xe0, 0x45, 0xd5, 0xca,
+	0xef, 0xca, 0x46, 0xdd, 0x08, 0x38, 0x0f, 0x22, 0x6a, 0x15, 0x4f, 0xbd, 0xac, 0x6f, 0xf9, 0x99,
+	0x20, 0x32, 0xe4, 0x6c, 0x56, 0xaf, 0xfe, 0x59, 0x97, 0x61, 0x4c, 0x53, 0x49, 0xe2, 0xa4, 0x6c,
+	0x78, 0x36, 0xdd, 0x80, 0xaa, 0x43, 0x24, 0x6d, 0xe5, 0xd3, 0xd1, 0x1e, 0x54, 0xbd, 0x01, 0x61,
+	0x8c, 0x46, 0xb6, 0xaf, 0x01, 0x13, 0xd4, 0x54, 0x67, 0xf9, 0x02, 0x1d, 0x41, 0xd5, 0x0f, 0x05,
+	0xf5, 0x72, 0x7d, 0xed, 0x8e, 0x09, 0x6a, 0x0f, 0x0e, 0x9e, 0xe3, 0x35, 0xa7, 0x78, 0x21, 0xd7,
+	0x98, 0x37, 0x3b, 0x4b, 0x0e, 0xb5, 0xa0, 0x1a, 0x93, 0xd1, 0x61, 0xcc, 0x33, 0x26, 0xb5, 0x8d,
+	0x7c, 0x44, 0x1d, 0x5f, 0xdf, 0x56, 0x95, 0xef, 0xb7, 0xd5, 0x17, 0x41, 0x28, 0x07, 0x59, 0x0f,
+	0x7b, 0x3c, 0xb6, 0x3c, 0x9e, 0xc6, 0x3c, 0x9d, 0x5d, 0xf6, 0x53, 0xff, 0xd4, 0x92, 0xe3, 0x84,
+	0xa6, 0xd8, 0x66, 0xd2, 0x59, 0x0a, 0xa0, 0xcf, 0x70, 0x27, 0x26, 0xa3, 0x4e, 0x96, 0x24, 0xd1,
+	0xb8, 0x29, 0x48, 0x69, 0x6d, 0xf3, 0x9f, 0x55, 0x1b, 0xd4, 0x73, 0xd6, 0x85, 0xd0, 0x3b, 0x58,
+	0x19, 0x86, 0xcc, 0xe7, 0x43, 0xed, 0xae, 0x09, 0x6a, 0x5b, 0x07, 0x8f, 0x71, 0x19, 0x27, 0x9e,
+	0xc7, 0x89, 0x1b, 0xb3, 0xb8, 0xeb, 0xf7, 0xf3, 0x69, 0x5f, 0x7e, 0x54, 0x81, 0x33, 0x43, 0x50,
+	0x1d, 0x6e, 0xf6, 0x23, 0x3e, 0xd4, 0x2a, 0xff, 0xf5, 0x8d, 0x05, 0x8b, 0x9a, 0x70, 0xab, 0x54,
+	0xeb, 0x48, 0x22, 0xa4, 0x76, 0xaf, 0x70, 0xa1, 0xaf, 0xb9, 0x70, 0xe7, 0x4b, 0x2d, 0x6d, 0x5c,
+	0xe6, 0x36, 0x56, 0xc1, 0x97, 0xe7, 0x00, 0xa2, 0xf5, 0xb5, 0xa0, 0xd7, 0xf0, 0x89, 0x73, 0xe8,
+	0x1e, 0x77, 0x5b, 0xf6, 0x7b, 0xdb, 0xed, 0x36, 0x6c, 0xe7, 0xf8, 0xc8, 0xb5, 0xdb, 0x27, 0x5d,
+	0xfb, 0xa4, 0xd9, 0x6a, 0x7f, 0xdc, 0x56, 0xf4, 0x47, 0x17, 0x57, 0xe6, 0xc3, 0x05, 0x68, 0xb3,
+	0xc2, 0xd4, 0x1b, 0xb8, 0xf7, 0x57, 0xaa, 0xfd, 0xc1, 0x2d, 0x30, 0xa0, 0xef, 0x5e, 0x5c, 0x99,
+	0xdb, 0x0b, 0xac, 0x9d, 0xc9, 0x9c, 0xd3, 0x37, 0xcf, 0xbf, 0x1a, 0x4a, 0xbd, 0x73, 0x3d, 0x31,
+	0xc0, 0xcd, 0xc4, 0x00, 0x3f, 0x27, 0x06, 0xb8, 0x9c, 0x1a, 0xca, 0xcd, 0xd4, 0x50, 0xbe, 0x4d,
+	0x0d, 0xe5, 0xd3, 0xdb, 0x95, 0x68, 0x52, 0x29, 0x08, 0x0b, 0x68, 0xc4, 0xcf, 0xe8, 0xfe, 0x19,
+	0x65, 0x32, 0x13, 0x34, 0xb5, 0xf2, 0x5f, 0xcd, 0x1a, 0x59, 0xbf, 0x1d, 0x9d, 0x22, 0xb1, 0x5e,
+	0xa5, 0x88, 0xe2, 0xd5, 0xaf, 0x01, 0x00, 0x76, 0x7d, 0x5e, 0x8c, 0x57, 0x03, 0x00, 0x00,
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRateLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Flow.Size()
+		i -= size
+		if _, err := m.Flow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRateLimit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSupplyFraction.Size()
+		i -= size
+		if _, err := m.MaxSupplyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Direction != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovRateLimit(uint64(m.Direction))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.MaxSupplyFraction.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= RateLimitDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupplyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/redemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RedemptionStatus enumerates the lifecycle states of a redemption request.
+type RedemptionStatus int32
+
+const (
+	// the escrowed tokens are waiting for the issuer to act
+	RedemptionPending RedemptionStatus = 0
+	// the escrowed tokens have been burned
+	RedemptionFulfilled RedemptionStatus = 1
+	// the escrowed tokens have been refunded to the holder
+	RedemptionRejected RedemptionStatus = 2
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_PENDING",
+	1: "REDEMPTION_STATUS_FULFILLED",
+	2: "REDEMPTION_STATUS_REJECTED",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_PENDING":   0,
+	"REDEMPTION_STATUS_FULFILLED": 1,
+	"REDEMPTION_STATUS_REJECTED":  2,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+
+type Redemption struct {
+	Id     uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Holder string           `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount types.Coin       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Status RedemptionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=hero.tokenfactory.RedemptionStatus" json:"status,omitempty"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57d77d93e0faa2b, []int{0}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redemption) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Redemption) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Redemption) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RedemptionPending
+}
+
+func init() {
+	proto.RegisterEnum("hero.tokenfactory.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
+	proto.RegisterType((*Redemption)(nil), "hero.tokenfactory.Redemption")
+}
+
+func init() { proto.RegisterFile("tokenfactory/redemption.proto", fileDescriptor_c57d77d93e0faa2b) }
+
+var fileDescriptor_c57d77d93e0faa2b = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4d, 0x6f, 0x12, 0x41,
+	0x18, 0xc7, 0x77, 0x90, 0x90, 0x38, 0x26, 0x0d, 0x1d, 0xb5, 0xd2, 0x35, 0xae, 0x1b, 0xbd, 0x10,
+	0x13, 0x67, 0xd2, 0x6a, 0x7c, 0x89, 0x27, 0x5b, 0x16, 0x83, 0x41, 0x24, 0x03, 0xbd, 0x78, 0x69,
+	0x96, 0xdd, 0xa7, 0xcb, 0xe8, 0x32, 0x0f, 0xd9, 0x99, 0x25, 0xf6, 0x1b, 0x18, 0x4e, 0x7e, 0x01,
+	0x4e, 0xde, 0xfc, 0x14, 0x1e, 0x7b, 0xec, 0xd1, 0x93, 0x31, 0xf0, 0x45, 0x0c, 0xb0, 0x11, 0x2d,
+	0xb7, 0x99, 0x3c, 0xbf, 0xdf, 0xf3, 0x9f, 0x97, 0x87, 0xde, 0xb3, 0xf8, 0x09, 0xf4, 0x59, 0x18,
+	0x59, 0xcc, 0xce, 0x45, 0x06, 0x31, 0x8c, 0xc6, 0x56, 0xa1, 0xe6, 0xe3, 0x0c, 0x2d, 0xb2, 0xdd,
+	0x21, 0x64, 0xc8, 0xff, 0x65, 0xdc, 0x5b, 0x09, 0x26, 0xb8, 0xaa, 0x8a, 0xe5, 0x6a, 0x0d, 0xba,
+	0x5e, 0x84, 0x66, 0x84, 0x46, 0x0c, 0x42, 0x03, 0x62, 0x72, 0x30, 0x00, 0x1b, 0x1e, 0x88, 0x08,
+	0x55, 0xd1, 0xe8, 0xc1, 0x77, 0x42, 0xa9, 0xfc, 0xdb, 0x9d, 0xed, 0xd0, 0x92, 0x8a, 0x6b, 0xc4,
+	0x27, 0xf5, 0xb2, 0x2c, 0xa9, 0x98, 0xed, 0xd1, 0xca, 0x10, 0xd3, 0x18, 0xb2, 0x5a, 0xc9, 0x27,
+	0xf5, 0xeb, 0xb2, 0xd8, 0xb1, 0xe7, 0xb4, 0x12, 0x8e, 0x30, 0xd7, 0xb6, 0x76, 0xcd, 0x27, 0xf5,
+	0x1b, 0x87, 0xfb, 0x7c, 0x9d, 0xc3, 0x97, 0x39, 0xbc, 0xc8, 0xe1, 0xc7, 0xa8, 0xf4, 0x51, 0xf9,
+	0xe2, 0xd7, 0x7d, 0x47, 0x16, 0x38, 0x7b, 0x45, 0x2b, 0xc6, 0x86, 0x36, 0x37, 0xb5, 0xb2, 0x4f,
+	0xea, 0x3b, 0x87, 0x0f, 0xf9, 0xd6, 0x4d, 0xf8, 0xe6, 0x3c, 0xbd, 0x15, 0x2a, 0x0b, 0xe5, 0xd1,
+	0x0f, 0x42, 0xab, 0x57, 0x8b, 0xec, 0x29, 0xdd, 0x97, 0x41, 0x23, 0x78, 0xd7, 0xed, 0xb7, 0xde,
+	0x77, 0x4e, 0x7b, 0xfd, 0xd7, 0xfd, 0x93, 0xde, 0x69, 0x37, 0xe8, 0x34, 0x5a, 0x9d, 0x37, 0x55,
+	0xc7, 0xbd, 0x3d, 0x9d, 0xf9, 0xbb, 0x1b, 0xa9, 0x0b, 0x3a, 0x56, 0x3a, 0x61, 0x2f, 0xe8, 0xdd,
+	0x6d, 0xab, 0x79, 0xd2, 0x6e, 0xb6, 0xda, 0xed, 0xa0, 0x51, 0x25, 0xee, 0x9d, 0xe9, 0xcc, 0xbf,
+	0xb9, 0xf1, 0x9a, 0x79, 0x7a, 0xa6, 0xd2, 0x14, 0x62, 0xf6, 0x8c, 0xba, 0xdb, 0xa6, 0x0c, 0xde,
+	0x06, 0xc7, 0xfd, 0xa0, 0x51, 0x2d, 0xb9, 0x7b, 0xd3, 0x99, 0xcf, 0x36, 0xa2, 0x84, 0x8f, 0x10,
+	0x59, 0x88, 0xdd, 0xf2, 0x97, 0x6f, 0x9e, 0x73, 0xd4, 0xbb, 0x98, 0x7b, 0xe4, 0x72, 0xee, 0x91,
+	0xdf, 0x73, 0x8f, 0x7c, 0x5d, 0x78, 0xce, 0xe5, 0xc2, 0x73, 0x7e, 0x2e, 0x3c, 0xe7, 0xc3, 0xcb,
+	0x44, 0xd9, 0x61, 0x3e, 0xe0, 0x11, 0x8e, 0x84, 0xb1, 0x59, 0xa8, 0x13, 0x48, 0x71, 0x02, 0x8f,
+	0x27, 0xa0, 0x6d, 0x9e, 0x81, 0x11, 0xcb, 0x87, 0x12, 0x9f, 0xc5, 0x7f, 0x83, 0x61, 0xcf, 0xc7,
+	0x60, 0x06, 0x95, 0xd5, 0x5f, 0x3e, 0xf9, 0x33, 0x00, 0x68, 0x38, 0xfa, 0x27, 0x35, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRedemption(uint64(m.Id))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRedemption(uint64(m.Status))
+	}
+	return n
+}
+
+func sovRedemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemption(x uint64) (n int) {
+	return sovRedemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemption = fmt.Errorf("proto: unexpected end of group")
+)
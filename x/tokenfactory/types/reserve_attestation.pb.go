@@ -0,0 +1,616 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/reserve_attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReserveAttestation records an off-chain proof of reserves backing the minting denom.
+type ReserveAttestation struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attester string `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	// reserves held by the issuer, denominated in the minting denom
+	Reserves types.Coin `protobuf:"bytes,3,opt,name=reserves,proto3" json:"reserves"`
+	// time at which the reserves were attested by the auditor
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Auditor   string    `protobuf:"bytes,5,opt,name=auditor,proto3" json:"auditor,omitempty"`
+	// hex encoded hash of the published attestation document
+	DocumentHash string `protobuf:"bytes,6,opt,name=documentHash,proto3" json:"documentHash,omitempty"`
+	// block height at which the attestation was submitted
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5eb455a6c3d209, []int{0}
+}
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetReserves() types.Coin {
+	if m != nil {
+		return m.Reserves
+	}
+	return types.Coin{}
+}
+
+func (m *ReserveAttestation) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ReserveAttestation) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReserveAttestation)(nil), "hero.tokenfactory.ReserveAttestation")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/reserve_attestation.proto", fileDescriptor_4c5eb455a6c3d209)
+}
+
+var fileDescriptor_4c5eb455a6c3d209 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x3d, 0x6f, 0xd4, 0x40,
+	0x10, 0xbd, 0x75, 0x8e, 0xcb, 0x65, 0x41, 0x48, 0xac, 0x10, 0x5a, 0x5c, 0xf8, 0xac, 0x14, 0xc8,
+	0x0d, 0xbb, 0x0a, 0x54, 0x88, 0x0a, 0xd3, 0x50, 0x1b, 0x2a, 0x1a, 0xb4, 0xb6, 0x27, 0xf6, 0x8a,
+	0xd8, 0x73, 0xda, 0x1d, 0x5b, 0xe4, 0x5f, 0xa4, 0xe3, 0x2f, 0xa5, 0x4c, 0x49, 0x05, 0xe8, 0xee,
+	0x8f, 0x20, 0x7f, 0xdc, 0x85, 0x74, 0xfb, 0x76, 0xde, 0x9b, 0xf7, 0x9e, 0x86, 0xbf, 0x22, 0xfc,
+	0x0e, 0xed, 0xa5, 0x29, 0x08, 0xdd, 0xb5, 0x76, 0xe0, 0xc1, 0xf5, 0xf0, 0xcd, 0x10, 0x81, 0x27,
+	0x43, 0x16, 0x5b, 0xb5, 0x75, 0x48, 0x28, 0x9e, 0xd5, 0xe0, 0x50, 0xfd, 0x4f, 0x0e, 0x9f, 0x57,
+	0x58, 0xe1, 0x38, 0xd5, 0xc3, 0x6b, 0x22, 0x86, 0x9b, 0x0a, 0xb1, 0xba, 0x02, 0x3d, 0xa2, 0xbc,
+	0xbb, 0xd4, 0x64, 0x9b, 0x61, 0x57, 0xb3, 0x9d, 0x09, 0x51, 0x81, 0xbe, 0x41, 0xaf, 0x73, 0xe3,
+	0x41, 0xf7, 0x17, 0x39, 0x90, 0xb9, 0xd0, 0x05, 0xda, 0xd9, 0xe9, 0xfc, 0x67, 0xc0, 0x45, 0x36,
+	0xe5, 0xf8, 0x70, 0x1f, 0x43, 0x3c, 0xe5, 0x81, 0x2d, 0x25, 0x8b, 0x59, 0xb2, 0xcc, 0x02, 0x5b,
+	0x8a, 0x90, 0xaf, 0xa7, 0x94, 0xe0, 0x64, 0x10, 0xb3, 0xe4, 0x2c, 0x3b, 0x62, 0xf1, 0x9e, 0xaf,
+	0xe7, 0x26, 0x5e, 0x9e, 0xc4, 0x2c, 0x79, 0xfc, 0xe6, 0xa5, 0x9a, 0x5c, 0xd5, 0xe0, 0xaa, 0x66,
+	0x57, 0xf5, 0x11, 0x6d, 0x9b, 0x2e, 0x6f, 0x7f, 0x6f, 0x16, 0xd9, 0x51, 0x20, 0x52, 0x7e, 0x76,
+	0x8c, 0x2c, 0x97, 0xa3, 0x3a, 0x54, 0x53, 0x29, 0x75, 0x28, 0xa5, 0xbe, 0x1c, 0x18, 0xe9, 0x7a,
+	0x90, 0xdf, 0xfc, 0xd9, 0xb0, 0xec, 0x5e, 0x26, 0x24, 0x3f, 0x35, 0x5d, 0x69, 0x09, 0x9d, 0x7c,
+	0x34, 0x66, 0x3b, 0x40, 0x71, 0xce, 0x9f, 0x94, 0x58, 0x74, 0x0d, 0xb4, 0xf4, 0xc9, 0xf8, 0x5a,
+	0xae, 0xc6, 0xf1, 0x83, 0x3f, 0xf1, 0x82, 0xaf, 0x6a, 0xb0, 0x55, 0x4d, 0xf2, 0x34, 0x66, 0xc9,
+	0x49, 0x36, 0xa3, 0xf4, 0xf3, 0xed, 0x2e, 0x62, 0x77, 0xbb, 0x88, 0xfd, 0xdd, 0x45, 0xec, 0x66,
+	0x1f, 0x2d, 0xee, 0xf6, 0xd1, 0xe2, 0xd7, 0x3e, 0x5a, 0x7c, 0x7d, 0x57, 0x59, 0xaa, 0xbb, 0x5c,
+	0x15, 0xd8, 0x68, 0x4f, 0xce, 0xb4, 0x15, 0x5c, 0x61, 0x0f, 0xaf, 0x7b, 0x68, 0xa9, 0x73, 0xe0,
+	0xf5, 0x70, 0x3d, 0xfd, 0x43, 0x3f, 0x38, 0x36, 0x5d, 0x6f, 0xc1, 0xe7, 0xab, 0xb1, 0xd3, 0xdb,
+	0x7f, 0x03, 0x00, 0x5f, 0x2f, 0x2c, 0x43, 0x09, 0x02, 0x00, 0x00,
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReserveAttestation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Reserves.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReserveAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReserveAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReserveAttestation(uint64(m.Id))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	l = m.Reserves.Size()
+	n += 1 + l + sovReserveAttestation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovReserveAttestation(uint64(l))
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReserveAttestation(uint64(m.Height))
+	}
+	return n
+}
+
+func sovReserveAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReserveAttestation(x uint64) (n int) {
+	return sovReserveAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserveAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserveAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReserveAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReserveAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReserveAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReserveAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReserveAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReserveAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReserveAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReserveAttestation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/role_change.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role enumerates the privileged roles of the module.
+type Role int32
+
+const (
+	RoleUnspecified  Role = 0
+	RoleOwner        Role = 1
+	RoleMasterMinter Role = 2
+	RolePauser       Role = 3
+	RoleBlacklister  Role = 4
+	RoleAttester     Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_OWNER",
+	2: "ROLE_MASTER_MINTER",
+	3: "ROLE_PAUSER",
+	4: "ROLE_BLACKLISTER",
+	5: "ROLE_ATTESTER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":   0,
+	"ROLE_OWNER":         1,
+	"ROLE_MASTER_MINTER": 2,
+	"ROLE_PAUSER":        3,
+	"ROLE_BLACKLISTER":   4,
+	"ROLE_ATTESTER":      5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_901ec5af98ab7204, []int{0}
+}
+
+// RoleChange is a role assignment queued until the role change delay has passed.
+type RoleChange struct {
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role Role   `protobuf:"varint,2,opt,name=role,proto3,enum=hero.tokenfactory.Role" json:"role,omitempty"`
+	// address the role is assigned to
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// address holding the role when the change was queued
+	Previous  string    `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	ExecuteAt time.Time `protobuf:"bytes,5,opt,name=executeAt,proto3,stdtime" json:"executeAt"`
+	// address that requested the change
+	RequestedBy string `protobuf:"bytes,6,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+}
+
+func (m *RoleChange) Reset()         { *m = RoleChange{} }
+func (m *RoleChange) String() string { return proto.CompactTextString(m) }
+func (*RoleChange) ProtoMessage()    {}
+func (*RoleChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_901ec5af98ab7204, []int{0}
+}
+func (m *RoleChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleChange.Merge(m, src)
+}
+func (m *RoleChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleChange proto.InternalMessageInfo
+
+func (m *RoleChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoleChange) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return RoleUnspecified
+}
+
+func (m *RoleChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleChange) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *RoleChange) GetExecuteAt() time.Time {
+	if m != nil {
+		return m.ExecuteAt
+	}
+	return time.Time{}
+}
+
+func (m *RoleChange) GetRequestedBy() string {
+	if m != nil {
+		return m.RequestedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("hero.tokenfactory.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleChange)(nil), "hero.tokenfactory.RoleChange")
+}
+
+func init() { proto.RegisterFile("tokenfactory/role_change.proto", fileDescriptor_901ec5af98ab7204) }
+
+var fileDescriptor_901ec5af98ab7204 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x3d, 0xa9, 0x5b, 0xda, 0x29, 0x2d, 0x66, 0xa8, 0x84, 0x65, 0x09, 0xc7, 0x82, 0x4d,
+	0xf8, 0xb3, 0xa5, 0xb2, 0x62, 0x69, 0x17, 0x23, 0x45, 0xe4, 0x4f, 0x4e, 0x22, 0x24, 0x36, 0x91,
+	0x63, 0xdf, 0x38, 0x56, 0x1d, 0x4f, 0x18, 0x8f, 0x43, 0xf3, 0x06, 0x28, 0xab, 0xbe, 0x40, 0x56,
+	0xbc, 0x4c, 0x97, 0x5d, 0xb2, 0x02, 0x94, 0x88, 0x47, 0x60, 0x8f, 0x66, 0xa2, 0xb4, 0x45, 0xec,
+	0x7c, 0x7d, 0xbe, 0x7b, 0xef, 0x99, 0x39, 0x83, 0x4d, 0x4e, 0xcf, 0x21, 0x1f, 0x85, 0x11, 0xa7,
+	0x6c, 0xee, 0x30, 0x9a, 0xc1, 0x20, 0x1a, 0x87, 0x79, 0x02, 0xf6, 0x94, 0x51, 0x4e, 0xc9, 0xc3,
+	0x31, 0x30, 0x6a, 0xdf, 0x85, 0x8c, 0x93, 0x84, 0x26, 0x54, 0xaa, 0x8e, 0xf8, 0xda, 0x80, 0x46,
+	0x35, 0xa1, 0x34, 0xc9, 0xc0, 0x91, 0xd5, 0xb0, 0x1c, 0x39, 0x3c, 0x9d, 0x40, 0xc1, 0xc3, 0xc9,
+	0x74, 0x03, 0x3c, 0xfd, 0x8d, 0x30, 0x0e, 0x68, 0x06, 0x67, 0x72, 0x3c, 0x39, 0xc6, 0x95, 0x34,
+	0xd6, 0x91, 0x85, 0x6a, 0x6a, 0x50, 0x49, 0x63, 0xf2, 0x12, 0xab, 0x62, 0xbb, 0x5e, 0xb1, 0x50,
+	0xed, 0xf8, 0xf4, 0xb1, 0xfd, 0xdf, 0x5e, 0x5b, 0x34, 0x07, 0x12, 0x22, 0x3a, 0xbe, 0x17, 0xc6,
+	0x31, 0x83, 0xa2, 0xd0, 0x77, 0x2c, 0x54, 0x3b, 0x08, 0xb6, 0x25, 0x31, 0xf0, 0xfe, 0x94, 0xc1,
+	0x2c, 0xa5, 0x65, 0xa1, 0xab, 0x52, 0xba, 0xa9, 0x89, 0x87, 0x0f, 0xe0, 0x02, 0xa2, 0x92, 0x83,
+	0xcb, 0xf5, 0x5d, 0x0b, 0xd5, 0x0e, 0x4f, 0x0d, 0x7b, 0x63, 0xdb, 0xde, 0xda, 0xb6, 0x7b, 0x5b,
+	0xdb, 0xde, 0xfe, 0xd5, 0x8f, 0xaa, 0x72, 0xf9, 0xb3, 0x8a, 0x82, 0xdb, 0x36, 0x62, 0xe1, 0x43,
+	0x06, 0x9f, 0x4b, 0x28, 0x38, 0xc4, 0xde, 0x5c, 0xdf, 0x93, 0x2b, 0xee, 0xfe, 0x7a, 0xf1, 0x07,
+	0x61, 0x55, 0x58, 0x25, 0xcf, 0xb1, 0x16, 0xb4, 0x1b, 0xfe, 0xa0, 0xdf, 0xea, 0x76, 0xfc, 0xb3,
+	0xfa, 0xfb, 0xba, 0xff, 0x4e, 0x53, 0x8c, 0x47, 0x8b, 0xa5, 0xf5, 0x40, 0xe8, 0xfd, 0xbc, 0x98,
+	0x42, 0x94, 0x8e, 0x52, 0x88, 0xc9, 0x13, 0x8c, 0x25, 0xda, 0xfe, 0xd8, 0xf2, 0x03, 0x0d, 0x19,
+	0x47, 0x8b, 0xa5, 0x75, 0x20, 0xa0, 0xf6, 0x97, 0x1c, 0x18, 0x79, 0x85, 0x89, 0x94, 0x9b, 0x6e,
+	0xb7, 0xe7, 0x07, 0x83, 0x66, 0xbd, 0xd5, 0xf3, 0x03, 0xad, 0x62, 0x9c, 0x2c, 0x96, 0x96, 0x26,
+	0xb0, 0x66, 0x58, 0x70, 0x60, 0xcd, 0x34, 0xe7, 0xc0, 0x48, 0x15, 0x1f, 0x4a, 0xba, 0xe3, 0xf6,
+	0xbb, 0x7e, 0xa0, 0xed, 0x18, 0xc7, 0x8b, 0xa5, 0x25, 0xaf, 0xbe, 0x13, 0x96, 0x05, 0xb0, 0x1b,
+	0x63, 0x5e, 0xc3, 0x3d, 0xfb, 0xd0, 0xa8, 0x8b, 0x99, 0x9a, 0x7a, 0x6b, 0xcc, 0xcb, 0xc2, 0xe8,
+	0x3c, 0x4b, 0xc5, 0x44, 0xf2, 0x0c, 0x1f, 0x49, 0xd4, 0xed, 0xf5, 0x7c, 0xc9, 0xed, 0x1a, 0xda,
+	0x62, 0x69, 0xdd, 0x17, 0x9c, 0xcb, 0xb9, 0x38, 0x33, 0x33, 0xd4, 0xaf, 0xdf, 0x4c, 0xc5, 0xeb,
+	0x5e, 0xad, 0x4c, 0x74, 0xbd, 0x32, 0xd1, 0xaf, 0x95, 0x89, 0x2e, 0xd7, 0xa6, 0x72, 0xbd, 0x36,
+	0x95, 0xef, 0x6b, 0x53, 0xf9, 0xf4, 0x36, 0x49, 0xf9, 0xb8, 0x1c, 0xda, 0x11, 0x9d, 0x38, 0x05,
+	0x67, 0x22, 0xfe, 0x8c, 0xce, 0xe0, 0xf5, 0x0c, 0x72, 0x5e, 0x32, 0x28, 0x1c, 0x91, 0xb5, 0x73,
+	0xe1, 0xfc, 0xf3, 0x14, 0xf9, 0x7c, 0x0a, 0xc5, 0x70, 0x4f, 0xe6, 0xf2, 0xe6, 0xef, 0x00, 0x3c,
+	0xf2, 0x0d, 0x2a, 0xa7, 0x02, 0x00, 0x00,
+}
+
+func (m *RoleChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintRoleChange(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecuteAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRoleChange(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Previous) > 0 {
+		i -= len(m.Previous)
+		copy(dAtA[i:], m.Previous)
+		i = encodeVarintRoleChange(dAtA, i, uint64(len(m.Previous)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRoleChange(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintRoleChange(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRoleChange(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoleChange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoleChange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRoleChange(uint64(m.Id))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRoleChange(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRoleChange(uint64(l))
+	}
+	l = len(m.Previous)
+	if l > 0 {
+		n += 1 + l + sovRoleChange(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecuteAt)
+	n += 1 + l + sovRoleChange(uint64(l))
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovRoleChange(uint64(l))
+	}
+	return n
+}
+
+func sovRoleChange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoleChange(x uint64) (n int) {
+	return sovRoleChange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoleChange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Previous = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecuteAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoleChange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoleChange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoleChange(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoleChange
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoleChange
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoleChange
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoleChange
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoleChange        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoleChange          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoleChange = fmt.Errorf("proto: unexpected end of group")
+)
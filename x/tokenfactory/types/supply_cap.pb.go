@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/supply_cap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SupplyCap is the maximum total supply of the minting denom.
+type SupplyCap struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *SupplyCap) Reset()         { *m = SupplyCap{} }
+func (m *SupplyCap) String() string { return proto.CompactTextString(m) }
+func (*SupplyCap) ProtoMessage()    {}
+func (*SupplyCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deaf8db173e777b9, []int{0}
+}
+func (m *SupplyCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyCap.Merge(m, src)
+}
+func (m *SupplyCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyCap proto.InternalMessageInfo
+
+func (m *SupplyCap) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*SupplyCap)(nil), "hero.tokenfactory.SupplyCap")
+}
+
+func init() { proto.RegisterFile("tokenfactory/supply_cap.proto", fileDescriptor_deaf8db173e777b9) }
+
+var fileDescriptor_deaf8db173e777b9 = []byte{
+	// 231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x09, 0x55, 0x22, 0x4c, 0x54, 0x0c, 0x50, 0x09, 0x83, 0x98, 0x58, 0xf0, 0xa9,
+	0x30, 0x20, 0xd6, 0x96, 0x27, 0xa0, 0x1b, 0x0b, 0x72, 0xac, 0xc3, 0x8d, 0x68, 0x7c, 0x96, 0x7d,
+	0x8e, 0xc8, 0x5b, 0xf0, 0x58, 0x1d, 0x3b, 0x32, 0x21, 0x94, 0xbc, 0x08, 0x4a, 0xd2, 0x81, 0x6e,
+	0x27, 0xfd, 0xdf, 0x77, 0xfa, 0xff, 0xfc, 0x92, 0xe9, 0x03, 0xdd, 0xbb, 0x36, 0x4c, 0xa1, 0x81,
+	0x98, 0xbc, 0xdf, 0x34, 0x6f, 0x46, 0x7b, 0xe5, 0x03, 0x31, 0x4d, 0x4f, 0xd7, 0x18, 0x48, 0xfd,
+	0x67, 0x66, 0x67, 0x96, 0x2c, 0x0d, 0x29, 0xf4, 0xd7, 0x08, 0xce, 0xa4, 0xa1, 0x58, 0x51, 0x84,
+	0x42, 0x47, 0x84, 0x7a, 0x5e, 0x20, 0xeb, 0x39, 0x18, 0x2a, 0xdd, 0x98, 0xdf, 0x3c, 0xe7, 0xc7,
+	0xab, 0xe1, 0xf9, 0x52, 0xfb, 0xe9, 0x63, 0x3e, 0xd1, 0x15, 0x25, 0xc7, 0xe7, 0xe2, 0x5a, 0xdc,
+	0x9e, 0xdc, 0x5f, 0xa8, 0xd1, 0x56, 0xbd, 0xad, 0xf6, 0xb6, 0x5a, 0x52, 0xe9, 0x16, 0x47, 0xdb,
+	0x9f, 0xab, 0xec, 0x65, 0x8f, 0x2f, 0x56, 0xdb, 0x56, 0x8a, 0x5d, 0x2b, 0xc5, 0x6f, 0x2b, 0xc5,
+	0x57, 0x27, 0xb3, 0x5d, 0x27, 0xb3, 0xef, 0x4e, 0x66, 0xaf, 0x4f, 0xb6, 0xe4, 0x75, 0x2a, 0x94,
+	0xa1, 0x0a, 0x22, 0x07, 0xed, 0x2c, 0x6e, 0xa8, 0xc6, 0xbb, 0x1a, 0x1d, 0xa7, 0x80, 0x11, 0xfa,
+	0x21, 0xf0, 0x09, 0x07, 0x73, 0xb9, 0xf1, 0x18, 0x8b, 0xc9, 0xd0, 0xf0, 0xe1, 0x6f, 0x00, 0x65,
+	0x12, 0xbb, 0x0d, 0x0b, 0x01, 0x00, 0x00,
+}
+
+func (m *SupplyCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSupplyCap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSupplyCap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSupplyCap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupplyCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovSupplyCap(uint64(l))
+	return n
+}
+
+func sovSupplyCap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSupplyCap(x uint64) (n int) {
+	return sovSupplyCap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SupplyCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyCap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyCap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSupplyCap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSupplyCap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSupplyCap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSupplyCap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSupplyCap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSupplyCap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSupplyCap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSupplyCap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSignBytes returns the bytes the holder signs to authorize the transfer on the given chain
+func (a TransferAuthorization) GetSignBytes(chainID string) []byte {
+	bz := ModuleCdc.MustMarshalJSON(&TransferAuthorizationSignDoc{
+		ChainId:       chainID,
+		Authorization: a,
+	})
+	return sdk.MustSortJSON(bz)
+}
+
+// Validate performs the stateless checks of the authorization
+func (a TransferAuthorization) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.From); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authorizer address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.To); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if !a.Amount.IsValid() || a.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount (%s)", a.Amount)
+	}
+	if !a.ValidBefore.After(a.ValidAfter) {
+		return sdkerrors.Wrap(ErrAuthorization, "valid before must be after valid after")
+	}
+	return validateNonce(a.Nonce)
+}
+
+// validateNonce checks that a nonce is set and short enough to be stored as a key
+func validateNonce(nonce string) error {
+	if len(nonce) == 0 || len(nonce) > 128 {
+		return sdkerrors.Wrap(ErrAuthorization, "nonce must be between 1 and 128 characters")
+	}
+	return nil
+}
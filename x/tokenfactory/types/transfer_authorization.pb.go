@@ -0,0 +1,1120 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/transfer_authorization.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuthorizationStatus enumerates the states of a consumed nonce.
+type AuthorizationStatus int32
+
+const (
+	// the authorization was used to transfer tokens
+	AuthorizationUsed AuthorizationStatus = 0
+	// the authorization was cancelled by the holder before it was used
+	AuthorizationCancelled AuthorizationStatus = 1
+)
+
+var AuthorizationStatus_name = map[int32]string{
+	0: "AUTHORIZATION_STATUS_USED",
+	1: "AUTHORIZATION_STATUS_CANCELLED",
+}
+
+var AuthorizationStatus_value = map[string]int32{
+	"AUTHORIZATION_STATUS_USED":      0,
+	"AUTHORIZATION_STATUS_CANCELLED": 1,
+}
+
+func (x AuthorizationStatus) String() string {
+	return proto.EnumName(AuthorizationStatus_name, int32(x))
+}
+
+func (AuthorizationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acb7246cc3d5beb7, []int{0}
+}
+
+// TransferAuthorization is a transfer signed off-chain by the holder so that a relayer can
+// submit it and pay the fees.
+type TransferAuthorization struct {
+	// holder of the tokens, the signer of the authorization
+	From   string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// the authorization can only be used after this time
+	ValidAfter time.Time `protobuf:"bytes,4,opt,name=validAfter,proto3,stdtime" json:"validAfter"`
+	// the authorization can only be used before this time
+	ValidBefore time.Time `protobuf:"bytes,5,opt,name=validBefore,proto3,stdtime" json:"validBefore"`
+	// unique value chosen by the holder, each nonce can only be used once
+	Nonce string `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
+func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TransferAuthorization) ProtoMessage()    {}
+func (*TransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb7246cc3d5beb7, []int{0}
+}
+func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferAuthorization.Merge(m, src)
+}
+func (m *TransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferAuthorization proto.InternalMessageInfo
+
+func (m *TransferAuthorization) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransferAuthorization) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransferAuthorization) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *TransferAuthorization) GetValidAfter() time.Time {
+	if m != nil {
+		return m.ValidAfter
+	}
+	return time.Time{}
+}
+
+func (m *TransferAuthorization) GetValidBefore() time.Time {
+	if m != nil {
+		return m.ValidBefore
+	}
+	return time.Time{}
+}
+
+func (m *TransferAuthorization) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+// TransferAuthorizationSignDoc is the payload signed by the holder, it binds the
+// authorization to a single chain.
+type TransferAuthorizationSignDoc struct {
+	ChainId       string                `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Authorization TransferAuthorization `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization"`
+}
+
+func (m *TransferAuthorizationSignDoc) Reset()         { *m = TransferAuthorizationSignDoc{} }
+func (m *TransferAuthorizationSignDoc) String() string { return proto.CompactTextString(m) }
+func (*TransferAuthorizationSignDoc) ProtoMessage()    {}
+func (*TransferAuthorizationSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb7246cc3d5beb7, []int{1}
+}
+func (m *TransferAuthorizationSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferAuthorizationSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferAuthorizationSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferAuthorizationSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferAuthorizationSignDoc.Merge(m, src)
+}
+func (m *TransferAuthorizationSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferAuthorizationSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferAuthorizationSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferAuthorizationSignDoc proto.InternalMessageInfo
+
+func (m *TransferAuthorizationSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *TransferAuthorizationSignDoc) GetAuthorization() TransferAuthorization {
+	if m != nil {
+		return m.Authorization
+	}
+	return TransferAuthorization{}
+}
+
+// AuthorizationState records a nonce of a holder that can no longer be used.
+type AuthorizationState struct {
+	Authorizer string              `protobuf:"bytes,1,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	Nonce      string              `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Status     AuthorizationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hero.tokenfactory.AuthorizationStatus" json:"status,omitempty"`
+}
+
+func (m *AuthorizationState) Reset()         { *m = AuthorizationState{} }
+func (m *AuthorizationState) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationState) ProtoMessage()    {}
+func (*AuthorizationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb7246cc3d5beb7, []int{2}
+}
+func (m *AuthorizationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationState.Merge(m, src)
+}
+func (m *AuthorizationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationState proto.InternalMessageInfo
+
+func (m *AuthorizationState) GetAuthorizer() string {
+	if m != nil {
+		return m.Authorizer
+	}
+	return ""
+}
+
+func (m *AuthorizationState) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *AuthorizationState) GetStatus() AuthorizationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AuthorizationUsed
+}
+
+func init() {
+	proto.RegisterEnum("hero.tokenfactory.AuthorizationStatus", AuthorizationStatus_name, AuthorizationStatus_value)
+	proto.RegisterType((*TransferAuthorization)(nil), "hero.tokenfactory.TransferAuthorization")
+	proto.RegisterType((*TransferAuthorizationSignDoc)(nil), "hero.tokenfactory.TransferAuthorizationSignDoc")
+	proto.RegisterType((*AuthorizationState)(nil), "hero.tokenfactory.AuthorizationState")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/transfer_authorization.proto", fileDescriptor_acb7246cc3d5beb7)
+}
+
+var fileDescriptor_acb7246cc3d5beb7 = []byte{
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x4e, 0xfa, 0xeb, 0xfa, 0x03, 0x4f, 0x4c, 0x9b, 0xd9, 0x50, 0x16, 0xa1, 0xb4, 0xea, 0x01,
+	0x15, 0x24, 0x1c, 0xad, 0x20, 0x21, 0x2e, 0x93, 0xd2, 0x3f, 0x88, 0x4a, 0xd3, 0x26, 0xb5, 0xe9,
+	0x65, 0x97, 0xca, 0x4d, 0x9d, 0x34, 0xa2, 0xf1, 0x5b, 0xd9, 0x4e, 0xc5, 0xf8, 0x04, 0xa8, 0x07,
+	0x34, 0x6e, 0x5c, 0x76, 0xe2, 0xcb, 0xec, 0xb8, 0x23, 0x27, 0x40, 0xed, 0x17, 0x41, 0x4d, 0x53,
+	0x91, 0x68, 0xbd, 0x70, 0xf3, 0x6b, 0x3f, 0x8f, 0x9f, 0xe7, 0x79, 0x5f, 0x1b, 0x3d, 0x57, 0xf0,
+	0x81, 0x71, 0x9f, 0x7a, 0x0a, 0xc4, 0x95, 0xad, 0x04, 0xe5, 0xd2, 0x67, 0x62, 0x40, 0x63, 0x35,
+	0x06, 0x11, 0x7e, 0xa2, 0x2a, 0x04, 0x4e, 0xa6, 0x02, 0x14, 0xe0, 0x83, 0x31, 0x13, 0x40, 0xb2,
+	0x78, 0xf3, 0x30, 0x80, 0x00, 0x92, 0x53, 0x7b, 0xb5, 0x5a, 0x03, 0xcd, 0x72, 0x00, 0x10, 0x4c,
+	0x98, 0x9d, 0x54, 0xc3, 0xd8, 0xb7, 0x55, 0x18, 0x31, 0xa9, 0x68, 0x34, 0x4d, 0x01, 0x96, 0x07,
+	0x32, 0x02, 0x69, 0x0f, 0xa9, 0x64, 0xf6, 0xec, 0x64, 0xc8, 0x14, 0x3d, 0xb1, 0x3d, 0x08, 0x53,
+	0xa5, 0xea, 0xb7, 0x02, 0x3a, 0x72, 0x53, 0x2b, 0x4e, 0xd6, 0x09, 0xc6, 0xa8, 0xe8, 0x0b, 0x88,
+	0x0c, 0xbd, 0xa2, 0xd7, 0x1e, 0x76, 0x93, 0x35, 0xde, 0x43, 0x05, 0x05, 0x46, 0x21, 0xd9, 0x29,
+	0x28, 0xc0, 0x6f, 0x50, 0x89, 0x46, 0x10, 0x73, 0x65, 0xfc, 0x57, 0xd1, 0x6b, 0xbb, 0xf5, 0x63,
+	0xb2, 0x96, 0x23, 0x2b, 0x39, 0x92, 0xca, 0x91, 0x26, 0x84, 0xbc, 0x51, 0xbc, 0xfd, 0x59, 0xd6,
+	0xba, 0x29, 0x1c, 0xb7, 0x10, 0x9a, 0xd1, 0x49, 0x38, 0x72, 0x7c, 0xc5, 0x84, 0x51, 0x4c, 0xc8,
+	0x26, 0x59, 0x87, 0x21, 0x9b, 0x30, 0xc4, 0xdd, 0x84, 0x69, 0x3c, 0x58, 0xb1, 0xaf, 0x7f, 0x95,
+	0xf5, 0x6e, 0x86, 0x87, 0xdf, 0xa1, 0xdd, 0xa4, 0x6a, 0x30, 0x1f, 0x04, 0x33, 0x76, 0xfe, 0xe1,
+	0x9a, 0x2c, 0x11, 0x1f, 0xa2, 0x1d, 0x0e, 0xdc, 0x63, 0x46, 0x29, 0x49, 0xb6, 0x2e, 0xaa, 0x5f,
+	0x74, 0xf4, 0x74, 0x6b, 0x6b, 0x7a, 0x61, 0xc0, 0x5b, 0xe0, 0x61, 0x03, 0xfd, 0xef, 0x8d, 0x69,
+	0xc8, 0x3b, 0xa3, 0xb4, 0x49, 0x9b, 0x12, 0xbb, 0xe8, 0x51, 0x6e, 0xac, 0x49, 0xcb, 0x76, 0xeb,
+	0x35, 0x72, 0x6f, 0xae, 0x64, 0xab, 0x42, 0xda, 0xad, 0xfc, 0x25, 0xd5, 0xb9, 0x8e, 0x70, 0xde,
+	0x88, 0xa2, 0x8a, 0x61, 0x0b, 0xa1, 0x0d, 0x8e, 0x89, 0xd4, 0x49, 0x66, 0xe7, 0x6f, 0xba, 0x42,
+	0x26, 0x1d, 0x3e, 0x45, 0x25, 0xa9, 0xa8, 0x8a, 0x65, 0x32, 0xba, 0xbd, 0xfa, 0xb3, 0x2d, 0xde,
+	0xee, 0x89, 0xc5, 0xb2, 0x9b, 0xb2, 0x5e, 0x7c, 0xd5, 0xd1, 0xe3, 0x2d, 0xe7, 0xf8, 0x35, 0x3a,
+	0x76, 0xfa, 0xee, 0xfb, 0x8b, 0x6e, 0xe7, 0xd2, 0x71, 0x3b, 0x17, 0xe7, 0x83, 0x9e, 0xeb, 0xb8,
+	0xfd, 0xde, 0xa0, 0xdf, 0x6b, 0xb7, 0xf6, 0x35, 0xf3, 0x68, 0x7e, 0x53, 0x39, 0xc8, 0xf1, 0xfa,
+	0x92, 0x8d, 0xf0, 0x29, 0xb2, 0xb6, 0xb2, 0x9a, 0xce, 0x79, 0xb3, 0x7d, 0x76, 0xd6, 0x6e, 0xed,
+	0xeb, 0xa6, 0x39, 0xbf, 0xa9, 0x3c, 0xc9, 0x51, 0x9b, 0x94, 0x7b, 0x6c, 0x32, 0x61, 0x23, 0xb3,
+	0xf8, 0xf9, 0xbb, 0xa5, 0x35, 0x7a, 0xb7, 0x0b, 0x4b, 0xbf, 0x5b, 0x58, 0xfa, 0xef, 0x85, 0xa5,
+	0x5f, 0x2f, 0x2d, 0xed, 0x6e, 0x69, 0x69, 0x3f, 0x96, 0x96, 0x76, 0xf9, 0x36, 0x08, 0xd5, 0x38,
+	0x1e, 0x12, 0x0f, 0x22, 0x5b, 0xae, 0xbe, 0x5e, 0xc0, 0x26, 0x30, 0x63, 0x2f, 0x67, 0x8c, 0xab,
+	0x58, 0x30, 0x69, 0xaf, 0xc2, 0xdb, 0x1f, 0xed, 0xfc, 0x17, 0xbd, 0x9a, 0x32, 0x39, 0x2c, 0x25,
+	0xef, 0xe8, 0xd5, 0x9f, 0x01, 0x00, 0x4f, 0x54, 0x1e, 0x64, 0xbf, 0x03, 0x00, 0x00,
+}
+
+func (m *TransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ValidBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidBefore):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTransferAuthorization(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ValidAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidAfter):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTransferAuthorization(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferAuthorizationSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferAuthorizationSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferAuthorizationSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizer) > 0 {
+		i -= len(m.Authorizer)
+		copy(dAtA[i:], m.Authorizer)
+		i = encodeVarintTransferAuthorization(dAtA, i, uint64(len(m.Authorizer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferAuthorization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferAuthorization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTransferAuthorization(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidAfter)
+	n += 1 + l + sovTransferAuthorization(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidBefore)
+	n += 1 + l + sovTransferAuthorization(uint64(l))
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferAuthorizationSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	l = m.Authorization.Size()
+	n += 1 + l + sovTransferAuthorization(uint64(l))
+	return n
+}
+
+func (m *AuthorizationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authorizer)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTransferAuthorization(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTransferAuthorization(uint64(m.Status))
+	}
+	return n
+}
+
+func sovTransferAuthorization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferAuthorization(x uint64) (n int) {
+	return sovTransferAuthorization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ValidAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ValidBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferAuthorizationSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferAuthorizationSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferAuthorizationSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AuthorizationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferAuthorization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferAuthorization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferAuthorization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferAuthorization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferAuthorization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferAuthorization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferAuthorization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferAuthorization = fmt.Errorf("proto: unexpected end of group")
+)